@@ -0,0 +1,179 @@
+// Package apperror 는 API 전반에서 사용하는 구조화된 에러 모델을 정의합니다.
+// 모든 에러는 안정적인 Code 를 가지며, 응답 시 RFC 7807 problem+json 으로 변환됩니다.
+package apperror
+
+import (
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// 공통 에러 코드
+const (
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeMalformedRequest = "MALFORMED_REQUEST"
+	CodeUnauthorized     = "UNAUTHORIZED"
+	CodeInvalidToken     = "INVALID_TOKEN"
+	CodeInvalidAPIKey    = "INVALID_API_KEY"
+	CodeForbidden        = "FORBIDDEN"
+	CodeInvalidCreds     = "INVALID_CREDENTIALS"
+	CodeInternal         = "INTERNAL_ERROR"
+	CodeRegionFull       = "REGION_FULL"
+	CodeRateLimited      = "RATE_LIMITED"
+	CodeAccountLocked    = "ACCOUNT_LOCKED"
+	CodeInvalidReset     = "INVALID_RESET_TOKEN"
+	CodeAccountDisabled  = "ACCOUNT_DISABLED"
+	CodeSelfDeactivation = "CANNOT_DEACTIVATE_SELF"
+	CodeCSRF             = "CSRF_TOKEN_INVALID"
+	CodeShiftOverlap     = "SHIFT_OVERLAP"
+	CodeInvalidDriver    = "INVALID_DRIVER"
+	CodeShiftEnded       = "SHIFT_ALREADY_ENDED"
+	CodeConfirmPending   = "CONFIRMATION_PENDING"
+	CodeConfirmAcked     = "CONFIRMATION_ALREADY_ACKNOWLEDGED"
+	CodeDeliveryPending  = "WEBHOOK_DELIVERY_PENDING"
+	CodeSorterManaged    = "SORTER_MANAGED"
+	CodeDBTimeout        = "DATABASE_TIMEOUT"
+)
+
+// FieldError 는 요청 필드 단위의 검증 실패를 나타냅니다.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// Error 는 HTTP 상태와 안정적인 에러 코드를 가진 애플리케이션 에러입니다.
+type Error struct {
+	Status int
+	Code   string
+	Title  string
+	Detail string
+	Fields []FieldError
+	Err    error
+	// RetryAfter 가 0보다 크면 응답에 Retry-After 헤더를 붙임
+	RetryAfter time.Duration
+}
+
+func (e *Error) Error() string {
+	if e.Err != nil {
+		return fmt.Sprintf("%s: %v", e.Code, e.Err)
+	}
+	if e.Detail != "" {
+		return fmt.Sprintf("%s: %s", e.Code, e.Detail)
+	}
+	return e.Code
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// New 는 새로운 애플리케이션 에러를 생성합니다.
+func New(status int, code, title string) *Error {
+	return &Error{Status: status, Code: code, Title: title}
+}
+
+// WithDetail 은 Detail 이 채워진 사본을 반환합니다.
+func (e *Error) WithDetail(format string, args ...any) *Error {
+	cp := *e
+	cp.Detail = fmt.Sprintf(format, args...)
+	return &cp
+}
+
+// Wrap 은 원인 에러를 감싼 사본을 반환합니다. 원인은 릴리스 모드에서 응답에 노출되지 않습니다.
+func (e *Error) Wrap(err error) *Error {
+	cp := *e
+	cp.Err = err
+	return &cp
+}
+
+// WithRetryAfter 는 Retry-After 가 설정된 사본을 반환합니다.
+func (e *Error) WithRetryAfter(d time.Duration) *Error {
+	cp := *e
+	cp.RetryAfter = d
+	return &cp
+}
+
+// 도메인 에러
+var (
+	ErrRegionFull          = New(http.StatusConflict, CodeRegionFull, "Region is full")
+	ErrShiftOverlap        = New(http.StatusConflict, CodeShiftOverlap, "Shift overlaps an existing shift")
+	ErrShiftEnded          = New(http.StatusConflict, CodeShiftEnded, "Shift has already ended")
+	ErrConfirmationPending = New(http.StatusConflict, CodeConfirmPending, "Vehicle has pending confirmations")
+	ErrConfirmationAcked   = New(http.StatusConflict, CodeConfirmAcked, "Confirmation has already been acknowledged")
+	ErrDeliveryPending     = New(http.StatusConflict, CodeDeliveryPending, "Webhook delivery is still pending")
+	ErrSorterManaged       = New(http.StatusConflict, CodeSorterManaged, "Induction is managed by the sorter")
+	ErrInvalidDriver       = New(http.StatusUnprocessableEntity, CodeInvalidDriver, "Employee cannot be assigned as a driver")
+	ErrUnauthorized        = New(http.StatusUnauthorized, CodeUnauthorized, "Missing or invalid token")
+	ErrInvalidToken        = New(http.StatusUnauthorized, CodeInvalidToken, "Invalid token")
+	ErrInvalidAPIKey       = New(http.StatusUnauthorized, CodeInvalidAPIKey, "Missing or unknown API key")
+	ErrForbidden           = New(http.StatusForbidden, CodeForbidden, "Forbidden")
+
+	ErrInvalidCredentials = New(http.StatusUnauthorized, CodeInvalidCreds, "Invalid credentials")
+	ErrRateLimited        = New(http.StatusTooManyRequests, CodeRateLimited, "Too many requests")
+	ErrAccountLocked      = New(http.StatusTooManyRequests, CodeAccountLocked, "Account temporarily locked")
+	ErrAccountDisabled    = New(http.StatusForbidden, CodeAccountDisabled, "Account is deactivated")
+	ErrCSRF               = New(http.StatusForbidden, CodeCSRF, "Missing or invalid CSRF token")
+	ErrSelfDeactivation   = New(http.StatusConflict, CodeSelfDeactivation, "Cannot deactivate your own account")
+	ErrInvalidResetToken  = New(http.StatusBadRequest, CodeInvalidReset, "Reset token is invalid, expired or already used")
+)
+
+// resourceCode 는 "trip_log" 와 같은 리소스 이름을 "TRIP_LOG" 로 변환합니다.
+func resourceCode(resource string) string {
+	return strings.ToUpper(strings.ReplaceAll(resource, "-", "_"))
+}
+
+// resourceTitle 은 "trip_log" 와 같은 리소스 이름을 "Trip log" 로 변환합니다.
+func resourceTitle(resource string) string {
+	s := strings.ReplaceAll(resource, "_", " ")
+	if s == "" {
+		return "Resource"
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
+
+// NotFound 는 <RESOURCE>_NOT_FOUND 에러를 생성합니다.
+func NotFound(resource string) *Error {
+	return New(http.StatusNotFound, resourceCode(resource)+"_NOT_FOUND", resourceTitle(resource)+" not found")
+}
+
+// Duplicate 는 DUPLICATE_<RESOURCE> 에러를 생성합니다.
+func Duplicate(resource string) *Error {
+	return New(http.StatusConflict, "DUPLICATE_"+resourceCode(resource), resourceTitle(resource)+" already exists")
+}
+
+// InvalidReference 는 존재하지 않는 연관 리소스를 참조할 때의 에러를 생성합니다.
+func InvalidReference(resource string) *Error {
+	return New(http.StatusUnprocessableEntity, "INVALID_"+resourceCode(resource)+"_REFERENCE", resourceTitle(resource)+" references a missing resource")
+}
+
+// InUse 는 다른 리소스가 참조 중이라 삭제/수정할 수 없을 때의 에러를 생성합니다.
+func InUse(resource string) *Error {
+	return New(http.StatusConflict, resourceCode(resource)+"_IN_USE", resourceTitle(resource)+" is referenced by other resources")
+}
+
+// InvalidID 는 경로 파라미터의 ID 형식이 잘못되었을 때의 에러를 생성합니다.
+func InvalidID(resource string) *Error {
+	return New(http.StatusBadRequest, "INVALID_"+resourceCode(resource)+"_ID", "Invalid "+strings.ReplaceAll(resource, "_", " ")+" id")
+}
+
+// Validation 은 필드 단위 검증 실패 에러를 생성합니다.
+func Validation(fields []FieldError) *Error {
+	return &Error{
+		Status: http.StatusBadRequest,
+		Code:   CodeValidationFailed,
+		Title:  "Request validation failed",
+		Fields: fields,
+	}
+}
+
+// Internal 은 원인을 감싼 500 에러를 생성합니다.
+func Internal(err error) *Error {
+	return &Error{
+		Status: http.StatusInternalServerError,
+		Code:   CodeInternal,
+		Title:  "Internal server error",
+		Err:    err,
+	}
+}
@@ -0,0 +1,67 @@
+package apperror
+
+import (
+	"log/slog"
+	"math"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/gin-gonic/gin"
+	"github.com/gin-gonic/gin/binding"
+	"github.com/go-playground/validator/v10"
+)
+
+// ProblemContentType 은 RFC 7807 응답의 Content-Type 입니다.
+const ProblemContentType = "application/problem+json"
+
+func init() {
+	// 검증 에러의 필드명을 Go 필드명이 아닌 JSON 필드명으로 노출
+	if v, ok := binding.Validator.Engine().(*validator.Validate); ok {
+		v.RegisterTagNameFunc(func(f reflect.StructField) string {
+			name := strings.SplitN(f.Tag.Get("json"), ",", 2)[0]
+			if name == "-" {
+				return ""
+			}
+			if name == "" {
+				return f.Name
+			}
+			return name
+		})
+	}
+}
+
+// Abort 는 에러를 problem+json 응답으로 작성하고 요청 처리를 중단합니다.
+func Abort(c *gin.Context, err error, resource string) {
+	appErr := Translate(err, resource)
+	if appErr.Status >= 500 {
+		slog.ErrorContext(c.Request.Context(), "request failed",
+			"code", appErr.Code, "method", c.Request.Method, "path", c.Request.URL.Path, "error", err)
+	}
+	if appErr.RetryAfter > 0 {
+		// 초 단위로 올림하여 클라이언트가 너무 일찍 재시도하지 않도록 함
+		c.Header("Retry-After", strconv.Itoa(int(math.Ceil(appErr.RetryAfter.Seconds()))))
+	}
+	c.Header("Content-Type", ProblemContentType)
+	c.AbortWithStatusJSON(appErr.Status, toProblem(c, appErr))
+}
+
+func toProblem(c *gin.Context, e *Error) dto.Problem {
+	p := dto.Problem{
+		Type:     "/problems/" + strings.ToLower(strings.ReplaceAll(e.Code, "_", "-")),
+		Title:    e.Title,
+		Status:   e.Status,
+		Detail:   e.Detail,
+		Instance: c.Request.URL.Path,
+		Code:     e.Code,
+	}
+	// 릴리스 모드가 아니면 디버깅을 위해 원인 에러를 노출
+	if p.Detail == "" && len(e.Fields) == 0 && e.Err != nil && gin.Mode() != gin.ReleaseMode {
+		p.Detail = e.Err.Error()
+	}
+	for _, f := range e.Fields {
+		p.Errors = append(p.Errors, dto.FieldError{Field: f.Field, Rule: f.Rule, Message: f.Message})
+	}
+	return p
+}
@@ -0,0 +1,140 @@
+package apperror
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"unicode"
+
+	"github.com/go-playground/validator/v10"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+// MySQL 에러 번호
+const (
+	mysqlErrDupEntry          = 1062
+	mysqlErrRowIsReferenced   = 1451
+	mysqlErrNoReferencedRow   = 1452
+	mysqlErrRowIsReferenced2  = 1217
+	mysqlErrNoReferencedRow2  = 1216
+	mysqlErrDataTooLong       = 1406
+	mysqlErrTruncatedWrongVal = 1265
+	mysqlErrCheckViolated     = 3819
+)
+
+// Translate 는 서비스/GORM/MySQL/바인딩 에러를 애플리케이션 에러로 변환합니다.
+// resource 는 에러 코드 생성에 사용되는 리소스 이름입니다 (예: "package").
+func Translate(err error, resource string) *Error {
+	if err == nil {
+		return nil
+	}
+
+	var appErr *Error
+	if errors.As(err, &appErr) {
+		return appErr
+	}
+
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return NotFound(resource).Wrap(err)
+	}
+	if errors.Is(err, gorm.ErrDuplicatedKey) {
+		return Duplicate(resource).Wrap(err)
+	}
+	if errors.Is(err, gorm.ErrForeignKeyViolated) {
+		return InvalidReference(resource).Wrap(err)
+	}
+	if errors.Is(err, gorm.ErrCheckConstraintViolated) {
+		return valueRejected().Wrap(err)
+	}
+	if errors.Is(err, context.DeadlineExceeded) {
+		// db.query_timeout 또는 요청 마감 시간 초과
+		return New(http.StatusServiceUnavailable, CodeDBTimeout, "Database did not respond in time").Wrap(err)
+	}
+
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) {
+		switch myErr.Number {
+		case mysqlErrDupEntry:
+			return Duplicate(resource).Wrap(err)
+		case mysqlErrNoReferencedRow, mysqlErrNoReferencedRow2:
+			return InvalidReference(resource).Wrap(err)
+		case mysqlErrRowIsReferenced, mysqlErrRowIsReferenced2:
+			return InUse(resource).Wrap(err)
+		case mysqlErrDataTooLong, mysqlErrTruncatedWrongVal, mysqlErrCheckViolated:
+			return valueRejected().Wrap(err)
+		}
+	}
+
+	var verrs validator.ValidationErrors
+	if errors.As(err, &verrs) {
+		fields := make([]FieldError, 0, len(verrs))
+		for _, fe := range verrs {
+			fields = append(fields, FieldError{
+				Field:   fe.Field(),
+				Rule:    fe.Tag(),
+				Message: fieldMessage(fe),
+			})
+		}
+		return Validation(fields).Wrap(err)
+	}
+
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	switch {
+	case errors.As(err, &typeErr):
+		return New(http.StatusBadRequest, CodeMalformedRequest, "Malformed request body").
+			WithDetail("field %q must be %s", typeErr.Field, typeErr.Type.String()).Wrap(err)
+	case errors.As(err, &syntaxErr), errors.Is(err, io.EOF), errors.Is(err, io.ErrUnexpectedEOF):
+		return New(http.StatusBadRequest, CodeMalformedRequest, "Malformed request body").Wrap(err)
+	}
+
+	return Internal(err)
+}
+
+// valueRejected 길이 초과, 형식 오류, CHECK 제약 위반처럼 DB 가 값을 거부한 경우
+func valueRejected() *Error {
+	return New(http.StatusUnprocessableEntity, CodeValidationFailed, "Value rejected by database")
+}
+
+// fieldMessage 는 validator 태그를 사람이 읽을 수 있는 메시지로 변환합니다.
+func fieldMessage(fe validator.FieldError) string {
+	switch fe.Tag() {
+	case "required":
+		return "is required"
+	case "len":
+		return fmt.Sprintf("must be exactly %s characters", fe.Param())
+	case "min":
+		return fmt.Sprintf("must be at least %s", fe.Param())
+	case "max":
+		return fmt.Sprintf("must be at most %s", fe.Param())
+	case "oneof":
+		return "must be one of: " + strings.Join(strings.Fields(fe.Param()), ", ")
+	case "email":
+		return "must be a valid email address"
+	case "http_url":
+		return "must be a valid http or https URL"
+	case "datetime":
+		return "must match the format " + fe.Param()
+	case "required_without":
+		return fmt.Sprintf("is required when %s is not provided", snakeCase(fe.Param()))
+	default:
+		return fmt.Sprintf("failed on the %q rule", fe.Tag())
+	}
+}
+
+// snakeCase 는 validator 파라미터로 전달되는 Go 필드명("EmployeeID")을 JSON 필드명("employee_id")으로 변환합니다.
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if i > 0 && unicode.IsUpper(r) && unicode.IsLower(rune(s[i-1])) {
+			b.WriteByte('_')
+		}
+		b.WriteRune(unicode.ToLower(r))
+	}
+	return b.String()
+}
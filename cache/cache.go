@@ -0,0 +1,114 @@
+// Package cache 는 자주 조회되는 읽기 결과를 짧은 시간 보관하는 캐시를 제공합니다.
+// 기본 구현은 프로세스 내 LRU 저장소이며, 여러 인스턴스가 캐시와 무효화를 공유해야 하면
+// Redis 저장소를 사용합니다.
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"log/slog"
+	"strconv"
+	"time"
+
+	"github.com/baboyiban/go-api-server/metrics"
+)
+
+// Store 직렬화된 캐시 항목 저장소
+type Store interface {
+	// Get 항목이 없거나 만료되었으면 ok=false
+	Get(ctx context.Context, key string) (value []byte, ok bool, err error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	// DeletePrefix prefix 로 시작하는 항목을 모두 삭제
+	DeletePrefix(ctx context.Context, prefix string) error
+	// Generation namespace 의 현재 세대. 한 번도 올리지 않았으면 0
+	Generation(ctx context.Context, namespace string) (uint64, error)
+	// IncrGeneration namespace 의 세대를 올림. 저장소를 공유하는 인스턴스는 세대도 공유함
+	IncrGeneration(ctx context.Context, namespace string) error
+}
+
+// Cache 네임스페이스 단위로 무효화하는 읽기 캐시. nil 이면 캐시 없이 매번 조회.
+// 키에 네임스페이스의 세대를 넣고 무효화할 때 세대를 올리므로, 무효화 전에 시작한 조회 결과는
+// 저장되더라도 이전 세대 키에 들어가 다시 읽히지 않음
+type Cache struct {
+	store Store
+	ttl   time.Duration
+}
+
+func New(store Store, ttl time.Duration) *Cache {
+	return &Cache{store: store, ttl: ttl}
+}
+
+// TTL 항목 유지 시간. 캐시가 없으면 0
+func (c *Cache) TTL() time.Duration {
+	if c == nil {
+		return 0
+	}
+	return c.ttl
+}
+
+// Invalidate namespace 의 세대를 올리고 이전 항목을 삭제. 삭제에 실패해도 TTL 이 지나면 사라지므로 기록만 함
+func (c *Cache) Invalidate(ctx context.Context, namespace string) {
+	if c == nil {
+		return
+	}
+	if err := c.store.IncrGeneration(ctx, namespace); err != nil {
+		slog.WarnContext(ctx, "캐시 무효화 실패", "cache", namespace, "error", err)
+	}
+	if err := c.store.DeletePrefix(ctx, namespace+":"); err != nil {
+		slog.WarnContext(ctx, "캐시 무효화 실패", "cache", namespace, "error", err)
+	}
+}
+
+// Close 저장소가 외부 연결을 가지고 있으면 닫음
+func (c *Cache) Close() error {
+	if c == nil {
+		return nil
+	}
+	if closer, ok := c.store.(io.Closer); ok {
+		return closer.Close()
+	}
+	return nil
+}
+
+// Fetch namespace 의 key 항목을 반환하고, 없으면 load 결과를 저장한 뒤 반환.
+// 저장소 오류는 기록만 하고 load 로 조회하며, load 가 실패한 결과는 저장하지 않음
+func Fetch[T any](ctx context.Context, c *Cache, namespace, key string, load func() (T, error)) (T, error) {
+	if c == nil {
+		return load()
+	}
+	generation, err := c.store.Generation(ctx, namespace)
+	if err != nil {
+		// 세대를 모르면 무효화된 항목을 읽거나 쓸 수 있으므로 캐시를 거치지 않음
+		metrics.ObserveCache(namespace, "error")
+		slog.WarnContext(ctx, "캐시 조회 실패", "cache", namespace, "error", err)
+		return load()
+	}
+	fullKey := namespace + ":" + strconv.FormatUint(generation, 10) + ":" + key
+	data, ok, err := c.store.Get(ctx, fullKey)
+	switch {
+	case err != nil:
+		metrics.ObserveCache(namespace, "error")
+		slog.WarnContext(ctx, "캐시 조회 실패", "cache", namespace, "error", err)
+	case ok:
+		var v T
+		if err := json.Unmarshal(data, &v); err == nil {
+			metrics.ObserveCache(namespace, "hit")
+			return v, nil
+		}
+		metrics.ObserveCache(namespace, "error")
+	default:
+		metrics.ObserveCache(namespace, "miss")
+	}
+
+	v, err := load()
+	if err != nil {
+		return v, err
+	}
+	if data, err := json.Marshal(v); err == nil {
+		if err := c.store.Set(ctx, fullKey, data, c.ttl); err != nil {
+			slog.WarnContext(ctx, "캐시 저장 실패", "cache", namespace, "error", err)
+		}
+	}
+	return v, nil
+}
@@ -0,0 +1,137 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFetch(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name string
+		// run 같은 키를 두 번 조회하기 전후의 동작. 두 번째 조회가 load 를 호출했는지 반환
+		run       func(t *testing.T, c, other *Cache) bool
+		wantFresh bool
+	}{
+		{"hit", func(t *testing.T, c, _ *Cache) bool {
+			return fetchTwice(t, c, nil)
+		}, false},
+		{"invalidated", func(t *testing.T, c, _ *Cache) bool {
+			return fetchTwice(t, c, func() { c.Invalidate(ctx, "ns") })
+		}, true},
+		{"invalidated by other instance", func(t *testing.T, c, other *Cache) bool {
+			return fetchTwice(t, c, func() { other.Invalidate(ctx, "ns") })
+		}, true},
+		{"other namespace invalidated", func(t *testing.T, c, other *Cache) bool {
+			return fetchTwice(t, c, func() { other.Invalidate(ctx, "other") })
+		}, false},
+		{"invalidated during load by other instance", func(t *testing.T, c, other *Cache) bool {
+			// 무효화 전에 읽은 값은 이전 세대 키에 저장되므로 다음 조회에서 쓰이지 않음
+			_, _ = Fetch(ctx, c, "ns", "k", func() (string, error) {
+				other.Invalidate(ctx, "ns")
+				return "stale", nil
+			})
+			loaded := false
+			v, _ := Fetch(ctx, c, "ns", "k", func() (string, error) {
+				loaded = true
+				return "fresh", nil
+			})
+			if v != "fresh" {
+				t.Errorf("got %q", v)
+			}
+			return loaded
+		}, true},
+		{"failed load not cached", func(t *testing.T, c, _ *Cache) bool {
+			if _, err := Fetch(ctx, c, "ns", "k", func() (string, error) { return "", errors.New("db down") }); err == nil {
+				t.Error("error not returned")
+			}
+			loaded := false
+			_, _ = Fetch(ctx, c, "ns", "k", func() (string, error) {
+				loaded = true
+				return "ok", nil
+			})
+			return loaded
+		}, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			// 두 인스턴스가 같은 저장소(Redis)를 공유하는 경우
+			shared := NewMemoryStore(10)
+			if got := tt.run(t, New(shared, time.Minute), New(shared, time.Minute)); got != tt.wantFresh {
+				t.Errorf("second fetch loaded = %v, want %v", got, tt.wantFresh)
+			}
+		})
+	}
+}
+
+// fetchTwice between 을 사이에 두고 두 번 조회. 두 번째 조회가 load 를 호출했으면 true
+func fetchTwice(t *testing.T, c *Cache, between func()) bool {
+	t.Helper()
+	ctx := context.Background()
+	loads := 0
+	load := func() (int, error) {
+		loads++
+		return loads, nil
+	}
+	if _, err := Fetch(ctx, c, "ns", "k", load); err != nil {
+		t.Fatal(err)
+	}
+	if between != nil {
+		between()
+	}
+	if _, err := Fetch(ctx, c, "ns", "k", load); err != nil {
+		t.Fatal(err)
+	}
+	return loads == 2
+}
+
+func TestFetch_NilCache(t *testing.T) {
+	var c *Cache
+	loads := 0
+	for range 2 {
+		_, _ = Fetch(context.Background(), c, "ns", "k", func() (int, error) { loads++; return loads, nil })
+	}
+	c.Invalidate(context.Background(), "ns")
+	if loads != 2 || c.TTL() != 0 {
+		t.Errorf("loads = %d, ttl = %s", loads, c.TTL())
+	}
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.Background()
+	s := NewMemoryStore(2)
+	now := time.Now()
+	s.now = func() time.Time { return now }
+
+	_ = s.Set(ctx, "a:1", []byte("1"), time.Minute)
+	_ = s.Set(ctx, "a:2", []byte("2"), time.Second)
+	_, _, _ = s.Get(ctx, "a:1") // a:1 을 최근 사용으로
+	_ = s.Set(ctx, "b:1", []byte("3"), time.Minute)
+
+	tests := []struct {
+		name  string
+		at    time.Duration
+		key   string
+		found bool
+	}{
+		{"recently used kept", 0, "a:1", true},
+		{"least recently used evicted", 0, "a:2", false},
+		{"newest kept", 0, "b:1", true},
+		{"expired", 2 * time.Minute, "b:1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s.now = func() time.Time { return now.Add(tt.at) }
+			if _, ok, _ := s.Get(ctx, tt.key); ok != tt.found {
+				t.Errorf("Get(%s) found = %v, want %v", tt.key, ok, tt.found)
+			}
+		})
+	}
+
+	_ = s.DeletePrefix(ctx, "a:")
+	if _, ok, _ := s.Get(ctx, "a:1"); ok {
+		t.Error("a:1 survived DeletePrefix")
+	}
+}
@@ -0,0 +1,98 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"strings"
+	"sync"
+	"time"
+)
+
+// MemoryStore 프로세스 내 LRU 저장소. maxEntries 를 넘으면 가장 오래 쓰이지 않은 항목부터 버림
+type MemoryStore struct {
+	mu         sync.Mutex
+	maxEntries int
+	order      *list.List // 앞쪽이 최근에 사용한 항목
+	items      map[string]*list.Element
+	now        func() time.Time
+	// generations 네임스페이스별 세대. LRU 로 버려지지 않도록 항목과 따로 보관
+	generations map[string]uint64
+}
+
+type memoryEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+func NewMemoryStore(maxEntries int) *MemoryStore {
+	return &MemoryStore{
+		maxEntries:  maxEntries,
+		order:       list.New(),
+		items:       map[string]*list.Element{},
+		now:         time.Now,
+		generations: map[string]uint64{},
+	}
+}
+
+func (s *MemoryStore) Get(_ context.Context, key string) ([]byte, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	el, ok := s.items[key]
+	if !ok {
+		return nil, false, nil
+	}
+	e := el.Value.(*memoryEntry)
+	if !s.now().Before(e.expiresAt) {
+		s.remove(el)
+		return nil, false, nil
+	}
+	s.order.MoveToFront(el)
+	return e.value, true, nil
+}
+
+func (s *MemoryStore) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	expiresAt := s.now().Add(ttl)
+	if el, ok := s.items[key]; ok {
+		e := el.Value.(*memoryEntry)
+		e.value, e.expiresAt = value, expiresAt
+		s.order.MoveToFront(el)
+		return nil
+	}
+	s.items[key] = s.order.PushFront(&memoryEntry{key: key, value: value, expiresAt: expiresAt})
+	for s.order.Len() > s.maxEntries {
+		s.remove(s.order.Back())
+	}
+	return nil
+}
+
+func (s *MemoryStore) DeletePrefix(_ context.Context, prefix string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for key, el := range s.items {
+		if strings.HasPrefix(key, prefix) {
+			s.remove(el)
+		}
+	}
+	return nil
+}
+
+func (s *MemoryStore) Generation(_ context.Context, namespace string) (uint64, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.generations[namespace], nil
+}
+
+func (s *MemoryStore) IncrGeneration(_ context.Context, namespace string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.generations[namespace]++
+	return nil
+}
+
+func (s *MemoryStore) remove(el *list.Element) {
+	s.order.Remove(el)
+	delete(s.items, el.Value.(*memoryEntry).key)
+}
@@ -0,0 +1,90 @@
+package cache
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// scanBatch DeletePrefix 가 SCAN 한 번에 가져오는 키 수
+const scanBatch = 500
+
+// RedisStore Redis 저장소. 모든 키 앞에 keyPrefix 를 붙임
+type RedisStore struct {
+	client    *redis.Client
+	keyPrefix string
+}
+
+func NewRedisStore(client *redis.Client, keyPrefix string) *RedisStore {
+	return &RedisStore{client: client, keyPrefix: keyPrefix}
+}
+
+func (s *RedisStore) Get(ctx context.Context, key string) ([]byte, bool, error) {
+	value, err := s.client.Get(ctx, s.keyPrefix+key).Bytes()
+	if errors.Is(err, redis.Nil) {
+		return nil, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	return value, true, nil
+}
+
+func (s *RedisStore) Set(ctx context.Context, key string, value []byte, ttl time.Duration) error {
+	return s.client.Set(ctx, s.keyPrefix+key, value, ttl).Err()
+}
+
+// DeletePrefix SCAN 으로 키를 찾아 UNLINK. 서버를 막지 않도록 KEYS 대신 나누어 조회
+func (s *RedisStore) DeletePrefix(ctx context.Context, prefix string) error {
+	iter := s.client.Scan(ctx, 0, globEscape(s.keyPrefix+prefix)+"*", scanBatch).Iterator()
+	keys := make([]string, 0, scanBatch)
+	for iter.Next(ctx) {
+		keys = append(keys, iter.Val())
+		if len(keys) == scanBatch {
+			if err := s.client.Unlink(ctx, keys...).Err(); err != nil {
+				return err
+			}
+			keys = keys[:0]
+		}
+	}
+	if err := iter.Err(); err != nil {
+		return err
+	}
+	if len(keys) > 0 {
+		return s.client.Unlink(ctx, keys...).Err()
+	}
+	return nil
+}
+
+// Generation 세대 키가 없으면 0. 세대 키는 만료되지 않음
+func (s *RedisStore) Generation(ctx context.Context, namespace string) (uint64, error) {
+	generation, err := s.client.Get(ctx, s.generationKey(namespace)).Uint64()
+	if errors.Is(err, redis.Nil) {
+		return 0, nil
+	}
+	return generation, err
+}
+
+// IncrGeneration INCR 로 올리므로 여러 인스턴스가 동시에 무효화해도 세대가 겹치지 않음
+func (s *RedisStore) IncrGeneration(ctx context.Context, namespace string) error {
+	return s.client.Incr(ctx, s.generationKey(namespace)).Err()
+}
+
+// generationKey 항목 키(<namespace>:...)와 겹치지 않도록 별도 접두어 사용
+func (s *RedisStore) generationKey(namespace string) string {
+	return s.keyPrefix + "generation:" + namespace
+}
+
+func (s *RedisStore) Close() error {
+	return s.client.Close()
+}
+
+var globReplacer = strings.NewReplacer(`\`, `\\`, `*`, `\*`, `?`, `\?`, `[`, `\[`, `]`, `\]`)
+
+// globEscape MATCH 패턴에서 특수문자로 해석되지 않도록 이스케이프
+func globEscape(s string) string {
+	return globReplacer.Replace(s)
+}
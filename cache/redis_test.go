@@ -0,0 +1,164 @@
+package cache
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"io"
+	"net"
+	"path"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// fakeRedis GET, SET, INCR, SCAN, UNLINK 만 지원하는 RESP2 서버. 만료는 무시
+type fakeRedis struct {
+	mu   sync.Mutex
+	data map[string]string
+}
+
+func newFakeRedis(t *testing.T) *redis.Client {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	f := &fakeRedis{data: map[string]string{}}
+	go func() {
+		for {
+			conn, err := ln.Accept()
+			if err != nil {
+				return
+			}
+			go f.serve(conn)
+		}
+	}()
+	client := redis.NewClient(&redis.Options{Addr: ln.Addr().String(), Protocol: 2, DisableIndentity: true})
+	t.Cleanup(func() {
+		_ = client.Close()
+		_ = ln.Close()
+	})
+	return client
+}
+
+func (f *fakeRedis) serve(conn net.Conn) {
+	defer conn.Close()
+	r := bufio.NewReader(conn)
+	for {
+		args, err := readCommand(r)
+		if err != nil {
+			return
+		}
+		if _, err := io.WriteString(conn, f.exec(args)); err != nil {
+			return
+		}
+	}
+}
+
+func (f *fakeRedis) exec(args []string) string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch strings.ToUpper(args[0]) {
+	case "GET":
+		v, ok := f.data[args[1]]
+		if !ok {
+			return "$-1\r\n"
+		}
+		return fmt.Sprintf("$%d\r\n%s\r\n", len(v), v)
+	case "SET":
+		f.data[args[1]] = args[2]
+		return "+OK\r\n"
+	case "INCR":
+		n, _ := strconv.Atoi(f.data[args[1]])
+		n++
+		f.data[args[1]] = strconv.Itoa(n)
+		return fmt.Sprintf(":%d\r\n", n)
+	case "SCAN":
+		// SCAN 0 MATCH pattern COUNT n: 한 번에 모두 반환
+		var keys []string
+		for k := range f.data {
+			if ok, _ := path.Match(args[3], k); ok {
+				keys = append(keys, k)
+			}
+		}
+		var b strings.Builder
+		fmt.Fprintf(&b, "*2\r\n$1\r\n0\r\n*%d\r\n", len(keys))
+		for _, k := range keys {
+			fmt.Fprintf(&b, "$%d\r\n%s\r\n", len(k), k)
+		}
+		return b.String()
+	case "UNLINK":
+		for _, k := range args[1:] {
+			delete(f.data, k)
+		}
+		return fmt.Sprintf(":%d\r\n", len(args)-1)
+	case "PING":
+		return "+PONG\r\n"
+	default:
+		return "-ERR unknown command '" + args[0] + "'\r\n"
+	}
+}
+
+// readCommand 클라이언트가 보내는 *<n> 배열의 벌크 문자열들
+func readCommand(r *bufio.Reader) ([]string, error) {
+	line, err := r.ReadString('\n')
+	if err != nil {
+		return nil, err
+	}
+	n, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(line, "*")))
+	if err != nil {
+		return nil, err
+	}
+	args := make([]string, n)
+	for i := range args {
+		if _, err := r.ReadString('\n'); err != nil { // $<len>
+			return nil, err
+		}
+		s, err := r.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		args[i] = strings.TrimSuffix(s, "\r\n")
+	}
+	return args, nil
+}
+
+func TestRedisStore(t *testing.T) {
+	ctx := context.Background()
+	client := newFakeRedis(t)
+	// 두 인스턴스가 같은 Redis 를 사용
+	a := New(NewRedisStore(client, "app:"), time.Minute)
+	b := New(NewRedisStore(client, "app:"), time.Minute)
+
+	if got := fetchTwice(t, b, func() { a.Invalidate(ctx, "ns") }); !got {
+		t.Error("invalidation on other instance not visible")
+	}
+	if got := fetchTwice(t, a, nil); got {
+		t.Error("fetch missed the entry cached by other instance")
+	}
+
+	store := NewRedisStore(client, "app:")
+	steps := []struct {
+		name string
+		do   func() error
+		want uint64
+	}{
+		{"unset namespace", func() error { return nil }, 0},
+		{"incremented", func() error { return store.IncrGeneration(ctx, "fresh") }, 1},
+		{"incremented again", func() error { return store.IncrGeneration(ctx, "fresh") }, 2},
+		// 항목 삭제가 세대 키를 지우지 않음
+		{"survives DeletePrefix", func() error { return store.DeletePrefix(ctx, "fresh:") }, 2},
+	}
+	for _, step := range steps {
+		if err := step.do(); err != nil {
+			t.Fatalf("%s: %v", step.name, err)
+		}
+		if got, err := store.Generation(ctx, "fresh"); err != nil || got != step.want {
+			t.Errorf("%s: generation = %d, %v; want %d", step.name, got, err, step.want)
+		}
+	}
+}
@@ -0,0 +1,418 @@
+// Package config 는 서버 설정을 로드하고 검증합니다.
+//
+// 우선순위: 기본값 < YAML 파일 < 환경변수 < 커맨드라인 플래그
+package config
+
+import (
+	"fmt"
+	"net"
+	"net/url"
+	"time"
+)
+
+type Config struct {
+	Mode      string          `yaml:"mode"` // gin 모드 (debug, release, test)
+	HTTP      HTTPConfig      `yaml:"http"`
+	GRPC      GRPCConfig      `yaml:"grpc"`
+	GraphQL   GraphQLConfig   `yaml:"graphql"`
+	DB        DBConfig        `yaml:"db"`
+	Auth      AuthConfig      `yaml:"auth"`
+	CORS      CORSConfig      `yaml:"cors"`
+	RateLimit RateLimitConfig `yaml:"rate_limit"`
+	Cache     CacheConfig     `yaml:"cache"`
+	Outbox    OutboxConfig    `yaml:"outbox"`
+	Webhook   WebhookConfig   `yaml:"webhook"`
+	MQTT      MQTTConfig      `yaml:"mqtt"`
+	Sorter    SorterConfig    `yaml:"sorter"`
+	Tracing   TracingConfig   `yaml:"tracing"`
+	Log       LogConfig       `yaml:"log"`
+}
+
+type HTTPConfig struct {
+	Port              string        `yaml:"port"`
+	ReadTimeout       time.Duration `yaml:"read_timeout"`
+	ReadHeaderTimeout time.Duration `yaml:"read_header_timeout"`
+	WriteTimeout      time.Duration `yaml:"write_timeout"`
+	IdleTimeout       time.Duration `yaml:"idle_timeout"`
+	MaxHeaderBytes    int           `yaml:"max_header_bytes"`
+	ShutdownTimeout   time.Duration `yaml:"shutdown_timeout"`
+	DrainDelay        time.Duration `yaml:"drain_delay"`
+}
+
+// GRPCConfig REST 와 같은 서비스 계층과 인증을 사용하는 gRPC 서버. HTTP 와 다른 포트에서 실행
+type GRPCConfig struct {
+	Enabled bool   `yaml:"enabled"`
+	Port    string `yaml:"port"`
+	// WatchResync Watch 스트림이 이벤트와 별개로 현재 상태를 다시 확인하는 주기.
+	// 아웃박스 이벤트는 처리한 인스턴스의 버스에만 전달되므로 다른 인스턴스의 변경은 이 주기로 반영됨
+	WatchResync time.Duration `yaml:"watch_resync"`
+	Reflection  bool          `yaml:"reflection"` // grpcurl 등에서 서비스 목록을 조회할 수 있게 함
+}
+
+// GraphQLConfig 대시보드용 /graphql 엔드포인트. 구독은 같은 경로의 WebSocket(graphql-transport-ws)으로 제공
+type GraphQLConfig struct {
+	Enabled       bool `yaml:"enabled"`
+	MaxDepth      int  `yaml:"max_depth"`     // 쿼리 중첩 깊이 제한
+	MaxPageSize   int  `yaml:"max_page_size"` // 목록 조회 한 페이지의 최대 크기
+	Introspection bool `yaml:"introspection"`
+	// KeepAlive 구독 연결에 ping 을 보내는 주기 (프록시의 유휴 연결 종료 방지)
+	KeepAlive time.Duration `yaml:"keep_alive"`
+	// WatchResync 구독이 이벤트와 별개로 현재 상태를 다시 확인하는 주기 (grpc.watch_resync 와 같은 이유)
+	WatchResync time.Duration `yaml:"watch_resync"`
+}
+
+// DB 드라이버 이름
+const (
+	DriverMySQL    = "mysql"
+	DriverPostgres = "postgres"
+	DriverSQLite   = "sqlite" // cgo 필요, 로컬 데모/테스트용
+)
+
+type DBConfig struct {
+	Driver          string        `yaml:"driver"` // mysql, postgres, sqlite
+	Host            string        `yaml:"host"`
+	Port            string        `yaml:"port"`
+	User            string        `yaml:"user"`
+	Password        string        `yaml:"password"`
+	Name            string        `yaml:"name"`     // sqlite 는 파일 경로
+	SSLMode         string        `yaml:"ssl_mode"` // postgres 전용
+	MaxOpenConns    int           `yaml:"max_open_conns"`
+	MaxIdleConns    int           `yaml:"max_idle_conns"`
+	ConnMaxLifetime time.Duration `yaml:"conn_max_lifetime"`
+	ConnMaxIdleTime time.Duration `yaml:"conn_max_idle_time"`
+	AutoMigrate     bool          `yaml:"auto_migrate"` // 시작 시 모델 기준으로 테이블/컬럼 생성. 끄면 누락된 테이블/컬럼이 있을 때 시작 실패
+	// ConnectMaxWait 시작 시 DB 가 준비될 때까지 재시도하며 기다리는 최대 시간 (0 이면 한 번만 시도)
+	ConnectMaxWait time.Duration `yaml:"connect_max_wait"`
+	// ConnectBackoff 첫 재시도 간격. 실패할 때마다 두 배로 늘어남
+	ConnectBackoff time.Duration `yaml:"connect_backoff"`
+	// QueryTimeout 쿼리 하나의 최대 실행 시간. 요청 컨텍스트의 남은 시간이 더 짧으면 그쪽을 따름 (0 이면 제한 없음)
+	QueryTimeout time.Duration `yaml:"query_timeout"`
+	// DeadlockRetries 교착 상태로 롤백된 트랜잭션을 다시 실행하는 최대 횟수
+	DeadlockRetries int `yaml:"deadlock_retries"`
+	// Replicas 목록/검색 조회를 보낼 읽기 전용 복제본 주소(host 또는 host:port). 계정과 DB 이름은 주 DB 와 같음
+	Replicas []string `yaml:"replicas"`
+	// ReplicaCheckInterval 복제본 상태 확인 주기. 응답하지 않는 복제본은 복구될 때까지 제외하고, 모두 내려가면 주 DB 로 조회
+	ReplicaCheckInterval time.Duration `yaml:"replica_check_interval"`
+}
+
+type AuthConfig struct {
+	JWTSecret string         `yaml:"jwt_secret"`
+	TokenTTL  time.Duration  `yaml:"token_ttl"`
+	Lockout   LockoutConfig  `yaml:"lockout"`
+	Password  PasswordConfig `yaml:"password"`
+	Session   SessionConfig  `yaml:"session"`
+	// StatusCacheTTL 요청마다 확인하는 직원 활성 상태/직급의 캐시 유지 시간 (0 이면 매 요청 조회)
+	StatusCacheTTL time.Duration `yaml:"status_cache_ttl"`
+	// APIKeys 장비와 연동 시스템에 발급한 X-API-Key 값. 목록에 없는 키는 API 키로 인정하지 않으며, 비어 있으면 분류기 스캔을 받지 않음
+	APIKeys []string `yaml:"api_keys"`
+}
+
+// SessionConfig 웹 대시보드용 쿠키 세션. 활성화하면 로그인 시 HttpOnly 토큰 쿠키와
+// double-submit CSRF 쿠키를 함께 발급하고, 인증 미들웨어가 Bearer 헤더 대신 쿠키도 받음
+type SessionConfig struct {
+	Enabled        bool   `yaml:"enabled"`
+	CookieName     string `yaml:"cookie_name"`
+	CSRFCookieName string `yaml:"csrf_cookie_name"`
+	CSRFHeader     string `yaml:"csrf_header"`
+	Domain         string `yaml:"domain"`
+	Path           string `yaml:"path"`
+	Secure         bool   `yaml:"secure"`
+	SameSite       string `yaml:"same_site"` // lax, strict, none
+}
+
+// PasswordConfig 비밀번호 정책과 해시 설정
+type PasswordConfig struct {
+	MinLength        int           `yaml:"min_length"`
+	MinClasses       int           `yaml:"min_classes"`        // 대문자, 소문자, 숫자, 특수문자 중 최소 포함 종류 수
+	BreachedListFile string        `yaml:"breached_list_file"` // 유출된 비밀번호 목록 (한 줄에 하나)
+	BcryptCost       int           `yaml:"bcrypt_cost"`
+	ResetTokenTTL    time.Duration `yaml:"reset_token_ttl"`
+}
+
+// LockoutConfig 로그인 연속 실패 시 계정 잠금 정책.
+// MaxFailedLogins 회 실패하면 BaseDuration 만큼 잠그고, 이후 실패할 때마다 두 배씩 늘려 MaxDuration 까지 잠금
+type LockoutConfig struct {
+	MaxFailedLogins int           `yaml:"max_failed_logins"` // 0 이면 잠금 비활성화
+	BaseDuration    time.Duration `yaml:"base_duration"`
+	MaxDuration     time.Duration `yaml:"max_duration"`
+}
+
+// CORSConfig 자격 증명(쿠키, Authorization)을 허용하므로 와일드카드 없이 명시한 출처만 허용
+type CORSConfig struct {
+	AllowOrigins []string `yaml:"allow_origins"`
+}
+
+// RateLimitConfig 토큰 버킷 기반 요청 제한 설정. 분당 허용 횟수가 0 이면 해당 제한은 비활성화
+type RateLimitConfig struct {
+	Enabled  bool          `yaml:"enabled"`
+	Login    RateRule      `yaml:"login"`    // 로그인 요청 (IP 기준)
+	IP       RateRule      `yaml:"ip"`       // 전체 API (IP 기준)
+	Employee RateRule      `yaml:"employee"` // 인증된 API (직원 기준)
+	APIKey   RateRule      `yaml:"api_key"`  // 등록된 X-API-Key 기준 (등록되지 않은 키는 IP 기준으로만 제한)
+	IdleTTL  time.Duration `yaml:"idle_ttl"` // 사용되지 않은 버킷을 정리하는 주기
+}
+
+type RateRule struct {
+	PerMinute int `yaml:"per_minute"`
+	Burst     int `yaml:"burst"`
+}
+
+// CacheConfig 지역, 차량 조회 응답 캐시 설정. 여러 인스턴스가 무효화를 공유해야 하면 backend 를 redis 로 지정
+type CacheConfig struct {
+	Enabled    bool          `yaml:"enabled"`
+	Backend    string        `yaml:"backend"`     // memory, redis
+	TTL        time.Duration `yaml:"ttl"`         // 캐시 항목 유지 시간이자 Cache-Control max-age
+	MaxEntries int           `yaml:"max_entries"` // backend=memory 일 때 보관하는 최대 항목 수 (LRU)
+	Redis      RedisConfig   `yaml:"redis"`
+}
+
+// RedisConfig backend=redis 일 때 접속 설정
+type RedisConfig struct {
+	Addr      string `yaml:"addr"` // host:port
+	Password  string `yaml:"password"`
+	DB        int    `yaml:"db"`
+	KeyPrefix string `yaml:"key_prefix"` // 같은 Redis 를 쓰는 다른 서비스와 키가 겹치지 않도록 붙이는 접두사
+}
+
+// OutboxConfig 아웃박스 디스패처 설정
+type OutboxConfig struct {
+	Enabled      bool          `yaml:"enabled"`       // false 면 디스패처를 띄우지 않음 (이벤트는 계속 기록됨)
+	PollInterval time.Duration `yaml:"poll_interval"` // 처리되지 않은 이벤트 조회 주기
+	BatchSize    int           `yaml:"batch_size"`    // 한 트랜잭션에서 잠그고 처리하는 이벤트 수
+	MaxAttempts  int           `yaml:"max_attempts"`  // 넘으면 전달을 포기하고 다음 이벤트로 진행
+	Retention    time.Duration `yaml:"retention"`     // 처리 완료된 이벤트 보관 기간 (0 이면 삭제하지 않음)
+}
+
+// WebhookConfig 웹훅 전송 워커 설정. 실패한 전송은 BaseBackoff 부터 두 배씩 늘려 MaxBackoff 까지 간격을 두고 재시도
+type WebhookConfig struct {
+	Enabled      bool          `yaml:"enabled"`       // false 면 전송 워커를 띄우지 않음 (대기 행은 계속 쌓임)
+	PollInterval time.Duration `yaml:"poll_interval"` // 전송 대기 행 조회 주기
+	BatchSize    int           `yaml:"batch_size"`    // 한 번에 가져오는 전송 수
+	Timeout      time.Duration `yaml:"timeout"`       // 요청 하나의 응답 대기 시간
+	MaxAttempts  int           `yaml:"max_attempts"`
+	BaseBackoff  time.Duration `yaml:"base_backoff"`
+	MaxBackoff   time.Duration `yaml:"max_backoff"`
+	// AllowPrivateTargets 루프백, 사설망 주소로의 전송 허용 (개발 환경 전용). 끄면 구독 생성과 전송 모두에서 차단
+	AllowPrivateTargets bool `yaml:"allow_private_targets"`
+}
+
+// MQTTConfig 차량/분류기 MQTT 브리지 설정. 수신 토픽의 + 자리에는 차량 ID 또는 분류기 ID 가 옴
+type MQTTConfig struct {
+	Enabled        bool          `yaml:"enabled"`
+	BrokerURL      string        `yaml:"broker_url"` // tcp://host:1883, ssl://host:8883, ws://host:9001
+	ClientID       string        `yaml:"client_id"`  // 비어 있으면 인스턴스마다 임의로 생성
+	Username       string        `yaml:"username"`
+	Password       string        `yaml:"password"`
+	QoS            int           `yaml:"qos"`
+	ConnectTimeout time.Duration `yaml:"connect_timeout"` // 연결, 구독 응답 대기 시간
+	HandlerTimeout time.Duration `yaml:"handler_timeout"` // 수신 메시지 하나의 처리 한도
+	PublishTimeout time.Duration `yaml:"publish_timeout"` // 차량 명령 발행 응답 대기 시간
+	// SharedGroup 설정하면 $share/<group>/ 공유 구독으로 여러 인스턴스 중 하나만 메시지를 받음
+	SharedGroup    string `yaml:"shared_group"`
+	TelemetryTopic string `yaml:"telemetry_topic"`
+	ScanTopic      string `yaml:"scan_topic"`
+	BinFullTopic   string `yaml:"bin_full_topic"`
+	CommandTopic   string `yaml:"command_topic"` // {vehicle_id} 를 차량 ID 로 치환
+}
+
+// SorterConfig 분류기 스캔 처리 설정
+type SorterConfig struct {
+	// ScanTimeout 스캔 하나의 처리 한도. 택배가 스캐너에서 분기점까지 가는 시간보다 짧아야 하며,
+	// 넘으면 결정 없이 503 으로 응답하므로 분류기는 오버플로 라인으로 보냄
+	ScanTimeout time.Duration `yaml:"scan_timeout"`
+}
+
+type TracingConfig struct {
+	Exporter     string  `yaml:"exporter"` // none, stdout, file, otlp
+	ServiceName  string  `yaml:"service_name"`
+	SampleRatio  float64 `yaml:"sample_ratio"`
+	FilePath     string  `yaml:"file_path"`     // exporter=file 일 때 출력 경로
+	OTLPEndpoint string  `yaml:"otlp_endpoint"` // host:port (예: localhost:4318)
+	OTLPInsecure bool    `yaml:"otlp_insecure"`
+}
+
+type LogConfig struct {
+	Level              string        `yaml:"level"`  // debug, info, warn, error
+	Format             string        `yaml:"format"` // json, text
+	SlowQueryThreshold time.Duration `yaml:"slow_query_threshold"`
+}
+
+// Default 기본 설정값
+func Default() Config {
+	return Config{
+		Mode: "debug",
+		HTTP: HTTPConfig{
+			Port:              "8080",
+			ReadTimeout:       15 * time.Second,
+			ReadHeaderTimeout: 5 * time.Second,
+			WriteTimeout:      30 * time.Second,
+			IdleTimeout:       60 * time.Second,
+			MaxHeaderBytes:    1 << 20,
+			ShutdownTimeout:   20 * time.Second,
+			DrainDelay:        5 * time.Second,
+		},
+		GRPC: GRPCConfig{
+			Enabled:     true,
+			Port:        "9090",
+			WatchResync: 15 * time.Second,
+			Reflection:  true,
+		},
+		GraphQL: GraphQLConfig{
+			Enabled:       true,
+			MaxDepth:      8,
+			MaxPageSize:   100,
+			Introspection: true,
+			KeepAlive:     15 * time.Second,
+			WatchResync:   15 * time.Second,
+		},
+		DB: DBConfig{
+			Host:                 "127.0.0.1",
+			Driver:               DriverMySQL,
+			Port:                 "", // 비어 있으면 드라이버 기본 포트
+			SSLMode:              "disable",
+			User:                 "root",
+			Password:             "password",
+			Name:                 "my_database",
+			MaxOpenConns:         25,
+			MaxIdleConns:         10,
+			ConnMaxLifetime:      30 * time.Minute,
+			ConnMaxIdleTime:      5 * time.Minute,
+			ConnectMaxWait:       time.Minute,
+			ConnectBackoff:       time.Second,
+			QueryTimeout:         10 * time.Second,
+			DeadlockRetries:      3,
+			ReplicaCheckInterval: 5 * time.Second,
+		},
+		Auth: AuthConfig{
+			TokenTTL:       8 * time.Hour,
+			StatusCacheTTL: 30 * time.Second,
+			Session: SessionConfig{
+				CookieName:     "token",
+				CSRFCookieName: "csrf_token",
+				CSRFHeader:     "X-CSRF-Token",
+				Path:           "/",
+				Secure:         true,
+				SameSite:       "lax",
+			},
+			Lockout: LockoutConfig{
+				MaxFailedLogins: 5,
+				BaseDuration:    time.Minute,
+				MaxDuration:     time.Hour,
+			},
+			Password: PasswordConfig{
+				MinLength:     10,
+				MinClasses:    3,
+				BcryptCost:    12,
+				ResetTokenTTL: time.Hour,
+			},
+		},
+		CORS: CORSConfig{
+			AllowOrigins: []string{"http://localhost:3000"},
+		},
+		RateLimit: RateLimitConfig{
+			Enabled:  true,
+			Login:    RateRule{PerMinute: 10, Burst: 5},
+			IP:       RateRule{PerMinute: 600, Burst: 100},
+			Employee: RateRule{PerMinute: 300, Burst: 60},
+			APIKey:   RateRule{PerMinute: 1200, Burst: 200},
+			IdleTTL:  10 * time.Minute,
+		},
+		Cache: CacheConfig{
+			Enabled:    true,
+			Backend:    "memory",
+			TTL:        5 * time.Second,
+			MaxEntries: 1000,
+			Redis: RedisConfig{
+				Addr:      "localhost:6379",
+				KeyPrefix: "go-api-server:",
+			},
+		},
+		Outbox: OutboxConfig{
+			Enabled:      true,
+			PollInterval: time.Second,
+			BatchSize:    100,
+			MaxAttempts:  20,
+			Retention:    7 * 24 * time.Hour,
+		},
+		Webhook: WebhookConfig{
+			Enabled:      true,
+			PollInterval: 5 * time.Second,
+			BatchSize:    20,
+			Timeout:      10 * time.Second,
+			MaxAttempts:  8,
+			BaseBackoff:  30 * time.Second,
+			MaxBackoff:   time.Hour,
+		},
+		MQTT: MQTTConfig{
+			BrokerURL:      "tcp://localhost:1883",
+			QoS:            1,
+			ConnectTimeout: 10 * time.Second,
+			HandlerTimeout: 5 * time.Second,
+			PublishTimeout: 5 * time.Second,
+			SharedGroup:    "go-api-server",
+			TelemetryTopic: "vehicles/+/telemetry",
+			ScanTopic:      "sorter/+/scanned",
+			BinFullTopic:   "sorter/+/bin_full",
+			CommandTopic:   "vehicles/{vehicle_id}/commands",
+		},
+		Sorter: SorterConfig{
+			ScanTimeout: 300 * time.Millisecond,
+		},
+		Tracing: TracingConfig{
+			Exporter:     "none",
+			ServiceName:  "go-api-server",
+			SampleRatio:  1,
+			FilePath:     "traces.jsonl",
+			OTLPEndpoint: "localhost:4318",
+		},
+		Log: LogConfig{
+			Level:              "info",
+			Format:             "json",
+			SlowQueryThreshold: 200 * time.Millisecond,
+		},
+	}
+}
+
+// IsRelease 릴리스 모드 여부
+func (c Config) IsRelease() bool {
+	return c.Mode == "release"
+}
+
+// DSN 드라이버별 접속 문자열
+func (c DBConfig) DSN() string {
+	switch c.Driver {
+	case DriverPostgres:
+		u := url.URL{
+			Scheme:   "postgres",
+			User:     url.UserPassword(c.User, c.Password),
+			Host:     net.JoinHostPort(c.Host, c.portOr("5432")),
+			Path:     "/" + c.Name,
+			RawQuery: url.Values{"sslmode": {c.SSLMode}}.Encode(),
+		}
+		return u.String()
+	case DriverSQLite:
+		// SQLite 는 외래 키 검사가 기본으로 꺼져 있고, 쓰기 트랜잭션끼리 잠금 승격 중 충돌하지 않도록 시작부터 쓰기 잠금을 잡는다
+		return "file:" + c.Name + "?_foreign_keys=on&_busy_timeout=5000&_txlock=immediate&_journal_mode=WAL"
+	default:
+		return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
+			c.User, c.Password, c.Host, c.portOr("3306"), c.Name)
+	}
+}
+
+// ForReplica 주소만 복제본(host 또는 host:port)으로 바꾼 설정
+func (c DBConfig) ForReplica(addr string) DBConfig {
+	r := c
+	r.Host, r.Port = addr, ""
+	if host, port, err := net.SplitHostPort(addr); err == nil {
+		r.Host, r.Port = host, port
+	}
+	r.Replicas = nil
+	return r
+}
+
+func (c DBConfig) portOr(def string) string {
+	if c.Port == "" {
+		return def
+	}
+	return c.Port
+}
@@ -0,0 +1,590 @@
+package config
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"gopkg.in/yaml.v3"
+)
+
+// minReleaseSecretLen 릴리스 모드에서 요구하는 JWT 시크릿 최소 길이
+const minReleaseSecretLen = 32
+
+// minAPIKeyLen 추측할 수 없도록 API 키에 요구하는 최소 길이
+const minAPIKeyLen = 24
+
+// Load 플래그, YAML 파일, 환경변수를 순서대로 읽어 검증된 설정을 반환
+func Load(args []string) (*Config, error) {
+	fs := flag.NewFlagSet("go-api-server", flag.ContinueOnError)
+	configPath := fs.String("config", os.Getenv("CONFIG_FILE"), "YAML 설정 파일 경로")
+	mode := fs.String("mode", "", "gin 모드 (debug, release, test)")
+	port := fs.String("port", "", "HTTP 포트")
+	if err := fs.Parse(args); err != nil {
+		return nil, err
+	}
+
+	cfg := Default()
+
+	if *configPath != "" {
+		if err := cfg.loadFile(*configPath); err != nil {
+			return nil, err
+		}
+	}
+
+	var errs []error
+	cfg.loadEnv(&errs)
+	if len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	if *mode != "" {
+		cfg.Mode = *mode
+	}
+	if *port != "" {
+		cfg.HTTP.Port = *port
+	}
+
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	return &cfg, nil
+}
+
+func (c *Config) loadFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("설정 파일 읽기 실패: %w", err)
+	}
+	defer f.Close()
+	dec := yaml.NewDecoder(f)
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil {
+		return fmt.Errorf("설정 파일 파싱 실패 (%s): %w", path, err)
+	}
+	return nil
+}
+
+func (c *Config) loadEnv(errs *[]error) {
+	envString(&c.Mode, "GIN_MODE")
+
+	envString(&c.HTTP.Port, "BACKEND_PORT")
+	envDuration(&c.HTTP.ReadTimeout, "HTTP_READ_TIMEOUT", errs)
+	envDuration(&c.HTTP.ReadHeaderTimeout, "HTTP_READ_HEADER_TIMEOUT", errs)
+	envDuration(&c.HTTP.WriteTimeout, "HTTP_WRITE_TIMEOUT", errs)
+	envDuration(&c.HTTP.IdleTimeout, "HTTP_IDLE_TIMEOUT", errs)
+	envInt(&c.HTTP.MaxHeaderBytes, "HTTP_MAX_HEADER_BYTES", errs)
+	envDuration(&c.HTTP.ShutdownTimeout, "SHUTDOWN_TIMEOUT", errs)
+	envDuration(&c.HTTP.DrainDelay, "SHUTDOWN_DRAIN_DELAY", errs)
+
+	envBool(&c.GRPC.Enabled, "GRPC_ENABLED", errs)
+	envString(&c.GRPC.Port, "GRPC_PORT")
+	envDuration(&c.GRPC.WatchResync, "GRPC_WATCH_RESYNC", errs)
+	envBool(&c.GRPC.Reflection, "GRPC_REFLECTION", errs)
+
+	envBool(&c.GraphQL.Enabled, "GRAPHQL_ENABLED", errs)
+	envInt(&c.GraphQL.MaxDepth, "GRAPHQL_MAX_DEPTH", errs)
+	envInt(&c.GraphQL.MaxPageSize, "GRAPHQL_MAX_PAGE_SIZE", errs)
+	envBool(&c.GraphQL.Introspection, "GRAPHQL_INTROSPECTION", errs)
+	envDuration(&c.GraphQL.KeepAlive, "GRAPHQL_KEEP_ALIVE", errs)
+	envDuration(&c.GraphQL.WatchResync, "GRAPHQL_WATCH_RESYNC", errs)
+
+	envString(&c.DB.Driver, "DB_DRIVER")
+	envString(&c.DB.Host, "DB_HOST")
+	envString(&c.DB.Port, "DB_PORT")
+	envString(&c.DB.User, "DB_USER")
+	envString(&c.DB.Password, "DB_PASSWORD")
+	envString(&c.DB.Name, "DB_NAME")
+	envString(&c.DB.SSLMode, "DB_SSLMODE")
+	envInt(&c.DB.MaxOpenConns, "DB_MAX_OPEN_CONNS", errs)
+	envInt(&c.DB.MaxIdleConns, "DB_MAX_IDLE_CONNS", errs)
+	envDuration(&c.DB.ConnMaxLifetime, "DB_CONN_MAX_LIFETIME", errs)
+	envDuration(&c.DB.ConnMaxIdleTime, "DB_CONN_MAX_IDLE_TIME", errs)
+	envBool(&c.DB.AutoMigrate, "DB_AUTO_MIGRATE", errs)
+	envDuration(&c.DB.ConnectMaxWait, "DB_CONNECT_MAX_WAIT", errs)
+	envDuration(&c.DB.ConnectBackoff, "DB_CONNECT_BACKOFF", errs)
+	envDuration(&c.DB.QueryTimeout, "DB_QUERY_TIMEOUT", errs)
+	envInt(&c.DB.DeadlockRetries, "DB_DEADLOCK_RETRIES", errs)
+	envList(&c.DB.Replicas, "DB_REPLICAS")
+	envDuration(&c.DB.ReplicaCheckInterval, "DB_REPLICA_CHECK_INTERVAL", errs)
+
+	envString(&c.Auth.JWTSecret, "JWT_SECRET")
+	envDuration(&c.Auth.TokenTTL, "JWT_TOKEN_TTL", errs)
+	envDuration(&c.Auth.StatusCacheTTL, "AUTH_STATUS_CACHE_TTL", errs)
+	envList(&c.Auth.APIKeys, "AUTH_API_KEYS")
+	envBool(&c.Auth.Session.Enabled, "SESSION_COOKIE_ENABLED", errs)
+	envString(&c.Auth.Session.CookieName, "SESSION_COOKIE_NAME")
+	envString(&c.Auth.Session.Domain, "SESSION_COOKIE_DOMAIN")
+	envBool(&c.Auth.Session.Secure, "SESSION_COOKIE_SECURE", errs)
+	envString(&c.Auth.Session.SameSite, "SESSION_COOKIE_SAMESITE")
+	envString(&c.Auth.Session.CSRFCookieName, "SESSION_CSRF_COOKIE_NAME")
+	envString(&c.Auth.Session.CSRFHeader, "SESSION_CSRF_HEADER")
+	envInt(&c.Auth.Lockout.MaxFailedLogins, "LOCKOUT_MAX_FAILED_LOGINS", errs)
+	envDuration(&c.Auth.Lockout.BaseDuration, "LOCKOUT_BASE_DURATION", errs)
+	envDuration(&c.Auth.Lockout.MaxDuration, "LOCKOUT_MAX_DURATION", errs)
+	envInt(&c.Auth.Password.MinLength, "PASSWORD_MIN_LENGTH", errs)
+	envInt(&c.Auth.Password.MinClasses, "PASSWORD_MIN_CLASSES", errs)
+	envString(&c.Auth.Password.BreachedListFile, "PASSWORD_BREACHED_LIST_FILE")
+	envInt(&c.Auth.Password.BcryptCost, "PASSWORD_BCRYPT_COST", errs)
+	envDuration(&c.Auth.Password.ResetTokenTTL, "PASSWORD_RESET_TOKEN_TTL", errs)
+
+	envList(&c.CORS.AllowOrigins, "FRONTEND_URL")
+	envList(&c.CORS.AllowOrigins, "CORS_ALLOW_ORIGINS")
+
+	envBool(&c.RateLimit.Enabled, "RATE_LIMIT_ENABLED", errs)
+	envRateRule(&c.RateLimit.Login, "RATE_LIMIT_LOGIN", errs)
+	envRateRule(&c.RateLimit.IP, "RATE_LIMIT_IP", errs)
+	envRateRule(&c.RateLimit.Employee, "RATE_LIMIT_EMPLOYEE", errs)
+	envRateRule(&c.RateLimit.APIKey, "RATE_LIMIT_API_KEY", errs)
+	envDuration(&c.RateLimit.IdleTTL, "RATE_LIMIT_IDLE_TTL", errs)
+
+	envBool(&c.Cache.Enabled, "CACHE_ENABLED", errs)
+	envString(&c.Cache.Backend, "CACHE_BACKEND")
+	envDuration(&c.Cache.TTL, "CACHE_TTL", errs)
+	envInt(&c.Cache.MaxEntries, "CACHE_MAX_ENTRIES", errs)
+	envString(&c.Cache.Redis.Addr, "REDIS_ADDR")
+	envString(&c.Cache.Redis.Password, "REDIS_PASSWORD")
+	envInt(&c.Cache.Redis.DB, "REDIS_DB", errs)
+	envString(&c.Cache.Redis.KeyPrefix, "REDIS_KEY_PREFIX")
+
+	envBool(&c.Outbox.Enabled, "OUTBOX_ENABLED", errs)
+	envDuration(&c.Outbox.PollInterval, "OUTBOX_POLL_INTERVAL", errs)
+	envInt(&c.Outbox.BatchSize, "OUTBOX_BATCH_SIZE", errs)
+	envInt(&c.Outbox.MaxAttempts, "OUTBOX_MAX_ATTEMPTS", errs)
+	envDuration(&c.Outbox.Retention, "OUTBOX_RETENTION", errs)
+
+	envBool(&c.Webhook.Enabled, "WEBHOOK_ENABLED", errs)
+	envDuration(&c.Webhook.PollInterval, "WEBHOOK_POLL_INTERVAL", errs)
+	envInt(&c.Webhook.BatchSize, "WEBHOOK_BATCH_SIZE", errs)
+	envDuration(&c.Webhook.Timeout, "WEBHOOK_TIMEOUT", errs)
+	envInt(&c.Webhook.MaxAttempts, "WEBHOOK_MAX_ATTEMPTS", errs)
+	envDuration(&c.Webhook.BaseBackoff, "WEBHOOK_BASE_BACKOFF", errs)
+	envDuration(&c.Webhook.MaxBackoff, "WEBHOOK_MAX_BACKOFF", errs)
+	envBool(&c.Webhook.AllowPrivateTargets, "WEBHOOK_ALLOW_PRIVATE_TARGETS", errs)
+
+	envBool(&c.MQTT.Enabled, "MQTT_ENABLED", errs)
+	envString(&c.MQTT.BrokerURL, "MQTT_BROKER_URL")
+	envString(&c.MQTT.ClientID, "MQTT_CLIENT_ID")
+	envString(&c.MQTT.Username, "MQTT_USERNAME")
+	envString(&c.MQTT.Password, "MQTT_PASSWORD")
+	envInt(&c.MQTT.QoS, "MQTT_QOS", errs)
+	envDuration(&c.MQTT.ConnectTimeout, "MQTT_CONNECT_TIMEOUT", errs)
+	envDuration(&c.MQTT.HandlerTimeout, "MQTT_HANDLER_TIMEOUT", errs)
+	envDuration(&c.MQTT.PublishTimeout, "MQTT_PUBLISH_TIMEOUT", errs)
+	envString(&c.MQTT.SharedGroup, "MQTT_SHARED_GROUP")
+	envString(&c.MQTT.TelemetryTopic, "MQTT_TELEMETRY_TOPIC")
+	envString(&c.MQTT.ScanTopic, "MQTT_SCAN_TOPIC")
+	envString(&c.MQTT.BinFullTopic, "MQTT_BIN_FULL_TOPIC")
+	envString(&c.MQTT.CommandTopic, "MQTT_COMMAND_TOPIC")
+
+	envDuration(&c.Sorter.ScanTimeout, "SORTER_SCAN_TIMEOUT", errs)
+
+	envString(&c.Tracing.Exporter, "TRACING_EXPORTER")
+	envString(&c.Tracing.ServiceName, "TRACING_SERVICE_NAME")
+	envFloat(&c.Tracing.SampleRatio, "TRACING_SAMPLE_RATIO", errs)
+	envString(&c.Tracing.FilePath, "TRACING_FILE")
+	envString(&c.Tracing.OTLPEndpoint, "TRACING_OTLP_ENDPOINT")
+	envBool(&c.Tracing.OTLPInsecure, "TRACING_OTLP_INSECURE", errs)
+
+	envString(&c.Log.Level, "LOG_LEVEL")
+	envString(&c.Log.Format, "LOG_FORMAT")
+	envDuration(&c.Log.SlowQueryThreshold, "LOG_SLOW_QUERY_THRESHOLD", errs)
+}
+
+// Validate 설정값 검증. 릴리스 모드에서는 안전하지 않은 값이 있으면 실패
+func (c *Config) Validate() error {
+	var errs []error
+
+	switch c.Mode {
+	case "debug", "release", "test":
+	default:
+		errs = append(errs, fmt.Errorf("mode 는 debug, release, test 중 하나여야 합니다: %q", c.Mode))
+	}
+
+	if n, err := strconv.Atoi(c.HTTP.Port); err != nil || n <= 0 || n > 65535 {
+		errs = append(errs, fmt.Errorf("http.port 가 올바르지 않습니다: %q", c.HTTP.Port))
+	}
+	if c.HTTP.MaxHeaderBytes <= 0 {
+		errs = append(errs, errors.New("http.max_header_bytes 는 0보다 커야 합니다"))
+	}
+	if c.HTTP.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("http.shutdown_timeout 은 0보다 커야 합니다"))
+	}
+
+	if c.GRPC.Enabled {
+		if n, err := strconv.Atoi(c.GRPC.Port); err != nil || n <= 0 || n > 65535 {
+			errs = append(errs, fmt.Errorf("grpc.port 가 올바르지 않습니다: %q", c.GRPC.Port))
+		} else if c.GRPC.Port == c.HTTP.Port {
+			errs = append(errs, errors.New("grpc.port 는 http.port 와 달라야 합니다"))
+		}
+		if c.GRPC.WatchResync <= 0 {
+			errs = append(errs, errors.New("grpc.watch_resync 는 0보다 커야 합니다"))
+		}
+	}
+
+	if c.GraphQL.Enabled {
+		if c.GraphQL.MaxDepth <= 0 || c.GraphQL.MaxPageSize <= 0 {
+			errs = append(errs, errors.New("graphql.max_depth, graphql.max_page_size 는 0보다 커야 합니다"))
+		}
+		if c.GraphQL.KeepAlive <= 0 || c.GraphQL.WatchResync <= 0 {
+			errs = append(errs, errors.New("graphql.keep_alive, graphql.watch_resync 는 0보다 커야 합니다"))
+		}
+	}
+
+	switch c.DB.Driver {
+	case DriverSQLite:
+		if c.DB.Name == "" {
+			errs = append(errs, errors.New("db.name(파일 경로) 은 필수입니다"))
+		}
+	case DriverMySQL, DriverPostgres:
+		if c.DB.Host == "" || c.DB.Name == "" || c.DB.User == "" {
+			errs = append(errs, errors.New("db.host, db.name, db.user 는 필수입니다"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("db.driver 는 mysql, postgres, sqlite 중 하나여야 합니다: %q", c.DB.Driver))
+	}
+	if c.DB.Port != "" {
+		if n, err := strconv.Atoi(c.DB.Port); err != nil || n <= 0 || n > 65535 {
+			errs = append(errs, fmt.Errorf("db.port 가 올바르지 않습니다: %q", c.DB.Port))
+		}
+	}
+	if c.DB.MaxOpenConns < 0 || c.DB.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("db 커넥션 풀 크기는 음수일 수 없습니다"))
+	}
+	if c.DB.MaxOpenConns > 0 && c.DB.MaxIdleConns > c.DB.MaxOpenConns {
+		errs = append(errs, errors.New("db.max_idle_conns 는 db.max_open_conns 보다 클 수 없습니다"))
+	}
+	if c.DB.ConnectMaxWait < 0 || c.DB.QueryTimeout < 0 || c.DB.DeadlockRetries < 0 {
+		errs = append(errs, errors.New("db.connect_max_wait, db.query_timeout, db.deadlock_retries 는 음수일 수 없습니다"))
+	}
+	if len(c.DB.Replicas) > 0 {
+		if c.DB.Driver == DriverSQLite {
+			errs = append(errs, errors.New("db.replicas 는 sqlite 에서 사용할 수 없습니다"))
+		}
+		if c.DB.ReplicaCheckInterval <= 0 {
+			errs = append(errs, errors.New("db.replica_check_interval 은 0보다 커야 합니다"))
+		}
+	}
+	if c.DB.ConnectMaxWait > 0 && c.DB.ConnectBackoff <= 0 {
+		errs = append(errs, errors.New("db.connect_max_wait 를 사용하면 db.connect_backoff 는 0보다 커야 합니다"))
+	}
+
+	if c.Auth.TokenTTL <= 0 {
+		errs = append(errs, errors.New("auth.token_ttl 은 0보다 커야 합니다"))
+	}
+	if c.Auth.JWTSecret == "" {
+		if c.IsRelease() {
+			errs = append(errs, errors.New("릴리스 모드에서는 JWT_SECRET 이 필수입니다"))
+		} else {
+			// 개발 환경에서는 임시 시크릿을 생성 (재시작 시 기존 토큰은 무효화됨)
+			c.Auth.JWTSecret = randomSecret()
+			slog.Warn("JWT_SECRET 이 비어 있어 임시 시크릿을 생성했습니다")
+		}
+	} else if c.IsRelease() && len(c.Auth.JWTSecret) < minReleaseSecretLen {
+		errs = append(errs, fmt.Errorf("릴리스 모드에서는 JWT_SECRET 이 최소 %d자 이상이어야 합니다", minReleaseSecretLen))
+	}
+
+	if c.Auth.StatusCacheTTL < 0 {
+		errs = append(errs, errors.New("auth.status_cache_ttl 은 음수일 수 없습니다"))
+	}
+	for i, key := range c.Auth.APIKeys {
+		if len(key) < minAPIKeyLen {
+			errs = append(errs, fmt.Errorf("auth.api_keys[%d] 는 최소 %d자 이상이어야 합니다", i, minAPIKeyLen))
+		}
+	}
+	if c.Auth.Lockout.MaxFailedLogins < 0 {
+		errs = append(errs, errors.New("auth.lockout.max_failed_logins 는 음수일 수 없습니다"))
+	}
+	if c.Auth.Lockout.MaxFailedLogins > 0 && (c.Auth.Lockout.BaseDuration <= 0 || c.Auth.Lockout.MaxDuration < c.Auth.Lockout.BaseDuration) {
+		errs = append(errs, errors.New("auth.lockout.base_duration 은 0보다 크고 max_duration 이하여야 합니다"))
+	}
+
+	// bcrypt 는 72바이트까지만 사용하므로 최소 길이도 그 이하여야 함
+	if c.Auth.Password.MinLength < 1 || c.Auth.Password.MinLength > 72 {
+		errs = append(errs, errors.New("auth.password.min_length 는 1 이상 72 이하여야 합니다"))
+	}
+	if c.Auth.Password.MinClasses < 0 || c.Auth.Password.MinClasses > 4 {
+		errs = append(errs, errors.New("auth.password.min_classes 는 0 이상 4 이하여야 합니다"))
+	}
+	if c.Auth.Password.BcryptCost < 10 || c.Auth.Password.BcryptCost > 31 {
+		errs = append(errs, errors.New("auth.password.bcrypt_cost 는 10 이상 31 이하여야 합니다"))
+	}
+	if c.Auth.Password.ResetTokenTTL <= 0 {
+		errs = append(errs, errors.New("auth.password.reset_token_ttl 은 0보다 커야 합니다"))
+	}
+
+	if c.Auth.Session.Enabled {
+		ss := c.Auth.Session
+		if ss.CookieName == "" || ss.CSRFCookieName == "" || ss.CSRFHeader == "" {
+			errs = append(errs, errors.New("auth.session 의 cookie_name, csrf_cookie_name, csrf_header 는 필수입니다"))
+		}
+		switch ss.SameSite {
+		case "lax", "strict":
+		case "none":
+			if !ss.Secure {
+				errs = append(errs, errors.New("auth.session.same_site=none 은 secure=true 가 필요합니다"))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("auth.session.same_site 는 lax, strict, none 중 하나여야 합니다: %q", ss.SameSite))
+		}
+		if c.IsRelease() && !ss.Secure {
+			errs = append(errs, errors.New("릴리스 모드에서는 auth.session.secure 가 true 여야 합니다"))
+		}
+	}
+
+	if len(c.CORS.AllowOrigins) == 0 {
+		errs = append(errs, errors.New("cors.allow_origins 는 최소 하나 이상이어야 합니다"))
+	}
+	for _, o := range c.CORS.AllowOrigins {
+		if strings.Contains(o, "*") {
+			errs = append(errs, fmt.Errorf("cors.allow_origins 에는 와일드카드를 사용할 수 없습니다 (자격 증명 허용): %q", o))
+		} else if !strings.HasPrefix(o, "http://") && !strings.HasPrefix(o, "https://") {
+			errs = append(errs, fmt.Errorf("cors.allow_origins 는 http:// 또는 https:// 로 시작해야 합니다: %q", o))
+		}
+	}
+
+	if c.RateLimit.Enabled {
+		rules := []struct {
+			name string
+			rule RateRule
+		}{
+			{"login", c.RateLimit.Login},
+			{"ip", c.RateLimit.IP},
+			{"employee", c.RateLimit.Employee},
+			{"api_key", c.RateLimit.APIKey},
+		}
+		for _, r := range rules {
+			if r.rule.PerMinute < 0 || r.rule.Burst < 0 || (r.rule.PerMinute > 0 && r.rule.Burst == 0) {
+				errs = append(errs, fmt.Errorf("rate_limit.%s 는 per_minute, burst 모두 0 이상이어야 하며 per_minute 가 있으면 burst 도 필요합니다", r.name))
+			}
+		}
+		if c.RateLimit.IdleTTL <= 0 {
+			errs = append(errs, errors.New("rate_limit.idle_ttl 은 0보다 커야 합니다"))
+		}
+	}
+
+	if c.Cache.Enabled {
+		switch c.Cache.Backend {
+		case "memory":
+			if c.Cache.MaxEntries < 1 {
+				errs = append(errs, errors.New("cache.max_entries 는 1 이상이어야 합니다"))
+			}
+		case "redis":
+			if c.Cache.Redis.Addr == "" {
+				errs = append(errs, errors.New("cache.redis.addr 이 필요합니다"))
+			}
+			if c.Cache.Redis.DB < 0 {
+				errs = append(errs, errors.New("cache.redis.db 는 음수일 수 없습니다"))
+			}
+		default:
+			errs = append(errs, fmt.Errorf("cache.backend 는 memory, redis 중 하나여야 합니다: %q", c.Cache.Backend))
+		}
+		if c.Cache.TTL < time.Second {
+			errs = append(errs, errors.New("cache.ttl 은 1초 이상이어야 합니다"))
+		}
+	}
+
+	if c.Outbox.PollInterval <= 0 {
+		errs = append(errs, errors.New("outbox.poll_interval 은 0보다 커야 합니다"))
+	}
+	if c.Outbox.BatchSize < 1 || c.Outbox.MaxAttempts < 1 {
+		errs = append(errs, errors.New("outbox.batch_size, outbox.max_attempts 는 1 이상이어야 합니다"))
+	}
+	if c.Outbox.Retention < 0 {
+		errs = append(errs, errors.New("outbox.retention 은 음수일 수 없습니다"))
+	}
+
+	if c.Webhook.PollInterval <= 0 || c.Webhook.Timeout <= 0 {
+		errs = append(errs, errors.New("webhook.poll_interval, webhook.timeout 은 0보다 커야 합니다"))
+	}
+	if c.Webhook.BatchSize < 1 || c.Webhook.MaxAttempts < 1 {
+		errs = append(errs, errors.New("webhook.batch_size, webhook.max_attempts 는 1 이상이어야 합니다"))
+	}
+	if c.Webhook.BaseBackoff <= 0 || c.Webhook.MaxBackoff < c.Webhook.BaseBackoff {
+		errs = append(errs, errors.New("webhook.base_backoff 는 0보다 크고 max_backoff 이하여야 합니다"))
+	}
+
+	if c.MQTT.Enabled {
+		if c.MQTT.BrokerURL == "" {
+			errs = append(errs, errors.New("mqtt.broker_url 이 필요합니다"))
+		}
+		if c.MQTT.QoS < 0 || c.MQTT.QoS > 2 {
+			errs = append(errs, fmt.Errorf("mqtt.qos 는 0, 1, 2 중 하나여야 합니다: %d", c.MQTT.QoS))
+		}
+		if c.MQTT.ConnectTimeout <= 0 || c.MQTT.HandlerTimeout <= 0 || c.MQTT.PublishTimeout <= 0 {
+			errs = append(errs, errors.New("mqtt.connect_timeout, mqtt.handler_timeout, mqtt.publish_timeout 은 0보다 커야 합니다"))
+		}
+		if c.MQTT.TelemetryTopic == "" || c.MQTT.ScanTopic == "" || c.MQTT.BinFullTopic == "" {
+			errs = append(errs, errors.New("mqtt.telemetry_topic, mqtt.scan_topic, mqtt.bin_full_topic 이 필요합니다"))
+		}
+		if !strings.Contains(c.MQTT.TelemetryTopic, "+") {
+			errs = append(errs, errors.New("mqtt.telemetry_topic 에는 차량 ID 자리(+)가 있어야 합니다"))
+		}
+		if !strings.Contains(c.MQTT.CommandTopic, "{vehicle_id}") {
+			errs = append(errs, errors.New("mqtt.command_topic 에는 {vehicle_id} 가 있어야 합니다"))
+		}
+	}
+
+	if c.Sorter.ScanTimeout <= 0 {
+		errs = append(errs, errors.New("sorter.scan_timeout 은 0보다 커야 합니다"))
+	}
+
+	switch c.Tracing.Exporter {
+	case "none", "stdout", "file", "otlp":
+	default:
+		errs = append(errs, fmt.Errorf("tracing.exporter 는 none, stdout, file, otlp 중 하나여야 합니다: %q", c.Tracing.Exporter))
+	}
+	if c.Tracing.SampleRatio < 0 || c.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("tracing.sample_ratio 는 0 이상 1 이하여야 합니다"))
+	}
+
+	switch c.Log.Level {
+	case "debug", "info", "warn", "error":
+	default:
+		errs = append(errs, fmt.Errorf("log.level 은 debug, info, warn, error 중 하나여야 합니다: %q", c.Log.Level))
+	}
+	switch c.Log.Format {
+	case "json", "text":
+	default:
+		errs = append(errs, fmt.Errorf("log.format 은 json, text 중 하나여야 합니다: %q", c.Log.Format))
+	}
+
+	return errors.Join(errs...)
+}
+
+// Redacted 민감한 값을 가린 설정 사본
+func (c Config) Redacted() Config {
+	r := c
+	r.CORS.AllowOrigins = append([]string(nil), c.CORS.AllowOrigins...)
+	r.DB.Replicas = append([]string(nil), c.DB.Replicas...)
+	r.DB.Password = redact(c.DB.Password)
+	r.Auth.JWTSecret = redact(c.Auth.JWTSecret)
+	r.Auth.APIKeys = make([]string, len(c.Auth.APIKeys))
+	for i, key := range c.Auth.APIKeys {
+		r.Auth.APIKeys[i] = redact(key)
+	}
+	r.Cache.Redis.Password = redact(c.Cache.Redis.Password)
+	return r
+}
+
+// String 민감한 값을 가린 YAML 형태의 설정
+func (c Config) String() string {
+	out, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return fmt.Sprintf("<설정 직렬화 실패: %v>", err)
+	}
+	return string(out)
+}
+
+// LogValue 구조화 로그에 민감한 값을 가린 설정을 출력
+func (c Config) LogValue() slog.Value {
+	out, err := yaml.Marshal(c.Redacted())
+	if err != nil {
+		return slog.StringValue(err.Error())
+	}
+	var m map[string]any
+	if err := yaml.Unmarshal(out, &m); err != nil {
+		return slog.StringValue(err.Error())
+	}
+	return slog.AnyValue(m)
+}
+
+func redact(s string) string {
+	if s == "" {
+		return ""
+	}
+	return "********"
+}
+
+func randomSecret() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+func envString(dst *string, key string) {
+	if v, ok := os.LookupEnv(key); ok {
+		*dst = v
+	}
+}
+
+func envList(dst *[]string, key string) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	var list []string
+	for _, item := range strings.Split(v, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			list = append(list, item)
+		}
+	}
+	*dst = list
+}
+
+func envInt(dst *int, key string, errs *[]error) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	n, err := strconv.Atoi(v)
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("%s 값이 올바르지 않습니다: %w", key, err))
+		return
+	}
+	*dst = n
+}
+
+func envDuration(dst *time.Duration, key string, errs *[]error) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("%s 값이 올바르지 않습니다: %w", key, err))
+		return
+	}
+	*dst = d
+}
+
+// envRateRule <PREFIX>_PER_MINUTE, <PREFIX>_BURST 를 읽음
+func envRateRule(dst *RateRule, prefix string, errs *[]error) {
+	envInt(&dst.PerMinute, prefix+"_PER_MINUTE", errs)
+	envInt(&dst.Burst, prefix+"_BURST", errs)
+}
+
+func envFloat(dst *float64, key string, errs *[]error) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("%s 값이 올바르지 않습니다: %w", key, err))
+		return
+	}
+	*dst = f
+}
+
+func envBool(dst *bool, key string, errs *[]error) {
+	v, ok := os.LookupEnv(key)
+	if !ok {
+		return
+	}
+	b, err := strconv.ParseBool(v)
+	if err != nil {
+		*errs = append(*errs, fmt.Errorf("%s 값이 올바르지 않습니다: %w", key, err))
+		return
+	}
+	*dst = b
+}
@@ -0,0 +1,201 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+const testSecret = "0123456789abcdef0123456789abcdef"
+
+func TestLoad(t *testing.T) {
+	dir := t.TempDir()
+	file := func(content string) string {
+		path := filepath.Join(dir, "config.yaml")
+		if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+		return path
+	}
+
+	tests := []struct {
+		name    string
+		yaml    string
+		env     map[string]string
+		args    []string
+		wantErr string
+		check   func(t *testing.T, cfg *Config)
+	}{
+		{name: "defaults", check: func(t *testing.T, cfg *Config) {
+			if cfg.HTTP.Port != "8080" || cfg.MQTT.HandlerTimeout != 5*time.Second {
+				t.Errorf("cfg = %+v", cfg)
+			}
+		}},
+		// 우선순위: 기본값 < 파일 < 환경변수 < 플래그
+		{name: "file overrides default", yaml: "http:\n  port: \"9000\"\nmqtt:\n  publish_timeout: 2s\n",
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.HTTP.Port != "9000" || cfg.MQTT.PublishTimeout != 2*time.Second {
+					t.Errorf("port = %s, publish timeout = %s", cfg.HTTP.Port, cfg.MQTT.PublishTimeout)
+				}
+			}},
+		{name: "env overrides file", yaml: "http:\n  port: \"9000\"\n", env: map[string]string{"BACKEND_PORT": "9100"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.HTTP.Port != "9100" {
+					t.Errorf("port = %s", cfg.HTTP.Port)
+				}
+			}},
+		{name: "flag overrides env", env: map[string]string{"BACKEND_PORT": "9100"}, args: []string{"-port", "9200"},
+			check: func(t *testing.T, cfg *Config) {
+				if cfg.HTTP.Port != "9200" {
+					t.Errorf("port = %s", cfg.HTTP.Port)
+				}
+			}},
+		{name: "env list and types", env: map[string]string{
+			"DB_REPLICAS":          " r1 , ,r2",
+			"GRPC_ENABLED":         "false",
+			"MQTT_HANDLER_TIMEOUT": "3s",
+			"TRACING_SAMPLE_RATIO": "0.5",
+			"AUTH_API_KEYS":        testSecret + "," + testSecret + "x",
+		}, check: func(t *testing.T, cfg *Config) {
+			if len(cfg.Auth.APIKeys) != 2 {
+				t.Errorf("api keys = %d", len(cfg.Auth.APIKeys))
+			}
+			if strings.Join(cfg.DB.Replicas, ",") != "r1,r2" || cfg.GRPC.Enabled || cfg.MQTT.HandlerTimeout != 3*time.Second || cfg.Tracing.SampleRatio != 0.5 {
+				t.Errorf("replicas = %v, grpc = %v, handler timeout = %s, sample ratio = %v",
+					cfg.DB.Replicas, cfg.GRPC.Enabled, cfg.MQTT.HandlerTimeout, cfg.Tracing.SampleRatio)
+			}
+		}},
+		{name: "generated secret outside release", env: map[string]string{"JWT_SECRET": ""}, check: func(t *testing.T, cfg *Config) {
+			if len(cfg.Auth.JWTSecret) != 64 {
+				t.Errorf("secret = %q", cfg.Auth.JWTSecret)
+			}
+		}},
+		{name: "unknown file field", yaml: "http:\n  prot: \"9000\"\n", wantErr: "설정 파일 파싱 실패"},
+		{name: "bad env duration", env: map[string]string{"HTTP_READ_TIMEOUT": "soon"}, wantErr: "HTTP_READ_TIMEOUT"},
+		{name: "bad env int", env: map[string]string{"DB_MAX_OPEN_CONNS": "many"}, wantErr: "DB_MAX_OPEN_CONNS"},
+		{name: "invalid after merge", args: []string{"-mode", "prod"}, wantErr: "mode 는"},
+		{name: "release requires secret", args: []string{"-mode", "release"}, env: map[string]string{"JWT_SECRET": ""}, wantErr: "JWT_SECRET 이 필수"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			t.Setenv("JWT_SECRET", testSecret)
+			for k, v := range tt.env {
+				t.Setenv(k, v)
+			}
+			args := tt.args
+			if tt.yaml != "" {
+				args = append([]string{"-config", file(tt.yaml)}, args...)
+			}
+
+			cfg, err := Load(args)
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("err = %v, want %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.check(t, cfg)
+		})
+	}
+}
+
+// 예시 파일이 설정 구조체와 어긋나지 않도록 확인
+func TestLoad_ExampleFile(t *testing.T) {
+	t.Setenv("JWT_SECRET", testSecret)
+	cfg, err := Load([]string{"-config", "../config.example.yaml"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !cfg.IsRelease() {
+		t.Errorf("mode = %s", cfg.Mode)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := []struct {
+		name    string
+		modify  func(c *Config)
+		wantErr string
+	}{
+		{"valid", func(c *Config) {}, ""},
+		{"bad port", func(c *Config) { c.HTTP.Port = "http" }, "http.port"},
+		{"grpc port clash", func(c *Config) { c.GRPC.Port = c.HTTP.Port }, "grpc.port 는 http.port"},
+		{"grpc disabled skips port", func(c *Config) { c.GRPC.Enabled = false; c.GRPC.Port = "" }, ""},
+		{"unknown driver", func(c *Config) { c.DB.Driver = "oracle" }, "db.driver"},
+		{"sqlite needs path", func(c *Config) { c.DB.Driver = DriverSQLite; c.DB.Name = "" }, "db.name"},
+		{"sqlite replicas", func(c *Config) { c.DB.Driver = DriverSQLite; c.DB.Replicas = []string{"r1"} }, "db.replicas"},
+		{"idle above open", func(c *Config) { c.DB.MaxIdleConns = 100 }, "db.max_idle_conns"},
+		{"short release secret", func(c *Config) { c.Mode = "release"; c.Auth.JWTSecret = "short" }, "최소 32자"},
+		{"short api key", func(c *Config) { c.Auth.APIKeys = []string{testSecret, "device"} }, "auth.api_keys[1]"},
+		{"password too long for bcrypt", func(c *Config) { c.Auth.Password.MinLength = 73 }, "min_length"},
+		{"same site none without secure", func(c *Config) {
+			c.Auth.Session.Enabled = true
+			c.Auth.Session.SameSite = "none"
+			c.Auth.Session.Secure = false
+		}, "secure=true"},
+		{"insecure session in release", func(c *Config) {
+			c.Mode = "release"
+			c.Auth.Session.Enabled = true
+			c.Auth.Session.Secure = false
+		}, "auth.session.secure"},
+		{"wildcard origin", func(c *Config) { c.CORS.AllowOrigins = []string{"https://*.example.com"} }, "와일드카드"},
+		{"origin without scheme", func(c *Config) { c.CORS.AllowOrigins = []string{"example.com"} }, "http://"},
+		{"rate without burst", func(c *Config) {
+			c.RateLimit.Enabled = true
+			c.RateLimit.IP = RateRule{PerMinute: 10}
+		}, "rate_limit.ip"},
+		{"redis without addr", func(c *Config) {
+			c.Cache.Enabled = true
+			c.Cache.Backend = "redis"
+			c.Cache.Redis.Addr = ""
+		}, "cache.redis.addr"},
+		{"webhook backoff inverted", func(c *Config) { c.Webhook.MaxBackoff = c.Webhook.BaseBackoff / 2 }, "webhook.base_backoff"},
+		{"mqtt publish timeout", func(c *Config) { c.MQTT.Enabled = true; c.MQTT.PublishTimeout = 0 }, "mqtt.publish_timeout"},
+		{"mqtt command topic", func(c *Config) { c.MQTT.Enabled = true; c.MQTT.CommandTopic = "vehicles/commands" }, "{vehicle_id}"},
+		{"sample ratio", func(c *Config) { c.Tracing.SampleRatio = 2 }, "tracing.sample_ratio"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := Default()
+			cfg.Auth.JWTSecret = testSecret
+			tt.modify(&cfg)
+			err := cfg.Validate()
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestRedacted(t *testing.T) {
+	cfg := Default()
+	cfg.Auth.JWTSecret = testSecret
+	cfg.DB.Password = "db-pass"
+	cfg.Cache.Redis.Password = "redis-pass"
+	cfg.DB.Replicas = []string{"r1"}
+	cfg.Auth.APIKeys = []string{"device-key-device-key-device"}
+
+	out := cfg.String()
+	for _, secret := range []string{testSecret, "db-pass", "redis-pass", "device-key-device-key-device"} {
+		if strings.Contains(out, secret) {
+			t.Errorf("String() leaks %q", secret)
+		}
+	}
+	// 원본은 바뀌지 않음
+	r := cfg.Redacted()
+	r.DB.Replicas[0] = "changed"
+	if cfg.Auth.JWTSecret != testSecret || cfg.DB.Replicas[0] != "r1" || cfg.Auth.APIKeys[0] != "device-key-device-key-device" {
+		t.Errorf("Redacted modified the original: %+v", cfg.Auth)
+	}
+}
@@ -1,63 +1,187 @@
 package database
 
 import (
+	"context"
 	"fmt"
-	"log"
-	"os"
+	"log/slog"
+	"strings"
+	"time"
 
 	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/driver/sqlite"
 	"gorm.io/gorm"
 
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/logger"
 	"github.com/baboyiban/go-api-server/models"
 )
 
-func getDSN() string {
-	getEnv := func(key, fallback string) string {
-		if v, ok := os.LookupEnv(key); ok {
-			return v
+// maxConnectBackoff 시작 시 연결 재시도 간격의 상한
+const maxConnectBackoff = 15 * time.Second
+
+// InitDB DB 에 연결하고 커넥션 풀을 설정. DB 가 아직 준비되지 않았으면 cfg.ConnectMaxWait 동안 재시도
+func InitDB(ctx context.Context, cfg config.DBConfig, slowQueryThreshold time.Duration) (*gorm.DB, error) {
+	db, err := connectWithRetry(ctx, cfg, slowQueryThreshold)
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, fmt.Errorf("DB 커넥션 풀 조회 실패: %w", err)
+	}
+	sqlDB.SetMaxOpenConns(cfg.MaxOpenConns)
+	sqlDB.SetMaxIdleConns(cfg.MaxIdleConns)
+	sqlDB.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+	sqlDB.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+
+	if cfg.QueryTimeout > 0 {
+		if err := db.Use(QueryTimeoutPlugin{Timeout: cfg.QueryTimeout}); err != nil {
+			return nil, fmt.Errorf("쿼리 타임아웃 등록 실패: %w", err)
+		}
+	}
+
+	if len(cfg.Replicas) > 0 {
+		if err := useReplicas(db, cfg); err != nil {
+			return nil, err
 		}
-		return fallback
 	}
 
-	return fmt.Sprintf("%s:%s@tcp(%s:%s)/%s?charset=utf8mb4&parseTime=True&loc=Local",
-		getEnv("DB_USER", "root"),
-		getEnv("DB_PASSWORD", "password"),
-		getEnv("DB_HOST", "127.0.0.1"),
-		getEnv("DB_PORT", "3306"),
-		getEnv("DB_NAME", "my_database"),
-	)
+	// 필요할 때만 마이그레이션 수행. 끈 상태에서 스키마가 모델보다 뒤처져 있으면
+	// 요청 처리 중에 없는 컬럼으로 실패하지 않도록 시작하지 않음
+	if cfg.AutoMigrate {
+		if err := autoMigrateAll(db); err != nil {
+			return nil, err
+		}
+	} else if pending := PendingMigrations(db); len(pending) > 0 {
+		_ = Close(db)
+		return nil, fmt.Errorf("적용되지 않은 마이그레이션이 있습니다 (db.auto_migrate=true 로 한 번 실행해 적용): %s", strings.Join(pending, ", "))
+	}
+
+	slog.Info("DB 연결 완료", "driver", cfg.Driver, "host", cfg.Host, "database", cfg.Name, "replicas", len(cfg.Replicas))
+	return db, nil
+}
+
+// connectWithRetry docker-compose 등에서 DB 가 API 보다 늦게 뜨는 경우를 위해 지수 백오프로 연결을 재시도
+func connectWithRetry(ctx context.Context, cfg config.DBConfig, slowQueryThreshold time.Duration) (*gorm.DB, error) {
+	deadline := time.Now().Add(cfg.ConnectMaxWait)
+	backoff := cfg.ConnectBackoff
+	for attempt := 1; ; attempt++ {
+		db, err := connect(ctx, cfg, slowQueryThreshold)
+		if err == nil {
+			return db, nil
+		}
+		if time.Now().Add(backoff).After(deadline) {
+			return nil, fmt.Errorf("DB 연결 실패 (%d회 시도): %w", attempt, err)
+		}
+		slog.Warn("DB 연결 실패, 재시도 예정", "attempt", attempt, "retry_in", backoff.String(), "error", err)
+		select {
+		case <-ctx.Done():
+			return nil, fmt.Errorf("DB 연결 대기 중단: %w", ctx.Err())
+		case <-time.After(backoff):
+		}
+		backoff = min(backoff*2, maxConnectBackoff)
+	}
 }
 
-func InitDB() *gorm.DB {
-	db, err := gorm.Open(mysql.Open(getDSN()), &gorm.Config{})
+// connect 한 번 연결을 시도. 실패하면 열린 커넥션 풀을 닫음
+func connect(ctx context.Context, cfg config.DBConfig, slowQueryThreshold time.Duration) (*gorm.DB, error) {
+	db, err := gorm.Open(dialector(cfg), &gorm.Config{
+		Logger: logger.NewGormLogger(slowQueryThreshold),
+		// 드라이버별 중복/외래 키/CHECK 위반 에러를 gorm.ErrDuplicatedKey 등으로 번역해 apperror.Translate 가 드라이버와 무관하게 동작
+		TranslateError: true,
+		// 핑 실패 시 gorm.Open 은 커넥션 풀을 닫지 않으므로 직접 확인
+		DisableAutomaticPing: true,
+	})
 	if err != nil {
-		log.Fatalf("DB 연결 실패: %v", err)
+		return nil, err
 	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	if err := sqlDB.PingContext(ctx); err != nil {
+		_ = sqlDB.Close()
+		return nil, err
+	}
+	return db, nil
+}
 
-	// 필요할 때만 마이그레이션 수행
-	// autoMigrateAll(db)
+// dialector 설정된 드라이버의 GORM Dialector
+func dialector(cfg config.DBConfig) gorm.Dialector {
+	switch cfg.Driver {
+	case config.DriverPostgres:
+		return postgres.Open(cfg.DSN())
+	case config.DriverSQLite:
+		return sqlite.Open(cfg.DSN())
+	default:
+		// 모델은 시각 컬럼 타입을 지정하지 않으므로 기존 스키마와 같은 정밀도 없는 datetime 으로 생성
+		return mysql.New(mysql.Config{DSN: cfg.DSN(), DisableDatetimePrecision: true})
+	}
+}
 
-	log.Println("DB 연결 완료")
-	return db
+// modelsToMigrate 마이그레이션 대상 모델 (의존 순서대로)
+var modelsToMigrate = []any{
+	&models.Region{},
+	&models.Vehicle{},
+	&models.VehicleConfirmation{},
+	&models.Employee{},
+	&models.Package{},
+	&models.Shift{},
+	&models.TripLog{},
+	&models.TripLogB{},
+	&models.DeliveryLog{},
+	&models.PasswordResetToken{},
+	&models.WebhookSubscription{},
+	&models.WebhookDelivery{},
+	&models.WebhookAttempt{},
+	&models.OutboxEvent{},
 }
 
 // autoMigrateAll 모든 모델에 대해 자동 마이그레이션 수행
-func autoMigrateAll(db *gorm.DB) {
-	modelsToMigrate := []any{
-		&models.Region{},
-		&models.Vehicle{},
-		&models.Employee{},
-		&models.Package{},
-		&models.TripLogB{},
-		&models.DeliveryLog{},
-	}
-
+func autoMigrateAll(db *gorm.DB) error {
 	for _, m := range modelsToMigrate {
 		name := fmt.Sprintf("%T", m)
-		log.Printf("마이그레이션 시작: %s", name)
+		slog.Info("마이그레이션 시작", "model", name)
 		if err := db.AutoMigrate(m); err != nil {
-			log.Fatalf("마이그레이션 실패: %s, 에러: %v", name, err)
+			return fmt.Errorf("마이그레이션 실패 (%s): %w", name, err)
 		}
-		log.Printf("마이그레이션 완료: %s", name)
+		slog.Info("마이그레이션 완료", "model", name)
+	}
+	return nil
+}
+
+// PendingMigrations 아직 생성되지 않은 테이블과 컬럼("테이블.컬럼") 목록을 반환
+func PendingMigrations(db *gorm.DB) []string {
+	var pending []string
+	for _, m := range modelsToMigrate {
+		stmt := &gorm.Statement{DB: db}
+		if err := stmt.Parse(m); err != nil {
+			pending = append(pending, fmt.Sprintf("%T", m))
+			continue
+		}
+		if !db.Migrator().HasTable(m) {
+			pending = append(pending, stmt.Schema.Table)
+			continue
+		}
+		for _, name := range stmt.Schema.DBNames {
+			if !db.Migrator().HasColumn(m, name) {
+				pending = append(pending, stmt.Schema.Table+"."+name)
+			}
+		}
+	}
+	return pending
+}
+
+// Close 복제본과 주 DB 커넥션 풀 종료
+func Close(db *gorm.DB) error {
+	if set, ok := db.Config.Plugins[replicaPlugin].(*replicaSet); ok {
+		set.close()
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
 	}
+	return sqlDB.Close()
 }
@@ -0,0 +1,135 @@
+package database
+
+import (
+	"context"
+	"errors"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"gorm.io/gorm"
+)
+
+// sqliteConfig t 가 끝나면 지워지는 SQLite 파일 설정
+func sqliteConfig(t *testing.T) config.DBConfig {
+	return config.DBConfig{
+		Driver:       config.DriverSQLite,
+		Name:         filepath.Join(t.TempDir(), "test.db"),
+		MaxOpenConns: 1,
+	}
+}
+
+func openDB(t *testing.T, cfg config.DBConfig) *gorm.DB {
+	t.Helper()
+	db, err := InitDB(context.Background(), cfg, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = Close(db) })
+	return db
+}
+
+func TestInitDB_PendingMigrations(t *testing.T) {
+	tests := []struct {
+		name    string
+		prepare func(t *testing.T, cfg config.DBConfig) // 자동 마이그레이션 없이 시작하기 전의 스키마
+		wantErr string
+	}{
+		{"empty database", func(*testing.T, config.DBConfig) {}, "employee"},
+		{"missing profile column", func(t *testing.T, cfg config.DBConfig) {
+			cfg.AutoMigrate = true
+			db := openDB(t, cfg)
+			if err := db.Exec("ALTER TABLE employee DROP COLUMN phone").Error; err != nil {
+				t.Fatal(err)
+			}
+		}, "employee.phone"},
+		{"up to date", func(t *testing.T, cfg config.DBConfig) {
+			cfg.AutoMigrate = true
+			openDB(t, cfg)
+		}, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := sqliteConfig(t)
+			tt.prepare(t, cfg)
+
+			db, err := InitDB(context.Background(), cfg, time.Second)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				_ = Close(db)
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestAutoMigrate_EmployeeDefaults(t *testing.T) {
+	cfg := sqliteConfig(t)
+	cfg.AutoMigrate = true
+	db := openDB(t, cfg)
+
+	// GORM 밖에서 넣은 행도 활성 상태로 생성됨
+	if err := db.Exec("INSERT INTO employee (password, position) VALUES ('x', '운송직')").Error; err != nil {
+		t.Fatal(err)
+	}
+	var active bool
+	if err := db.Raw("SELECT is_active FROM employee").Scan(&active).Error; err != nil || !active {
+		t.Errorf("is_active = %v, %v", active, err)
+	}
+}
+
+// unreachable 아무도 듣지 않는 포트라 매번 즉시 연결이 거부되는 설정
+var unreachable = config.DBConfig{
+	Driver: config.DriverPostgres, Host: "127.0.0.1", Port: "1",
+	User: "api", Name: "api", SSLMode: "disable",
+}
+
+func TestConnectWithRetry_GivesUp(t *testing.T) {
+	tests := []struct {
+		name    string
+		maxWait time.Duration
+		backoff time.Duration
+		wantErr string
+	}{
+		{"single attempt", 0, 20 * time.Millisecond, "1회 시도"},
+		// 20ms 후 재시도하고, 다음 간격 40ms 는 대기 시간 50ms 를 넘으므로 포기
+		{"retries within max wait", 50 * time.Millisecond, 20 * time.Millisecond, "2회 시도"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			cfg := unreachable
+			cfg.ConnectMaxWait, cfg.ConnectBackoff = tt.maxWait, tt.backoff
+
+			start := time.Now()
+			db, err := connectWithRetry(context.Background(), cfg, time.Second)
+			if err == nil {
+				_ = Close(db)
+				t.Fatal("connected to an unreachable database")
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+			if taken := time.Since(start); taken > time.Second {
+				t.Errorf("gave up after %s", taken)
+			}
+		})
+	}
+}
+
+func TestConnectWithRetry_Cancelled(t *testing.T) {
+	cfg := unreachable
+	cfg.ConnectMaxWait, cfg.ConnectBackoff = time.Minute, time.Second
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	_, err := connectWithRetry(ctx, cfg, time.Second)
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("err = %v, want context.DeadlineExceeded", err)
+	}
+}
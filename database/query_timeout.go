@@ -0,0 +1,74 @@
+package database
+
+import (
+	"context"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+const (
+	queryCancelKey  = "query_timeout:cancel"
+	queryParentKey  = "query_timeout:parent"
+	queryTimeoutTag = "query_timeout"
+)
+
+// QueryTimeoutPlugin 쿼리마다 요청 컨텍스트에서 파생한 타임아웃 컨텍스트를 적용하는 GORM 플러그인.
+// 요청이 취소되거나 마감 시간이 더 짧으면 그쪽이 먼저 적용됨.
+// Row/Rows 는 콜백이 끝난 뒤에도 결과를 읽으므로 대상에서 제외
+type QueryTimeoutPlugin struct {
+	Timeout time.Duration
+}
+
+func (QueryTimeoutPlugin) Name() string {
+	return queryTimeoutTag
+}
+
+func (p QueryTimeoutPlugin) Initialize(db *gorm.DB) error {
+	cb := db.Callback()
+	hooks := []struct {
+		operation string
+		before    func(name string, fn func(*gorm.DB)) error
+		after     func(name string, fn func(*gorm.DB)) error
+	}{
+		{"create", cb.Create().Before("gorm:create").Register, cb.Create().After("gorm:create").Register},
+		{"query", cb.Query().Before("gorm:query").Register, cb.Query().After("gorm:query").Register},
+		{"update", cb.Update().Before("gorm:update").Register, cb.Update().After("gorm:update").Register},
+		{"delete", cb.Delete().Before("gorm:delete").Register, cb.Delete().After("gorm:delete").Register},
+		{"raw", cb.Raw().Before("gorm:raw").Register, cb.Raw().After("gorm:raw").Register},
+	}
+	for _, h := range hooks {
+		if err := h.before(queryTimeoutTag+":before_"+h.operation, p.start); err != nil {
+			return err
+		}
+		if err := h.after(queryTimeoutTag+":after_"+h.operation, finish); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p QueryTimeoutPlugin) start(db *gorm.DB) {
+	parent := db.Statement.Context
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithTimeout(parent, p.Timeout)
+	db.Statement.Context = ctx
+	db.InstanceSet(queryParentKey, parent)
+	db.InstanceSet(queryCancelKey, cancel)
+}
+
+// finish 타임아웃 컨텍스트를 해제하고, 같은 Statement 로 이어지는 다음 쿼리를 위해 원래 컨텍스트로 되돌림
+func finish(db *gorm.DB) {
+	if v, ok := db.InstanceGet(queryCancelKey); ok {
+		if cancel, ok := v.(context.CancelFunc); ok {
+			cancel()
+		}
+	}
+	if v, ok := db.InstanceGet(queryParentKey); ok {
+		if parent, ok := v.(context.Context); ok {
+			db.Statement.Context = parent
+		}
+	}
+}
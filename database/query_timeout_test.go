@@ -0,0 +1,49 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+)
+
+// endlessQuery 끝나지 않는 재귀 CTE. 취소되어야만 반환됨
+const endlessQuery = "WITH RECURSIVE c(x) AS (SELECT 1 UNION ALL SELECT x + 1 FROM c) SELECT count(*) FROM c"
+
+func TestQueryTimeoutPlugin(t *testing.T) {
+	cfg := sqliteConfig(t)
+	cfg.AutoMigrate = true
+	cfg.QueryTimeout = 50 * time.Millisecond
+	db := openDB(t, cfg)
+
+	tests := []struct {
+		name     string
+		query    string
+		wantCode string // 빈 값이면 성공
+	}{
+		{"fast query", "SELECT 1", ""},
+		{"slow query is cancelled", endlessQuery, apperror.CodeDBTimeout},
+		// 취소된 뒤에도 같은 연결로 다음 쿼리가 정상 실행됨
+		{"next query", "SELECT 1", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			var n int64
+			err := db.WithContext(context.Background()).Raw(tt.query).Find(&n).Error
+			if tt.wantCode == "" {
+				if err != nil || n != 1 {
+					t.Fatalf("n = %d, err = %v", n, err)
+				}
+				return
+			}
+			if elapsed := time.Since(start); elapsed > 2*time.Second {
+				t.Errorf("query ran for %s, want cancellation near %s", elapsed, cfg.QueryTimeout)
+			}
+			if got := apperror.Translate(err, "region"); got == nil || got.Code != tt.wantCode {
+				t.Errorf("Translate(%v) = %v, want %s", err, got, tt.wantCode)
+			}
+		})
+	}
+}
@@ -0,0 +1,188 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"log/slog"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/driver/mysql"
+	"gorm.io/driver/postgres"
+	"gorm.io/gorm"
+	"gorm.io/plugin/dbresolver"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/metrics"
+)
+
+const (
+	// replicaResolver 복제본으로 보낼 조회를 고르는 dbresolver 이름. 지정하지 않은 쿼리는 모두 주 DB 로 감
+	replicaResolver = "replica"
+	replicaPlugin   = "replica_health"
+)
+
+// UseReplica 이 세션의 조회를 정상인 복제본으로 보냄. 복제본이 없거나 모두 내려가 있으면 주 DB 를 사용하고,
+// 쓰기와 잠금 조회(FOR UPDATE)는 주 DB 로 감. 트랜잭션은 복제본에서 시작될 수 있으므로 이 세션에서 시작하지 않음
+func UseReplica(db *gorm.DB) *gorm.DB {
+	if _, ok := db.Config.Plugins[replicaPlugin]; !ok {
+		return db
+	}
+	return db.Clauses(dbresolver.Use(replicaResolver)).Session(&gorm.Session{})
+}
+
+// ReplicaHealth 정상인 복제본 수와 전체 복제본 수
+func ReplicaHealth(db *gorm.DB) (up, total int) {
+	set, ok := db.Config.Plugins[replicaPlugin].(*replicaSet)
+	if !ok {
+		return 0, 0
+	}
+	for _, r := range set.replicas {
+		if r.up.Load() {
+			up++
+		}
+	}
+	return up, len(set.replicas)
+}
+
+type replica struct {
+	addr string
+	db   *sql.DB
+	up   atomic.Bool
+}
+
+// replicaSet 복제본 커넥션과 상태. 주기적으로 핑을 보내 응답하는 복제본만 조회에 사용
+type replicaSet struct {
+	primary  *sql.DB
+	replicas []*replica
+	interval time.Duration
+	next     atomic.Uint64
+	stop     context.CancelFunc
+	done     sync.WaitGroup
+}
+
+func (*replicaSet) Name() string {
+	return replicaPlugin
+}
+
+func (*replicaSet) Initialize(*gorm.DB) error {
+	return nil
+}
+
+// Resolve dbresolver 정책. 정상인 복제본을 돌아가며 고르고, 없으면 주 DB 를 반환
+func (s *replicaSet) Resolve([]gorm.ConnPool) gorm.ConnPool {
+	n := uint64(len(s.replicas))
+	start := s.next.Add(1)
+	for i := range n {
+		if r := s.replicas[(start+i)%n]; r.up.Load() {
+			return r.db
+		}
+	}
+	return s.primary
+}
+
+// useReplicas 복제본 커넥션 풀을 열고 dbresolver 에 등록. 연결하지 못한 복제본은 내려간 것으로 시작
+func useReplicas(db *gorm.DB, cfg config.DBConfig) error {
+	primary, err := db.DB()
+	if err != nil {
+		return err
+	}
+	set := &replicaSet{primary: primary, interval: cfg.ReplicaCheckInterval}
+	// 정책은 아래 목록 대신 set 의 상태로 고르지만, dbresolver 는 후보가 하나뿐이면 정책을 거치지 않으므로 주 DB 도 후보에 넣음
+	dialectors := []gorm.Dialector{existingConn(cfg.Driver, primary)}
+	for _, addr := range cfg.Replicas {
+		rdb, err := sql.Open(sqlDriverName(cfg.Driver), cfg.ForReplica(addr).DSN())
+		if err != nil {
+			set.close()
+			return fmt.Errorf("복제본 %s 설정 실패: %w", addr, err)
+		}
+		rdb.SetMaxOpenConns(cfg.MaxOpenConns)
+		rdb.SetMaxIdleConns(cfg.MaxIdleConns)
+		rdb.SetConnMaxLifetime(cfg.ConnMaxLifetime)
+		rdb.SetConnMaxIdleTime(cfg.ConnMaxIdleTime)
+		set.replicas = append(set.replicas, &replica{addr: addr, db: rdb})
+		dialectors = append(dialectors, existingConn(cfg.Driver, rdb))
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	set.stop = cancel
+	set.check(ctx, true)
+
+	resolver := dbresolver.Register(dbresolver.Config{Replicas: dialectors, Policy: set}, replicaResolver)
+	if err := db.Use(resolver); err != nil {
+		set.close()
+		return fmt.Errorf("복제본 등록 실패: %w", err)
+	}
+	if err := db.Use(set); err != nil {
+		set.close()
+		return err
+	}
+	set.done.Add(1)
+	go set.watch(ctx)
+	return nil
+}
+
+func (s *replicaSet) watch(ctx context.Context) {
+	defer s.done.Done()
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.check(ctx, false)
+		}
+	}
+}
+
+// check 모든 복제본에 핑을 보내 상태를 갱신. 처음 확인할 때와 상태가 바뀐 경우에만 로그를 남김
+func (s *replicaSet) check(ctx context.Context, initial bool) {
+	for _, r := range s.replicas {
+		pingCtx, cancel := context.WithTimeout(ctx, s.interval)
+		err := r.db.PingContext(pingCtx)
+		cancel()
+		if ctx.Err() != nil {
+			return
+		}
+		up := err == nil
+		metrics.SetReplicaUp(r.addr, up)
+		if r.up.Swap(up) == up && !initial {
+			continue
+		}
+		if up {
+			slog.Info("복제본 조회 사용", "replica", r.addr)
+		} else {
+			slog.Warn("복제본 응답 없음, 복구될 때까지 조회에서 제외", "replica", r.addr, "error", err)
+		}
+	}
+}
+
+// close 상태 확인을 멈추고 복제본 커넥션을 닫음. 이후 조회는 주 DB 로 감
+func (s *replicaSet) close() {
+	if s.stop != nil {
+		s.stop()
+	}
+	s.done.Wait()
+	for _, r := range s.replicas {
+		r.up.Store(false)
+		_ = r.db.Close()
+	}
+}
+
+// existingConn 이미 열린 커넥션 풀을 쓰는 Dialector. 서버 버전 조회 등 초기화 쿼리를 보내지 않음
+func existingConn(driver string, conn *sql.DB) gorm.Dialector {
+	if driver == config.DriverPostgres {
+		return postgres.New(postgres.Config{Conn: conn})
+	}
+	return mysql.New(mysql.Config{Conn: conn, SkipInitializeWithVersion: true})
+}
+
+func sqlDriverName(driver string) string {
+	if driver == config.DriverPostgres {
+		return "pgx"
+	}
+	return "mysql"
+}
@@ -0,0 +1,80 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"testing"
+	"time"
+)
+
+// newReplicaSet 연결되지 않는 복제본 n 개와 주 DB 로 이루어진 replicaSet
+func newReplicaSet(t *testing.T, n int) *replicaSet {
+	t.Helper()
+	open := func() *sql.DB {
+		db, err := sql.Open(sqlDriverName(unreachable.Driver), unreachable.DSN())
+		if err != nil {
+			t.Fatal(err)
+		}
+		return db
+	}
+	primary := open()
+	t.Cleanup(func() { _ = primary.Close() })
+	set := &replicaSet{primary: primary, interval: time.Second}
+	for i := range n {
+		set.replicas = append(set.replicas, &replica{addr: "replica-" + string(rune('a'+i)), db: open()})
+	}
+	t.Cleanup(set.close)
+	return set
+}
+
+func TestReplicaSet_Resolve(t *testing.T) {
+	tests := []struct {
+		name string
+		up   []bool
+		want []int // 연속 호출 시 고르는 복제본 순서. -1 은 주 DB
+	}{
+		{"all up", []bool{true, true}, []int{1, 0, 1}},
+		// 내려간 복제본 차례는 다음 복제본이 대신 받음
+		{"one down", []bool{true, false, true}, []int{2, 2, 0}},
+		{"all down", []bool{false, false}, []int{-1, -1}},
+		{"no replicas", nil, []int{-1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			set := newReplicaSet(t, len(tt.up))
+			for i, up := range tt.up {
+				set.replicas[i].up.Store(up)
+			}
+			for i, want := range tt.want {
+				got := set.Resolve(nil)
+				if want < 0 {
+					if got != set.primary {
+						t.Errorf("call %d: resolved a replica, want primary", i)
+					}
+					continue
+				}
+				if got != set.replicas[want].db {
+					t.Errorf("call %d: did not resolve replica %d", i, want)
+				}
+			}
+		})
+	}
+}
+
+func TestReplicaSet_CheckFallsBackToPrimary(t *testing.T) {
+	set := newReplicaSet(t, 2)
+	for _, r := range set.replicas {
+		r.up.Store(true)
+	}
+
+	// 핑에 응답하지 않으면 내려간 것으로 표시되고 조회는 주 DB 로 감
+	set.check(context.Background(), false)
+	for _, r := range set.replicas {
+		if r.up.Load() {
+			t.Errorf("%s is still up", r.addr)
+		}
+	}
+	if set.Resolve(nil) != set.primary {
+		t.Error("resolved a replica that is down, want primary")
+	}
+}
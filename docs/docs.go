@@ -17,7 +17,7 @@ const docTemplate = `{
     "paths": {
         "/api/auth/login": {
             "post": {
-                "description": "직원 ID와 비밀번호로 로그인합니다. 성공 시 JWT 토큰을 반환합니다.",
+                "description": "직원 ID 또는 로그인 아이디(username)와 비밀번호로 로그인합니다. 쿠키 세션이 활성화되어 있으면 JWT 를 HttpOnly 쿠키(token)로,\nCSRF 토큰을 스크립트에서 읽을 수 있는 쿠키(csrf_token)로 함께 발급합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -49,21 +49,52 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
+                    },
+                    "403": {
+                        "description": "비활성화된 계정",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "요청 한도 초과 또는 계정 잠금 (Retry-After 헤더 포함)",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/logout": {
+            "post": {
+                "description": "세션 쿠키와 CSRF 쿠키를 삭제합니다. Bearer 토큰은 클라이언트에서 폐기해야 합니다.",
+                "tags": [
+                    "auth"
+                ],
+                "summary": "로그아웃",
+                "responses": {
+                    "204": {
+                        "description": "No Content"
                     }
                 }
             }
         },
         "/api/auth/me": {
             "get": {
-                "description": "JWT 토큰을 이용해 로그인한 직원의 정보를 반환합니다.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "JWT 토큰을 Authorization 헤더 또는 세션 쿠키(token)로 전달하여 로그인한 직원의 정보를 반환합니다.",
                 "produces": [
                     "application/json"
                 ],
@@ -81,7 +112,135 @@ const docTemplate = `{
                     "401": {
                         "description": "Unauthorized",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/me/assignment": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "로그인한 운송직 직원의 현재 진행 중인 근무(배정 차량)를 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "shift"
+                ],
+                "summary": "내 현재 배정 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ShiftResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "진행 중인 근무 없음",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/password": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "현재 비밀번호를 확인한 뒤 로그인한 직원의 비밀번호를 변경합니다. 기존에 발급된 토큰과 세션은 모두 무효화되므로 다시 로그인해야 합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "비밀번호 변경",
+                "parameters": [
+                    {
+                        "description": "현재 비밀번호와 새 비밀번호",
+                        "name": "password",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ChangePasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "현재 비밀번호 불일치 또는 비밀번호 정책 위반",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/auth/password/reset": {
+            "post": {
+                "description": "관리자가 발급한 일회용 토큰으로 비밀번호를 재설정합니다. 성공 시 계정 잠금이 해제되고 기존 토큰과 세션은 무효화됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "비밀번호 재설정",
+                "parameters": [
+                    {
+                        "description": "재설정 토큰과 새 비밀번호",
+                        "name": "reset",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ResetPasswordRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "400": {
+                        "description": "토큰이 유효하지 않거나 비밀번호 정책 위반",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "429": {
+                        "description": "Too Many Requests",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -150,13 +309,25 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -197,6 +368,36 @@ const docTemplate = `{
                         "name": "load_order",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "등록 시각 (YYYY-MM-DD)",
+                        "name": "registered_at",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "첫 운송 시각 (YYYY-MM-DD)",
+                        "name": "first_transport_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "투입 시각 (YYYY-MM-DD)",
+                        "name": "input_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "두번째 운송 시각 (YYYY-MM-DD)",
+                        "name": "second_transport_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "완료 시각 (YYYY-MM-DD)",
+                        "name": "completed_at",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "정렬 필드 (예: -registration_time, -trip_id 등)",
@@ -213,12 +414,6 @@ const docTemplate = `{
                                 "$ref": "#/definitions/dto.DeliveryLogResponse"
                             }
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
                     }
                 }
             }
@@ -252,13 +447,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -303,19 +498,31 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -345,13 +552,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -370,7 +577,7 @@ const docTemplate = `{
                 "parameters": [
                     {
                         "type": "string",
-                        "description": "정렬 필드 (예: -employee_id, -position 등)",
+                        "description": "정렬 필드 (예: -employee_id, name, -hire_date 등)",
                         "name": "sort",
                         "in": "query"
                     }
@@ -420,13 +627,25 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -449,6 +668,18 @@ const docTemplate = `{
                         "name": "employee_id",
                         "in": "query"
                     },
+                    {
+                        "type": "string",
+                        "description": "이름 (부분 일치)",
+                        "name": "name",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "로그인 아이디",
+                        "name": "username",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "직책",
@@ -463,7 +694,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "정렬 필드 (예: -employee_id, -position 등)",
+                        "description": "정렬 필드 (예: -employee_id, name, -hire_date 등)",
                         "name": "sort",
                         "in": "query"
                     }
@@ -481,7 +712,7 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -516,13 +747,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -567,19 +798,31 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "중복된 값이거나 본인 계정을 비활성화하려는 경우",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -609,113 +852,321 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             }
         },
-        "/api/package": {
-            "get": {
-                "description": "모든 패키지 정보를 반환합니다.",
+        "/api/employee/{id}/deactivate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "직원 계정을 비활성화합니다. 이미 발급된 토큰으로도 더 이상 요청할 수 없습니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "package"
+                    "employee"
                 ],
-                "summary": "모든 패키지 조회",
+                "summary": "직원 비활성화",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "정렬 필드 (예: -registered_at는 최신순, package_id 등)",
-                        "name": "sort",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "직원 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.PackageResponse"
-                            }
+                            "$ref": "#/definitions/dto.EmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "본인 계정은 비활성화할 수 없음",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
-            },
+            }
+        },
+        "/api/employee/{id}/password-reset": {
             "post": {
-                "description": "새로운 패키지를 생성합니다.",
-                "consumes": [
-                    "application/json"
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "직원의 일회용 비밀번호 재설정 토큰을 발급합니다. 토큰은 응답에서 한 번만 노출되며, 기존 미사용 토큰은 무효화됩니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "package"
+                    "employee"
                 ],
-                "summary": "패키지 생성",
+                "summary": "비밀번호 재설정 토큰 발급",
                 "parameters": [
                     {
-                        "description": "패키지 정보",
-                        "name": "package",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreatePackageRequest"
-                        }
+                        "type": "integer",
+                        "description": "직원 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.PackageResponse"
+                            "$ref": "#/definitions/dto.PasswordResetTokenResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             }
         },
-        "/api/package/search": {
-            "get": {
-                "description": "쿼리 파라미터로 패키지를 검색합니다.",
+        "/api/employee/{id}/reactivate": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "비활성화된 직원 계정을 다시 활성화합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "package"
+                    "employee"
                 ],
-                "summary": "패키지 검색",
+                "summary": "직원 재활성화",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "패키지 ID",
-                        "name": "package_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "패키지 타입",
+                        "description": "직원 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EmployeeResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/employee/{id}/trips": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "직원이 운전한 A/B 차량 운행 로그를 반환합니다. 관리직은 모든 직원, 그 외에는 본인만 조회할 수 있습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "employee"
+                ],
+                "summary": "직원 운행 이력 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "직원 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.EmployeeTripsResponse"
+                        }
+                    },
+                    "403": {
+                        "description": "Forbidden",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/package": {
+            "get": {
+                "description": "모든 패키지 정보를 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "package"
+                ],
+                "summary": "모든 패키지 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "정렬 필드 (예: -registered_at는 최신순, package_id 등)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.PackageResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "새로운 패키지를 생성합니다. 투입됨 상태는 분류기 스캔(POST /api/sorter/scan)으로만 설정할 수 있습니다 (409 SORTER_MANAGED).",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "package"
+                ],
+                "summary": "패키지 생성",
+                "parameters": [
+                    {
+                        "description": "패키지 정보",
+                        "name": "package",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreatePackageRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.PackageResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "500": {
+                        "description": "Internal Server Error",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/package/search": {
+            "get": {
+                "description": "쿼리 파라미터로 패키지를 검색합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "package"
+                ],
+                "summary": "패키지 검색",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "패키지 ID",
+                        "name": "package_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "패키지 타입",
                         "name": "package_type",
                         "in": "query"
                     },
@@ -733,7 +1184,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "등록 시각(RFC3339)",
+                        "description": "등록 시각 (YYYY-MM-DD)",
                         "name": "registered_at",
                         "in": "query"
                     },
@@ -753,12 +1204,6 @@ const docTemplate = `{
                                 "$ref": "#/definitions/dto.PackageResponse"
                             }
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
                     }
                 }
             }
@@ -792,19 +1237,19 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             },
             "put": {
-                "description": "패키지 ID로 패키지 정보를 수정합니다.",
+                "description": "패키지 ID로 패키지 정보를 수정합니다. 투입됨으로 바꾸거나 투입된 패키지의 지역을 바꾸는 것은 분류기 스캔만 할 수 있으므로 409 SORTER_MANAGED 를 반환합니다. 투입된 패키지를 다른 상태로 바꾸면 지역 적재량을 하나 줄이고, 최대 용량 아래로 내려가면 포화 표시를 해제합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -843,25 +1288,37 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             },
             "delete": {
-                "description": "패키지 ID로 패키지를 삭제합니다.",
+                "description": "패키지 ID로 패키지를 삭제합니다. 투입된 패키지면 지역 적재량을 하나 줄이고, 최대 용량 아래로 내려가면 포화 표시를 해제합니다.",
                 "produces": [
                     "application/json"
                 ],
@@ -885,13 +1342,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -923,6 +1380,12 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/dto.RegionResponse"
                             }
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+                            }
                         }
                     }
                 }
@@ -960,13 +1423,25 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1027,7 +1502,7 @@ const docTemplate = `{
                     },
                     {
                         "type": "string",
-                        "description": "포화 시각(RFC3339)",
+                        "description": "포화 시각 (YYYY-MM-DD)",
                         "name": "saturated_at",
                         "in": "query"
                     },
@@ -1046,12 +1521,12 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/dto.RegionResponse"
                             }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+                            }
                         }
                     }
                 }
@@ -1081,18 +1556,24 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/dto.RegionResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+                            }
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1137,19 +1618,31 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1179,33 +1672,50 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             }
         },
-        "/api/trip-log": {
+        "/api/shift": {
             "get": {
-                "description": "모든 차량 운행 로그 정보를 반환합니다.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "근무 목록을 최근 시작 순으로 반환합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "trip_log"
+                    "shift"
                 ],
-                "summary": "모든 차량 운행 로그 조회",
+                "summary": "근무 목록 조회",
                 "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "직원 ID",
+                        "name": "employee_id",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
-                        "description": "정렬 필드 (예: -trip_id, -start_time 등)",
-                        "name": "sort",
+                        "description": "차량 ID",
+                        "name": "vehicle_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "현재 진행 중인 근무만",
+                        "name": "active",
                         "in": "query"
                     }
                 ],
@@ -1215,14 +1725,19 @@ const docTemplate = `{
                         "schema": {
                             "type": "array",
                             "items": {
-                                "$ref": "#/definitions/dto.TripLogResponse"
+                                "$ref": "#/definitions/dto.ShiftResponse"
                             }
                         }
                     }
                 }
             },
             "post": {
-                "description": "새로운 차량 운행 로그를 생성합니다.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "운송직 직원에게 차량을 배정하는 근무를 등록합니다. 같은 차량이나 같은 직원의 근무 시간이 겹치면 거부됩니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1230,17 +1745,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "trip_log"
+                    "shift"
                 ],
-                "summary": "차량 운행 로그 생성",
+                "summary": "근무 등록",
                 "parameters": [
                     {
-                        "description": "차량 운행 로그 정보",
-                        "name": "trip_log",
+                        "description": "근무 정보",
+                        "name": "shift",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateTripLogRequest"
+                            "$ref": "#/definitions/dto.CreateShiftRequest"
                         }
                     }
                 ],
@@ -1248,186 +1763,119 @@ const docTemplate = `{
                     "201": {
                         "description": "Created",
                         "schema": {
-                            "$ref": "#/definitions/dto.TripLogResponse"
+                            "$ref": "#/definitions/dto.ShiftResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "근무 시간 겹침",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "존재하지 않는 직원/차량 또는 운송직이 아닌 직원",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             }
         },
-        "/api/trip-log-b": {
+        "/api/shift/{id}": {
             "get": {
-                "description": "모든 B차 운행 로그 정보를 반환합니다.",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "trip_log_b"
+                    "shift"
                 ],
-                "summary": "모든 B차 운행 로그 조회",
+                "summary": "근무 단건 조회",
                 "parameters": [
                     {
-                        "type": "string",
-                        "description": "정렬 필드 (예: -trip_id, -start_time 등)",
-                        "name": "sort",
-                        "in": "query"
+                        "type": "integer",
+                        "description": "근무 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.TripLogBResponse"
-                            }
+                            "$ref": "#/definitions/dto.ShiftResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             },
-            "post": {
-                "description": "새로운 B차 운행 로그를 생성합니다.",
-                "consumes": [
-                    "application/json"
-                ],
-                "produces": [
-                    "application/json"
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
                 "tags": [
-                    "trip_log_b"
+                    "shift"
                 ],
-                "summary": "B차 운행 로그 생성",
+                "summary": "근무 삭제",
                 "parameters": [
                     {
-                        "description": "B차 운행 로그 정보",
-                        "name": "trip_log_b",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.CreateTripLogBRequest"
-                        }
+                        "type": "integer",
+                        "description": "근무 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
-                        "schema": {
-                            "$ref": "#/definitions/dto.TripLogBResponse"
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
+                    "204": {
+                        "description": "No Content"
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "404": {
+                        "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             }
         },
-        "/api/trip-log-b/search": {
-            "get": {
-                "description": "쿼리 파라미터로 B차 운행 로그를 검색합니다.",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "trip_log_b"
-                ],
-                "summary": "B차 운행 로그 검색",
-                "parameters": [
-                    {
-                        "type": "integer",
-                        "description": "trip_id",
-                        "name": "trip_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "차량 ID",
-                        "name": "vehicle_id",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "상태",
-                        "name": "status",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "목적지1",
-                        "name": "destination_1",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "목적지2",
-                        "name": "destination_2",
-                        "in": "query"
-                    },
-                    {
-                        "type": "string",
-                        "description": "목적지3",
-                        "name": "destination_3",
-                        "in": "query"
-                    },
+        "/api/shift/{id}/end": {
+            "post": {
+                "security": [
                     {
-                        "type": "string",
-                        "description": "정렬 필드 (예: -trip_id, -start_time 등)",
-                        "name": "sort",
-                        "in": "query"
+                        "ApiKeyAuth": []
                     }
                 ],
-                "responses": {
-                    "200": {
-                        "description": "OK",
-                        "schema": {
-                            "type": "array",
-                            "items": {
-                                "$ref": "#/definitions/dto.TripLogBResponse"
-                            }
-                        }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
-                    }
-                }
-            }
-        },
-        "/api/trip-log-b/{id}": {
-            "get": {
-                "description": "trip_id로 B차 운행 로그를 조회합니다.",
+                "description": "진행 중이거나 예정된 근무를 지금 종료합니다.",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "trip_log_b"
+                    "shift"
                 ],
-                "summary": "B차 운행 로그 단건 조회",
+                "summary": "근무 종료",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "B차 운행 로그 trip_id",
+                        "description": "근무 ID",
                         "name": "id",
                         "in": "path",
                         "required": true
@@ -1437,25 +1885,32 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TripLogBResponse"
+                            "$ref": "#/definitions/dto.ShiftResponse"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "409": {
+                        "description": "이미 종료된 근무",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
-            },
-            "put": {
-                "description": "trip_id로 B차 운행 로그 정보를 수정합니다.",
+            }
+        },
+        "/api/sorter/scan": {
+            "post": {
+                "security": [
+                    {
+                        "DeviceKey": []
+                    }
+                ],
+                "description": "분류기가 인식한 패키지를 보낼 적재함을 결정합니다. divert 면 region_id 적재함으로 보내며 패키지는 투입됨 상태가 되고 지역 적재량이 늘어납니다. 적재함이 가득 찼으면 overflow, 등록되지 않았거나 이미 분류를 지난 패키지는 reject 이며, 결정은 package.scanned 이벤트로 기록됩니다. 처리 한도(sorter.scan_timeout)를 넘기면 아무것도 기록하지 않고 503 을 반환하므로 분류기는 오버플로 라인으로 보내야 합니다.",
                 "consumes": [
                     "application/json"
                 ],
@@ -1463,24 +1918,17 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "trip_log_b"
+                    "sorter"
                 ],
-                "summary": "B차 운행 로그 정보 수정",
+                "summary": "분류기 스캔",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "B차 운행 로그 trip_id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
-                    },
-                    {
-                        "description": "수정할 B차 운행 로그 정보",
-                        "name": "trip_log_b",
+                        "description": "인식한 패키지",
+                        "name": "scan",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.UpdateTripLogBRequest"
+                            "$ref": "#/definitions/dto.SorterScanRequest"
                         }
                     }
                 ],
@@ -1488,61 +1936,112 @@ const docTemplate = `{
                     "200": {
                         "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.TripLogBResponse"
+                            "$ref": "#/definitions/dto.SorterScanResponse"
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "401": {
+                        "description": "X-API-Key 가 없거나 등록되지 않음 (INVALID_API_KEY)",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
-                    "500": {
-                        "description": "Internal Server Error",
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/trip-log": {
+            "get": {
+                "description": "모든 차량 운행 로그 정보를 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "trip_log"
+                ],
+                "summary": "모든 차량 운행 로그 조회",
+                "parameters": [
+                    {
+                        "type": "string",
+                        "description": "정렬 필드 (예: -trip_id, -start_time 등)",
+                        "name": "sort",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.TripLogResponse"
+                            }
                         }
                     }
                 }
             },
-            "delete": {
-                "description": "trip_id로 B차 운행 로그를 삭제합니다.",
+            "post": {
+                "description": "새로운 차량 운행 로그를 생성합니다.",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "trip_log_b"
+                    "trip_log"
                 ],
-                "summary": "B차 운행 로그 삭제",
+                "summary": "차량 운행 로그 생성",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "B차 운행 로그 trip_id",
-                        "name": "id",
-                        "in": "path",
-                        "required": true
+                        "description": "차량 운행 로그 정보",
+                        "name": "trip_log",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTripLogRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "204": {
-                        "description": "No Content"
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.TripLogResponse"
+                        }
                     },
-                    "404": {
-                        "description": "Not Found",
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1571,6 +2070,24 @@ const docTemplate = `{
                         "name": "vehicle_id",
                         "in": "query"
                     },
+                    {
+                        "type": "integer",
+                        "description": "운전 직원 ID",
+                        "name": "driver_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "출발 시각 (YYYY-MM-DD)",
+                        "name": "start_time",
+                        "in": "query"
+                    },
+                    {
+                        "type": "string",
+                        "description": "도착 시각 (YYYY-MM-DD)",
+                        "name": "end_time",
+                        "in": "query"
+                    },
                     {
                         "type": "string",
                         "description": "상태",
@@ -1599,12 +2116,6 @@ const docTemplate = `{
                                 "$ref": "#/definitions/dto.TripLogResponse"
                             }
                         }
-                    },
-                    "400": {
-                        "description": "Bad Request",
-                        "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
-                        }
                     }
                 }
             }
@@ -1638,13 +2149,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1689,19 +2200,31 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1731,13 +2254,13 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1769,6 +2292,12 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/dto.VehicleResponse"
                             }
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+                            }
                         }
                     }
                 }
@@ -1806,13 +2335,25 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1892,12 +2433,18 @@ const docTemplate = `{
                             "items": {
                                 "$ref": "#/definitions/dto.VehicleResponse"
                             }
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+                            }
                         }
                     },
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1927,18 +2474,24 @@ const docTemplate = `{
                         "description": "OK",
                         "schema": {
                             "$ref": "#/definitions/dto.VehicleResponse"
+                        },
+                        "headers": {
+                            "Cache-Control": {
+                                "type": "string",
+                                "description": "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+                            }
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -1983,19 +2536,31 @@ const docTemplate = `{
                     "400": {
                         "description": "Bad Request",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "Conflict",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "422": {
+                        "description": "Unprocessable Entity",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
@@ -2025,82 +2590,874 @@ const docTemplate = `{
                     "404": {
                         "description": "Not Found",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     },
                     "500": {
                         "description": "Internal Server Error",
                         "schema": {
-                            "$ref": "#/definitions/dto.ErrorResponse"
+                            "$ref": "#/definitions/dto.Problem"
                         }
                     }
                 }
             }
-        }
-    },
-    "definitions": {
-        "dto.CreateDeliveryLogRequest": {
-            "type": "object",
-            "required": [
-                "package_id",
-                "region_id",
-                "trip_id"
-            ],
-            "properties": {
-                "completed_at": {
-                    "description": "RFC3339",
-                    "type": "string"
-                },
-                "first_transport_time": {
-                    "description": "RFC3339",
-                    "type": "string"
-                },
-                "input_time": {
-                    "description": "RFC3339",
-                    "type": "string"
-                },
-                "load_order": {
-                    "type": "integer"
-                },
-                "package_id": {
-                    "type": "integer"
-                },
-                "region_id": {
-                    "type": "string"
-                },
-                "registered_at": {
-                    "description": "RFC3339",
-                    "type": "string"
-                },
-                "second_transport_time": {
-                    "description": "RFC3339",
-                    "type": "string"
-                },
-                "trip_id": {
-                    "type": "integer"
-                }
-            }
         },
-        "dto.CreateEmployeeRequest": {
-            "type": "object",
-            "required": [
+        "/api/vehicle/{id}/confirmations": {
+            "get": {
+                "description": "차량의 확인 요청을 최근 순으로 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle"
+                ],
+                "summary": "차량 확인 요청 목록 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "차량 Internal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "상태 (pending, acknowledged)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.ConfirmationResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            },
+            "post": {
+                "description": "차량이 운영자 확인을 요청합니다. 확인 필요 플래그를 설정하고 LED 를 요청 색상(생략 시 사유별 기본 색상)으로 바꿉니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle"
+                ],
+                "summary": "차량 확인 요청 등록",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "차량 Internal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "확인 요청 정보",
+                        "name": "confirmation",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateConfirmationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConfirmationResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/vehicle/{id}/confirmations/{cid}/ack": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "운영자가 확인 요청을 처리합니다. 대기 중인 요청이 더 없으면 확인 필요 플래그를 내리고 LED 를 요청 전 상태로 복원합니다. 처리한 직원이 기록됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "vehicle"
+                ],
+                "summary": "차량 확인 요청 처리",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "차량 Internal ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "integer",
+                        "description": "확인 요청 ID",
+                        "name": "cid",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "처리 메모",
+                        "name": "ack",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AcknowledgeConfirmationRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ConfirmationResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "이미 처리된 요청",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 구독 목록 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.WebhookResponse"
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "이벤트를 받을 URL 과 이벤트 타입을 등록합니다. 루프백, 사설망, 링크 로컬 주소는 등록할 수 없습니다. 요청은 X-Webhook-Signature 헤더에 \"\u003ctimestamp\u003e.\u003cbody\u003e\" 의 HMAC-SHA256 서명(sha256=\u003chex\u003e)을 담아 전송됩니다. 시크릿은 생성 응답에서만 반환됩니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 구독 생성",
+                "parameters": [
+                    {
+                        "description": "구독 정보",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook/deliveries/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "전송한 본문과 시도 기록을 함께 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 전송 단건 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "전송 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookDeliveryDetailResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook/deliveries/{id}/redeliver": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "성공 또는 실패로 끝난 전송을 같은 본문과 이벤트 ID 로 즉시 다시 전송합니다. 시도 횟수는 초기화됩니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 재전송",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "전송 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookDeliveryResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "409": {
+                        "description": "아직 전송 대기 중",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook/{id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 구독 단건 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "구독 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "URL, 이벤트 타입, 활성 여부를 변경합니다. secret 을 지정하면 시크릿을 교체합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 구독 수정",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "구독 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "구독 정보",
+                        "name": "webhook",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateWebhookRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "구독과 전송 기록을 함께 삭제합니다.",
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 구독 삭제",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "구독 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "204": {
+                        "description": "No Content"
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook/{id}/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "구독의 전송을 최근 순으로 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 전송 목록 조회",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "구독 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "type": "string",
+                        "description": "상태 (pending, succeeded, failed)",
+                        "name": "status",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "array",
+                            "items": {
+                                "$ref": "#/definitions/dto.WebhookDeliveryResponse"
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/api/webhook/{id}/test": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "webhook.test 이벤트를 즉시 전송하고 결과를 반환합니다. 실패하면 일반 전송처럼 재시도가 예약됩니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "webhook"
+                ],
+                "summary": "웹훅 테스트 전송",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "구독 ID",
+                        "name": "id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.WebhookDeliveryResponse"
+                        }
+                    },
+                    "404": {
+                        "description": "Not Found",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/graphql": {
+            "get": {
+                "description": "graphql-transport-ws 하위 프로토콜로 연결합니다. 토큰은 Authorization 헤더 또는 connection_init payload 의 authorization(\"Bearer \u003ctoken\u003e\")으로 전달합니다. packageChanged, vehicleChanged 구독은 현재 상태를 먼저 보내고 변경될 때마다 다시 보냅니다.",
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL 구독 (WebSocket)",
+                "responses": {
+                    "101": {
+                        "description": "Switching Protocols"
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "대시보드용 GraphQL 엔드포인트입니다. 지역, 택배, 차량, 운행 기록, 배송 기록과 그 관계를 한 번에 조회합니다. 목록은 REST 검색과 같은 필터, 정렬, limit/offset 페이지를 지원합니다. 직원 정보(TripLog.driver)는 관리직만 조회할 수 있습니다. 필드 에러는 200 응답의 errors 에 REST 와 같은 code 로 담깁니다. 구독은 같은 경로의 WebSocket(graphql-transport-ws)을 사용합니다.",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "graphql"
+                ],
+                "summary": "GraphQL 쿼리 실행",
+                "parameters": [
+                    {
+                        "description": "쿼리와 변수",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.GraphQLResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Bad Request",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    },
+                    "401": {
+                        "description": "Unauthorized",
+                        "schema": {
+                            "$ref": "#/definitions/dto.Problem"
+                        }
+                    }
+                }
+            }
+        },
+        "/healthz": {
+            "get": {
+                "description": "프로세스가 요청을 처리할 수 있는지 확인합니다. 외부 의존성은 점검하지 않습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Liveness 점검",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.HealthResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/readyz": {
+            "get": {
+                "description": "DB 연결과 마이그레이션 상태를 점검합니다. 종료 중이거나 점검에 실패하면 503을 반환합니다. 읽기 복제본이 설정되어 있으면 정상인 복제본 수(replicas)를 함께 반환하며, 복제본 상태는 결과에 영향을 주지 않습니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "Readiness 점검",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReadinessResponse"
+                        }
+                    },
+                    "503": {
+                        "description": "Service Unavailable",
+                        "schema": {
+                            "$ref": "#/definitions/dto.ReadinessResponse"
+                        }
+                    }
+                }
+            }
+        },
+        "/version": {
+            "get": {
+                "description": "git 커밋, 빌드 시각, Go 버전을 반환합니다.",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "health"
+                ],
+                "summary": "빌드 정보 조회",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/buildinfo.Info"
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "buildinfo.Info": {
+            "type": "object",
+            "properties": {
+                "build_time": {
+                    "type": "string"
+                },
+                "commit": {
+                    "type": "string"
+                },
+                "go_version": {
+                    "type": "string"
+                },
+                "version": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.AcknowledgeConfirmationRequest": {
+            "type": "object",
+            "properties": {
+                "note": {
+                    "type": "string",
+                    "maxLength": 255
+                }
+            }
+        },
+        "dto.ChangePasswordRequest": {
+            "type": "object",
+            "required": [
+                "new_password",
+                "old_password"
+            ],
+            "properties": {
+                "new_password": {
+                    "type": "string"
+                },
+                "old_password": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.ConfirmationResponse": {
+            "type": "object",
+            "properties": {
+                "acknowledged_at": {
+                    "type": "string"
+                },
+                "acknowledged_by": {
+                    "type": "integer"
+                },
+                "confirmation_id": {
+                    "type": "integer"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "led_color": {
+                    "type": "string"
+                },
+                "note": {
+                    "type": "string"
+                },
+                "reason": {
+                    "type": "string"
+                },
+                "requested_at": {
+                    "type": "string"
+                },
+                "status": {
+                    "type": "string"
+                },
+                "vehicle_id": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.CreateConfirmationRequest": {
+            "type": "object",
+            "required": [
+                "reason"
+            ],
+            "properties": {
+                "detail": {
+                    "type": "string",
+                    "maxLength": 255
+                },
+                "led_color": {
+                    "description": "생략 시 사유별 기본 색상",
+                    "type": "string",
+                    "enum": [
+                        "green",
+                        "yellow",
+                        "red",
+                        "blue"
+                    ]
+                },
+                "reason": {
+                    "type": "string",
+                    "enum": [
+                        "arrival",
+                        "load_mismatch",
+                        "other"
+                    ]
+                }
+            }
+        },
+        "dto.CreateDeliveryLogRequest": {
+            "type": "object",
+            "required": [
+                "package_id",
+                "region_id",
+                "trip_id"
+            ],
+            "properties": {
+                "completed_at": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "first_transport_time": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "input_time": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "load_order": {
+                    "type": "integer"
+                },
+                "package_id": {
+                    "type": "integer"
+                },
+                "region_id": {
+                    "type": "string"
+                },
+                "registered_at": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "second_transport_time": {
+                    "description": "RFC3339",
+                    "type": "string"
+                },
+                "trip_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.CreateEmployeeRequest": {
+            "type": "object",
+            "required": [
+                "name",
                 "password",
                 "position"
             ],
             "properties": {
+                "assigned_vehicle_id": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "hire_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
                 "is_active": {
                     "description": "optional, default true",
                     "type": "boolean"
                 },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50
+                },
                 "password": {
                     "type": "string"
                 },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20
+                },
                 "position": {
                     "type": "string",
                     "enum": [
                         "관리직",
                         "운송직"
                     ]
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
                 }
             }
         },
@@ -2112,7 +3469,14 @@ const docTemplate = `{
             ],
             "properties": {
                 "package_status": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "등록됨",
+                        "A차운송중",
+                        "투입됨",
+                        "B차운송중",
+                        "완료됨"
+                    ]
                 },
                 "package_type": {
                     "type": "string"
@@ -2146,34 +3510,25 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.CreateTripLogBRequest": {
+        "dto.CreateShiftRequest": {
             "type": "object",
             "required": [
-                "vehicle_id"
+                "employee_id"
             ],
             "properties": {
-                "destination_1": {
-                    "type": "string"
-                },
-                "destination_2": {
-                    "type": "string"
-                },
-                "destination_3": {
-                    "type": "string"
+                "employee_id": {
+                    "type": "integer"
                 },
                 "end_time": {
-                    "description": "RFC3339 string",
+                    "description": "RFC3339 string, 생략 시 종료 처리 전까지 진행 중",
                     "type": "string"
                 },
                 "start_time": {
-                    "description": "RFC3339 string",
-                    "type": "string"
-                },
-                "status": {
-                    "description": "\"운행중\" or \"비운행중\"",
+                    "description": "RFC3339 string, 생략 시 현재 시각",
                     "type": "string"
                 },
                 "vehicle_id": {
+                    "description": "생략 시 직원의 배정 차량(assigned_vehicle_id)",
                     "type": "string"
                 }
             }
@@ -2187,6 +3542,10 @@ const docTemplate = `{
                 "destination": {
                     "type": "string"
                 },
+                "driver_id": {
+                    "description": "생략 시 해당 차량의 진행 중인 근무자",
+                    "type": "integer"
+                },
                 "end_time": {
                     "description": "RFC3339 string",
                     "type": "string"
@@ -2197,7 +3556,11 @@ const docTemplate = `{
                 },
                 "status": {
                     "description": "\"운행중\" or \"비운행중\"",
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "운행중",
+                        "비운행중"
+                    ]
                 },
                 "vehicle_id": {
                     "type": "string"
@@ -2218,6 +3581,37 @@ const docTemplate = `{
                 }
             }
         },
+        "dto.CreateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "event_types",
+                "url"
+            ],
+            "properties": {
+                "event_types": {
+                    "description": "예: package.completed, region.saturated",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_active": {
+                    "description": "optional, default true",
+                    "type": "boolean"
+                },
+                "secret": {
+                    "description": "생략 시 서버에서 생성",
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500
+                }
+            }
+        },
         "dto.DeliveryLogResponse": {
             "type": "object",
             "properties": {
@@ -2242,110 +3636,375 @@ const docTemplate = `{
                 "registered_at": {
                     "type": "string"
                 },
-                "second_transport_time": {
-                    "type": "string"
+                "second_transport_time": {
+                    "type": "string"
+                },
+                "trip_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.EmployeeResponse": {
+            "type": "object",
+            "properties": {
+                "assigned_vehicle_id": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "employee_id": {
+                    "type": "integer"
+                },
+                "hire_date": {
+                    "description": "YYYY-MM-DD",
+                    "type": "string"
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "position": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.EmployeeTripsResponse": {
+            "type": "object",
+            "properties": {
+                "employee_id": {
+                    "type": "integer"
+                },
+                "trips_a": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TripLogResponse"
+                    }
+                },
+                "trips_b": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TripLogBResponse"
+                    }
+                }
+            }
+        },
+        "dto.FieldError": {
+            "type": "object",
+            "properties": {
+                "field": {
+                    "type": "string",
+                    "example": "region_id"
+                },
+                "message": {
+                    "type": "string",
+                    "example": "is required"
+                },
+                "rule": {
+                    "type": "string",
+                    "example": "required"
+                }
+            }
+        },
+        "dto.GraphQLError": {
+            "type": "object",
+            "properties": {
+                "extensions": {
+                    "type": "object",
+                    "additionalProperties": {}
+                },
+                "message": {
+                    "type": "string"
+                },
+                "path": {
+                    "type": "array",
+                    "items": {}
+                }
+            }
+        },
+        "dto.GraphQLRequest": {
+            "type": "object",
+            "required": [
+                "query"
+            ],
+            "properties": {
+                "operationName": {
+                    "type": "string"
+                },
+                "query": {
+                    "type": "string",
+                    "example": "{ package(packageId: 1) { packageStatus region { regionName } } }"
+                },
+                "variables": {
+                    "type": "object",
+                    "additionalProperties": {}
+                }
+            }
+        },
+        "dto.GraphQLResponse": {
+            "type": "object",
+            "properties": {
+                "data": {},
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.GraphQLError"
+                    }
+                }
+            }
+        },
+        "dto.HealthResponse": {
+            "type": "object",
+            "properties": {
+                "status": {
+                    "type": "string",
+                    "example": "ok"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "type": "object",
+            "required": [
+                "password"
+            ],
+            "properties": {
+                "employee_id": {
+                    "type": "integer"
+                },
+                "password": {
+                    "type": "string"
+                },
+                "username": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.LoginResponse": {
+            "type": "object",
+            "properties": {
+                "employee": {
+                    "$ref": "#/definitions/dto.EmployeeResponse"
+                },
+                "token": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PackageResponse": {
+            "type": "object",
+            "properties": {
+                "package_id": {
+                    "type": "integer"
+                },
+                "package_status": {
+                    "type": "string"
+                },
+                "package_type": {
+                    "type": "string"
+                },
+                "region_id": {
+                    "type": "string"
+                },
+                "registered_at": {
+                    "type": "string"
+                }
+            }
+        },
+        "dto.PasswordResetTokenResponse": {
+            "type": "object",
+            "properties": {
+                "employee_id": {
+                    "type": "integer"
+                },
+                "expires_at": {
+                    "type": "string"
+                },
+                "token": {
+                    "description": "한 번만 노출되므로 안전한 경로로 직원에게 전달",
+                    "type": "string"
+                }
+            }
+        },
+        "dto.Problem": {
+            "type": "object",
+            "properties": {
+                "code": {
+                    "type": "string",
+                    "example": "PACKAGE_NOT_FOUND"
+                },
+                "detail": {
+                    "type": "string"
+                },
+                "errors": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.FieldError"
+                    }
+                },
+                "instance": {
+                    "type": "string",
+                    "example": "/api/package/17"
+                },
+                "status": {
+                    "type": "integer",
+                    "example": 404
+                },
+                "title": {
+                    "type": "string",
+                    "example": "Package not found"
                 },
-                "trip_id": {
-                    "type": "integer"
+                "type": {
+                    "type": "string",
+                    "example": "/problems/package-not-found"
                 }
             }
         },
-        "dto.EmployeeResponse": {
+        "dto.ReadinessResponse": {
             "type": "object",
             "properties": {
-                "employee_id": {
-                    "type": "integer"
+                "checks": {
+                    "type": "object",
+                    "additionalProperties": {
+                        "type": "string"
+                    }
                 },
-                "is_active": {
-                    "type": "boolean"
+                "pending_migrations": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "position": {
-                    "type": "string"
+                "status": {
+                    "type": "string",
+                    "example": "ready"
                 }
             }
         },
-        "dto.ErrorResponse": {
+        "dto.RegionResponse": {
             "type": "object",
             "properties": {
-                "details": {
+                "coord_x": {
+                    "type": "integer"
+                },
+                "coord_y": {
+                    "type": "integer"
+                },
+                "current_capacity": {
+                    "type": "integer"
+                },
+                "is_full": {
+                    "type": "boolean"
+                },
+                "max_capacity": {
+                    "type": "integer"
+                },
+                "region_id": {
                     "type": "string"
                 },
-                "error": {
+                "region_name": {
+                    "type": "string"
+                },
+                "saturated_at": {
                     "type": "string"
                 }
             }
         },
-        "dto.LoginRequest": {
+        "dto.ResetPasswordRequest": {
             "type": "object",
             "required": [
-                "employee_id",
-                "password"
+                "new_password",
+                "token"
             ],
             "properties": {
-                "employee_id": {
-                    "type": "integer"
-                },
-                "password": {
+                "new_password": {
                     "type": "string"
-                }
-            }
-        },
-        "dto.LoginResponse": {
-            "type": "object",
-            "properties": {
-                "employee": {
-                    "$ref": "#/definitions/dto.EmployeeResponse"
                 },
                 "token": {
                     "type": "string"
                 }
             }
         },
-        "dto.PackageResponse": {
+        "dto.ShiftResponse": {
             "type": "object",
             "properties": {
-                "package_id": {
+                "created_by": {
                     "type": "integer"
                 },
-                "package_status": {
-                    "type": "string"
+                "employee_id": {
+                    "type": "integer"
                 },
-                "package_type": {
+                "end_time": {
                     "type": "string"
                 },
-                "region_id": {
+                "shift_id": {
+                    "type": "integer"
+                },
+                "start_time": {
                     "type": "string"
                 },
-                "registered_at": {
+                "vehicle_id": {
                     "type": "string"
                 }
             }
         },
-        "dto.RegionResponse": {
+        "dto.SorterScanRequest": {
             "type": "object",
+            "required": [
+                "package_id"
+            ],
             "properties": {
-                "coord_x": {
-                    "type": "integer"
+                "package_id": {
+                    "type": "integer",
+                    "minimum": 1
                 },
-                "coord_y": {
-                    "type": "integer"
+                "sorter_id": {
+                    "description": "기록용. MQTT 로 받으면 토픽의 분류기 ID",
+                    "type": "string",
+                    "maxLength": 50
+                }
+            }
+        },
+        "dto.SorterScanResponse": {
+            "type": "object",
+            "properties": {
+                "bin_full": {
+                    "description": "BinFull 이 스캔 뒤 적재함이 가득 참. 이후 같은 지역의 패키지는 overflow 로 결정됨",
+                    "type": "boolean"
                 },
-                "current_capacity": {
-                    "type": "integer"
+                "decision": {
+                    "description": "divert, overflow, reject",
+                    "type": "string",
+                    "example": "divert"
                 },
-                "is_full": {
+                "duplicate": {
+                    "description": "Duplicate 이미 투입된 패키지를 다시 인식함. 같은 적재함으로 보내되 적재량은 다시 세지 않음",
                     "type": "boolean"
                 },
-                "max_capacity": {
+                "package_id": {
                     "type": "integer"
                 },
-                "region_id": {
+                "reason": {
+                    "description": "overflow, reject 일 때 사유",
                     "type": "string"
                 },
-                "region_name": {
+                "region_id": {
+                    "description": "RegionID 패키지의 지역이자 divert 일 때 보낼 적재함",
+                    "type": "string",
+                    "example": "R01"
+                },
+                "scanned_at": {
+                    "description": "RFC3339",
                     "type": "string"
                 },
-                "saturated_at": {
+                "sorter_id": {
                     "type": "string"
                 }
             }
@@ -2362,6 +4021,9 @@ const docTemplate = `{
                 "destination_3": {
                     "type": "string"
                 },
+                "driver_id": {
+                    "type": "integer"
+                },
                 "end_time": {
                     "type": "string"
                 },
@@ -2385,6 +4047,9 @@ const docTemplate = `{
                 "destination": {
                     "type": "string"
                 },
+                "driver_id": {
+                    "type": "integer"
+                },
                 "end_time": {
                     "type": "string"
                 },
@@ -2428,18 +4093,43 @@ const docTemplate = `{
         "dto.UpdateEmployeeRequest": {
             "type": "object",
             "properties": {
+                "assigned_vehicle_id": {
+                    "type": "string",
+                    "maxLength": 15
+                },
+                "email": {
+                    "type": "string",
+                    "maxLength": 100
+                },
+                "hire_date": {
+                    "type": "string"
+                },
                 "is_active": {
                     "type": "boolean"
                 },
+                "name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 1
+                },
                 "password": {
                     "type": "string"
                 },
+                "phone": {
+                    "type": "string",
+                    "maxLength": 20
+                },
                 "position": {
                     "type": "string",
                     "enum": [
                         "관리직",
                         "운송직"
                     ]
+                },
+                "username": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 3
                 }
             }
         },
@@ -2447,7 +4137,14 @@ const docTemplate = `{
             "type": "object",
             "properties": {
                 "package_status": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "등록됨",
+                        "A차운송중",
+                        "투입됨",
+                        "B차운송중",
+                        "완료됨"
+                    ]
                 },
                 "package_type": {
                     "type": "string"
@@ -2486,35 +4183,15 @@ const docTemplate = `{
                 }
             }
         },
-        "dto.UpdateTripLogBRequest": {
-            "type": "object",
-            "properties": {
-                "destination_1": {
-                    "type": "string"
-                },
-                "destination_2": {
-                    "type": "string"
-                },
-                "destination_3": {
-                    "type": "string"
-                },
-                "end_time": {
-                    "type": "string"
-                },
-                "start_time": {
-                    "type": "string"
-                },
-                "status": {
-                    "type": "string"
-                }
-            }
-        },
         "dto.UpdateTripLogRequest": {
             "type": "object",
             "properties": {
                 "destination": {
                     "type": "string"
                 },
+                "driver_id": {
+                    "type": "integer"
+                },
                 "end_time": {
                     "type": "string"
                 },
@@ -2522,7 +4199,11 @@ const docTemplate = `{
                     "type": "string"
                 },
                 "status": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "운행중",
+                        "비운행중"
+                    ]
                 }
             }
         },
@@ -2536,13 +4217,47 @@ const docTemplate = `{
                     "type": "integer"
                 },
                 "led_status": {
-                    "type": "string"
+                    "type": "string",
+                    "enum": [
+                        "off",
+                        "green",
+                        "yellow",
+                        "red",
+                        "blue"
+                    ]
                 },
                 "max_load": {
                     "type": "integer"
+                }
+            }
+        },
+        "dto.UpdateWebhookRequest": {
+            "type": "object",
+            "required": [
+                "event_types",
+                "url"
+            ],
+            "properties": {
+                "event_types": {
+                    "description": "예: package.completed, region.saturated",
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "type": "string"
+                    }
                 },
-                "needs_confirmation": {
+                "is_active": {
                     "type": "boolean"
+                },
+                "secret": {
+                    "description": "지정하면 시크릿 교체",
+                    "type": "string",
+                    "maxLength": 128,
+                    "minLength": 16
+                },
+                "url": {
+                    "type": "string",
+                    "maxLength": 500
                 }
             }
         },
@@ -2574,6 +4289,154 @@ const docTemplate = `{
                     "type": "string"
                 }
             }
+        },
+        "dto.WebhookAttemptResponse": {
+            "type": "object",
+            "properties": {
+                "attempt_id": {
+                    "type": "integer"
+                },
+                "attempted_at": {
+                    "type": "string"
+                },
+                "duration_ms": {
+                    "type": "integer"
+                },
+                "error": {
+                    "type": "string"
+                },
+                "status_code": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.WebhookDeliveryDetailResponse": {
+            "type": "object",
+            "properties": {
+                "attempt_logs": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.WebhookAttemptResponse"
+                    }
+                },
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "delivery_id": {
+                    "type": "integer"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "description": "대기 중일 때만",
+                    "type": "string"
+                },
+                "payload": {
+                    "description": "전송한 JSON 본문",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending, succeeded, failed",
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.WebhookDeliveryResponse": {
+            "type": "object",
+            "properties": {
+                "attempts": {
+                    "type": "integer"
+                },
+                "created_at": {
+                    "type": "string"
+                },
+                "delivered_at": {
+                    "type": "string"
+                },
+                "delivery_id": {
+                    "type": "integer"
+                },
+                "event_id": {
+                    "type": "string"
+                },
+                "event_type": {
+                    "type": "string"
+                },
+                "last_error": {
+                    "type": "string"
+                },
+                "last_status_code": {
+                    "type": "integer"
+                },
+                "next_attempt_at": {
+                    "description": "대기 중일 때만",
+                    "type": "string"
+                },
+                "status": {
+                    "description": "pending, succeeded, failed",
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "integer"
+                }
+            }
+        },
+        "dto.WebhookResponse": {
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "string"
+                },
+                "created_by": {
+                    "type": "integer"
+                },
+                "event_types": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    }
+                },
+                "is_active": {
+                    "type": "boolean"
+                },
+                "secret": {
+                    "description": "생성 응답에서만 노출",
+                    "type": "string"
+                },
+                "subscription_id": {
+                    "type": "integer"
+                },
+                "url": {
+                    "type": "string"
+                }
+            }
+        }
+    },
+    "securityDefinitions": {
+        "DeviceKey": {
+            "description": "auth.api_keys 에 등록된 장비 API 키",
+            "type": "apiKey",
+            "name": "X-API-Key",
+            "in": "header"
         }
     }
 }`
@@ -1,7 +1,11 @@
 package dto
 
+import "time"
+
+// LoginRequest employee_id 와 username 중 하나로 로그인
 type LoginRequest struct {
-	EmployeeID int    `json:"employee_id" binding:"required"`
+	EmployeeID int    `json:"employee_id" binding:"required_without=Username"`
+	Username   string `json:"username" binding:"required_without=EmployeeID"`
 	Password   string `json:"password" binding:"required"`
 }
 
@@ -9,3 +13,19 @@ type LoginResponse struct {
 	Token    string           `json:"token"`
 	Employee EmployeeResponse `json:"employee"`
 }
+
+type ChangePasswordRequest struct {
+	OldPassword string `json:"old_password" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+type ResetPasswordRequest struct {
+	Token       string `json:"token" binding:"required"`
+	NewPassword string `json:"new_password" binding:"required"`
+}
+
+type PasswordResetTokenResponse struct {
+	EmployeeID int       `json:"employee_id"`
+	Token      string    `json:"token"` // 한 번만 노출되므로 안전한 경로로 직원에게 전달
+	ExpiresAt  time.Time `json:"expires_at"`
+}
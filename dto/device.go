@@ -0,0 +1,34 @@
+package dto
+
+// 차량/분류기가 MQTT 로 보내는 메시지와 차량으로 보내는 명령
+
+// VehicleTelemetryMessage 차량 위치/적재량 보고. 차량 ID 는 토픽에서 가져옴
+type VehicleTelemetryMessage struct {
+	CoordX      int  `json:"coord_x"`
+	CoordY      int  `json:"coord_y"`
+	CurrentLoad *int `json:"current_load" binding:"omitempty,min=0"`
+}
+
+// PackageScannedMessage 분류기가 택배를 인식함
+type PackageScannedMessage struct {
+	PackageID int `json:"package_id" binding:"required,min=1"`
+}
+
+// BinFullMessage 분류기의 지역 적재함이 가득 참
+type BinFullMessage struct {
+	RegionID string `json:"region_id" binding:"required,len=3"`
+}
+
+// VehicleLedEvent vehicle.led_changed 이벤트 본문
+type VehicleLedEvent struct {
+	VehicleID string `json:"vehicle_id"`
+	LedStatus string `json:"led_status"`
+}
+
+// VehicleCommand 차량 명령 토픽으로 보내는 메시지
+type VehicleCommand struct {
+	Command   string `json:"command"` // set_led
+	LedStatus string `json:"led_status,omitempty"`
+	EventID   string `json:"event_id"` // 중복 수신 시 차량이 무시할 수 있도록
+	IssuedAt  string `json:"issued_at"`
+}
@@ -1,19 +1,37 @@
 package dto
 
 type CreateEmployeeRequest struct {
-	Password string `json:"password" binding:"required"`
-	Position string `json:"position" binding:"required,oneof=관리직 운송직"`
-	IsActive *bool  `json:"is_active"` // optional, default true
+	Password          string  `json:"password" binding:"required"`
+	Position          string  `json:"position" binding:"required,oneof=관리직 운송직"`
+	IsActive          *bool   `json:"is_active"` // optional, default true
+	Name              string  `json:"name" binding:"required,max=50"`
+	Username          *string `json:"username" binding:"omitempty,min=3,max=50"`
+	Phone             *string `json:"phone" binding:"omitempty,max=20"`
+	Email             *string `json:"email" binding:"omitempty,email,max=100"`
+	HireDate          *string `json:"hire_date" binding:"omitempty,datetime=2006-01-02"` // YYYY-MM-DD
+	AssignedVehicleID *string `json:"assigned_vehicle_id" binding:"omitempty,max=15"`
 }
 
 type UpdateEmployeeRequest struct {
-	Password string `json:"password"`
-	Position string `json:"position" binding:"omitempty,oneof=관리직 운송직"`
-	IsActive *bool  `json:"is_active"`
+	Password          string  `json:"password"`
+	Position          string  `json:"position" binding:"omitempty,oneof=관리직 운송직"`
+	IsActive          *bool   `json:"is_active"`
+	Name              *string `json:"name" binding:"omitempty,min=1,max=50"`
+	Username          *string `json:"username" binding:"omitempty,min=3,max=50"`
+	Phone             *string `json:"phone" binding:"omitempty,max=20"`
+	Email             *string `json:"email" binding:"omitempty,email,max=100"`
+	HireDate          *string `json:"hire_date" binding:"omitempty,datetime=2006-01-02"`
+	AssignedVehicleID *string `json:"assigned_vehicle_id" binding:"omitempty,max=15"`
 }
 
 type EmployeeResponse struct {
-	EmployeeID int    `json:"employee_id"`
-	Position   string `json:"position"`
-	IsActive   bool   `json:"is_active"`
+	EmployeeID        int     `json:"employee_id"`
+	Position          string  `json:"position"`
+	IsActive          bool    `json:"is_active"`
+	Name              string  `json:"name"`
+	Username          *string `json:"username"`
+	Phone             *string `json:"phone"`
+	Email             *string `json:"email"`
+	HireDate          *string `json:"hire_date"` // YYYY-MM-DD
+	AssignedVehicleID *string `json:"assigned_vehicle_id"`
 }
@@ -0,0 +1,21 @@
+package dto
+
+// GraphQLRequest POST /graphql 본문이자 WebSocket subscribe 메시지의 payload
+type GraphQLRequest struct {
+	Query         string         `json:"query" binding:"required" example:"{ package(packageId: 1) { packageStatus region { regionName } } }"`
+	OperationName string         `json:"operationName,omitempty"`
+	Variables     map[string]any `json:"variables,omitempty"`
+}
+
+// GraphQLResponse 실행 결과. 필드 단위 에러가 있어도 나머지 data 는 함께 반환됨
+type GraphQLResponse struct {
+	Data   any            `json:"data,omitempty"`
+	Errors []GraphQLError `json:"errors,omitempty"`
+}
+
+// GraphQLError extensions.code 는 REST Problem 의 code 와 같음
+type GraphQLError struct {
+	Message    string         `json:"message"`
+	Path       []any          `json:"path,omitempty"`
+	Extensions map[string]any `json:"extensions,omitempty"`
+}
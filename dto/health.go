@@ -0,0 +1,11 @@
+package dto
+
+type HealthResponse struct {
+	Status string `json:"status" example:"ok"`
+}
+
+type ReadinessResponse struct {
+	Status            string            `json:"status" example:"ready"`
+	Checks            map[string]string `json:"checks"`
+	PendingMigrations []string          `json:"pending_migrations,omitempty"`
+}
@@ -3,13 +3,13 @@ package dto
 type CreatePackageRequest struct {
 	PackageType   string `json:"package_type" binding:"required"`
 	RegionID      string `json:"region_id" binding:"required"`
-	PackageStatus string `json:"package_status"`
+	PackageStatus string `json:"package_status" binding:"omitempty,oneof=등록됨 A차운송중 투입됨 B차운송중 완료됨"`
 }
 
 type UpdatePackageRequest struct {
 	PackageType   string `json:"package_type"`
 	RegionID      string `json:"region_id"`
-	PackageStatus string `json:"package_status"`
+	PackageStatus string `json:"package_status" binding:"omitempty,oneof=등록됨 A차운송중 투입됨 B차운송중 완료됨"`
 }
 
 type PackageResponse struct {
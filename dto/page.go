@@ -0,0 +1,7 @@
+package dto
+
+// PageRequest 검색 결과 페이지. Limit 이 0 이면 전체
+type PageRequest struct {
+	Limit  int
+	Offset int
+}
@@ -0,0 +1,19 @@
+package dto
+
+// Problem 은 RFC 7807 (application/problem+json) 에러 응답입니다.
+type Problem struct {
+	Type     string       `json:"type" example:"/problems/package-not-found"`
+	Title    string       `json:"title" example:"Package not found"`
+	Status   int          `json:"status" example:"404"`
+	Detail   string       `json:"detail,omitempty"`
+	Instance string       `json:"instance,omitempty" example:"/api/package/17"`
+	Code     string       `json:"code" example:"PACKAGE_NOT_FOUND"`
+	Errors   []FieldError `json:"errors,omitempty"`
+}
+
+// FieldError 는 요청 필드 단위의 검증 실패 정보입니다.
+type FieldError struct {
+	Field   string `json:"field" example:"region_id"`
+	Rule    string `json:"rule" example:"required"`
+	Message string `json:"message" example:"is required"`
+}
@@ -0,0 +1,23 @@
+package dto
+
+type CreateShiftRequest struct {
+	EmployeeID int     `json:"employee_id" binding:"required"`
+	VehicleID  *string `json:"vehicle_id"` // 생략 시 직원의 배정 차량(assigned_vehicle_id)
+	StartTime  *string `json:"start_time"` // RFC3339 string, 생략 시 현재 시각
+	EndTime    *string `json:"end_time"`   // RFC3339 string, 생략 시 종료 처리 전까지 진행 중
+}
+
+type ShiftResponse struct {
+	ShiftID    int     `json:"shift_id"`
+	EmployeeID int     `json:"employee_id"`
+	VehicleID  string  `json:"vehicle_id"`
+	StartTime  string  `json:"start_time"`
+	EndTime    *string `json:"end_time"`
+	CreatedBy  int     `json:"created_by"`
+}
+
+type EmployeeTripsResponse struct {
+	EmployeeID int                `json:"employee_id"`
+	TripsA     []TripLogResponse  `json:"trips_a"`
+	TripsB     []TripLogBResponse `json:"trips_b"`
+}
@@ -0,0 +1,36 @@
+package dto
+
+// 분류 결정
+const (
+	SortDivert   = "divert"   // 지역 적재함으로 보냄
+	SortOverflow = "overflow" // 적재함이 가득 차 오버플로 라인으로 보냄
+	SortReject   = "reject"   // 분류할 수 없어 반송 라인으로 보냄
+)
+
+// 오버플로, 반송 사유
+const (
+	ReasonBinFull        = "bin_full"        // 지역 적재함이 포화 상태이거나 최대 용량에 도달
+	ReasonUnknownPackage = "unknown_package" // 등록되지 않은 패키지
+	ReasonInvalidStatus  = "invalid_status"  // 이미 분류를 지나 B차 운송 이후 단계인 패키지
+)
+
+// SorterScanRequest 분류기가 인식한 패키지
+type SorterScanRequest struct {
+	PackageID int    `json:"package_id" binding:"required,min=1"`
+	SorterID  string `json:"sorter_id" binding:"omitempty,max=50"` // 기록용. MQTT 로 받으면 토픽의 분류기 ID
+}
+
+// SorterScanResponse 분류 결정. package.scanned 이벤트 본문으로도 기록됨
+type SorterScanResponse struct {
+	PackageID int    `json:"package_id"`
+	SorterID  string `json:"sorter_id,omitempty"`
+	Decision  string `json:"decision" example:"divert"` // divert, overflow, reject
+	// RegionID 패키지의 지역이자 divert 일 때 보낼 적재함
+	RegionID string `json:"region_id,omitempty" example:"R01"`
+	Reason   string `json:"reason,omitempty"` // overflow, reject 일 때 사유
+	// Duplicate 이미 투입된 패키지를 다시 인식함. 같은 적재함으로 보내되 적재량은 다시 세지 않음
+	Duplicate bool `json:"duplicate,omitempty"`
+	// BinFull 이 스캔 뒤 적재함이 가득 참. 이후 같은 지역의 패키지는 overflow 로 결정됨
+	BinFull   bool   `json:"bin_full"`
+	ScannedAt string `json:"scanned_at"` // RFC3339
+}
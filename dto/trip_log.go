@@ -2,22 +2,25 @@ package dto
 
 type CreateTripLogRequest struct {
 	VehicleID   string  `json:"vehicle_id" binding:"required"`
-	StartTime   *string `json:"start_time"` // RFC3339 string
-	EndTime     *string `json:"end_time"`   // RFC3339 string
-	Status      string  `json:"status"`     // "운행중" or "비운행중"
+	DriverID    *int    `json:"driver_id"`                                 // 생략 시 해당 차량의 진행 중인 근무자
+	StartTime   *string `json:"start_time"`                                // RFC3339 string
+	EndTime     *string `json:"end_time"`                                  // RFC3339 string
+	Status      string  `json:"status" binding:"omitempty,oneof=운행중 비운행중"` // "운행중" or "비운행중"
 	Destination *string `json:"destination"`
 }
 
 type UpdateTripLogRequest struct {
+	DriverID    *int    `json:"driver_id"`
 	StartTime   *string `json:"start_time"`
 	EndTime     *string `json:"end_time"`
-	Status      string  `json:"status"`
+	Status      string  `json:"status" binding:"omitempty,oneof=운행중 비운행중"`
 	Destination *string `json:"destination"`
 }
 
 type TripLogResponse struct {
 	TripID      int     `json:"trip_id"`
 	VehicleID   string  `json:"vehicle_id"`
+	DriverID    *int    `json:"driver_id"`
 	StartTime   *string `json:"start_time"`
 	EndTime     *string `json:"end_time"`
 	Status      string  `json:"status"`
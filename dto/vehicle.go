@@ -5,12 +5,12 @@ type CreateVehicleRequest struct {
 	MaxLoad   int    `json:"max_load"`
 }
 
+// UpdateVehicleRequest needs_confirmation 은 확인 요청 워크플로우로만 변경됨
 type UpdateVehicleRequest struct {
-	MaxLoad           int    `json:"max_load"`
-	LedStatus         string `json:"led_status"`
-	NeedsConfirmation bool   `json:"needs_confirmation"`
-	CoordX            int    `json:"coord_x"`
-	CoordY            int    `json:"coord_y"`
+	MaxLoad   int     `json:"max_load"`
+	LedStatus *string `json:"led_status" binding:"omitempty,oneof=off green yellow red blue"`
+	CoordX    int     `json:"coord_x"`
+	CoordY    int     `json:"coord_y"`
 }
 
 type VehicleResponse struct {
@@ -23,3 +23,26 @@ type VehicleResponse struct {
 	CoordX            int    `json:"coord_x"`
 	CoordY            int    `json:"coord_y"`
 }
+
+type CreateConfirmationRequest struct {
+	Reason   string  `json:"reason" binding:"required,oneof=arrival load_mismatch other"`
+	Detail   *string `json:"detail" binding:"omitempty,max=255"`
+	LedColor string  `json:"led_color" binding:"omitempty,oneof=green yellow red blue"` // 생략 시 사유별 기본 색상
+}
+
+type AcknowledgeConfirmationRequest struct {
+	Note *string `json:"note" binding:"omitempty,max=255"`
+}
+
+type ConfirmationResponse struct {
+	ConfirmationID int     `json:"confirmation_id"`
+	VehicleID      string  `json:"vehicle_id"`
+	Reason         string  `json:"reason"`
+	Detail         *string `json:"detail"`
+	LedColor       string  `json:"led_color"`
+	Status         string  `json:"status"`
+	RequestedAt    string  `json:"requested_at"`
+	AcknowledgedBy *int    `json:"acknowledged_by"`
+	AcknowledgedAt *string `json:"acknowledged_at"`
+	Note           *string `json:"note"`
+}
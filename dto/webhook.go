@@ -0,0 +1,53 @@
+package dto
+
+type CreateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required,http_url,max=500"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,dive,required"` // 예: package.completed, region.saturated
+	Secret     *string  `json:"secret" binding:"omitempty,min=16,max=128"`          // 생략 시 서버에서 생성
+	IsActive   *bool    `json:"is_active"`                                          // optional, default true
+}
+
+type UpdateWebhookRequest struct {
+	URL        string   `json:"url" binding:"required,http_url,max=500"`
+	EventTypes []string `json:"event_types" binding:"required,min=1,dive,required"` // 예: package.completed, region.saturated
+	Secret     *string  `json:"secret" binding:"omitempty,min=16,max=128"`          // 지정하면 시크릿 교체
+	IsActive   *bool    `json:"is_active"`
+}
+
+type WebhookResponse struct {
+	SubscriptionID int      `json:"subscription_id"`
+	URL            string   `json:"url"`
+	EventTypes     []string `json:"event_types"`
+	IsActive       bool     `json:"is_active"`
+	CreatedBy      int      `json:"created_by"`
+	CreatedAt      string   `json:"created_at"`
+	Secret         string   `json:"secret,omitempty"` // 생성 응답에서만 노출
+}
+
+type WebhookDeliveryResponse struct {
+	DeliveryID     int     `json:"delivery_id"`
+	SubscriptionID int     `json:"subscription_id"`
+	EventID        string  `json:"event_id"`
+	EventType      string  `json:"event_type"`
+	Status         string  `json:"status"` // pending, succeeded, failed
+	Attempts       int     `json:"attempts"`
+	NextAttemptAt  *string `json:"next_attempt_at"` // 대기 중일 때만
+	LastStatusCode *int    `json:"last_status_code"`
+	LastError      *string `json:"last_error"`
+	CreatedAt      string  `json:"created_at"`
+	DeliveredAt    *string `json:"delivered_at"`
+}
+
+type WebhookAttemptResponse struct {
+	AttemptID   int     `json:"attempt_id"`
+	AttemptedAt string  `json:"attempted_at"`
+	StatusCode  *int    `json:"status_code"`
+	Error       *string `json:"error"`
+	DurationMs  int64   `json:"duration_ms"`
+}
+
+type WebhookDeliveryDetailResponse struct {
+	WebhookDeliveryResponse
+	Payload     string                   `json:"payload"` // 전송한 JSON 본문
+	AttemptLogs []WebhookAttemptResponse `json:"attempt_logs"`
+}
@@ -0,0 +1,75 @@
+package graphqlapi
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	qerrors "github.com/graph-gophers/graphql-go/errors"
+)
+
+// 에러 코드 생성에 사용하는 리소스 이름 (REST 핸들러와 같음)
+const (
+	regionResource      = "region"
+	packageResource     = "package"
+	vehicleResource     = "vehicle"
+	tripLogResource     = "trip_log"
+	deliveryLogResource = "delivery_log"
+	employeeResource    = "employee"
+)
+
+// resolverError REST 의 Problem 과 같은 코드를 extensions 로 전달하는 GraphQL 에러
+type resolverError struct {
+	err *apperror.Error
+}
+
+func (e *resolverError) Error() string {
+	if e.err.Detail != "" {
+		return e.err.Title + ": " + e.err.Detail
+	}
+	return e.err.Title
+}
+
+func (e *resolverError) Extensions() map[string]any {
+	ext := map[string]any{"code": e.err.Code, "status": e.err.Status}
+	if len(e.err.Fields) > 0 {
+		ext["fields"] = e.err.Fields
+	}
+	return ext
+}
+
+// toError apperror 변환을 거쳐 GraphQL 에러로. 서버 에러는 원인을 기록하고 숨김
+func toError(ctx context.Context, err error, resource string) error {
+	if err == nil {
+		return nil
+	}
+	appErr := apperror.Translate(err, resource)
+	// 클라이언트가 연결을 끊어 취소된 조회는 서버 에러로 기록하지 않음
+	if appErr.Status >= http.StatusInternalServerError && !errors.Is(err, context.Canceled) {
+		slog.ErrorContext(ctx, "graphql resolver failed", "code", appErr.Code, "resource", resource, "error", err)
+	}
+	return &resolverError{err: appErr}
+}
+
+// subscriptionError 구독 시작 실패. graphql-go 는 일반 에러의 메시지만 남기므로 extensions 를 채운 QueryError 로 반환
+func subscriptionError(ctx context.Context, err error, resource string) error {
+	re := toError(ctx, err, resource).(*resolverError)
+	return &qerrors.QueryError{Message: re.Error(), Extensions: re.Extensions(), ResolverError: re}
+}
+
+// orNull 단건 조회에서 없는 대상은 에러 대신 null
+func orNull[T any](ctx context.Context, v *T, err error, resource string) (*T, error) {
+	if err != nil {
+		if isNotFound(err) {
+			return nil, nil
+		}
+		return nil, toError(ctx, err, resource)
+	}
+	return v, nil
+}
+
+func isNotFound(err error) bool {
+	return err != nil && apperror.Translate(err, "").Status == http.StatusNotFound
+}
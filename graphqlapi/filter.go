@@ -0,0 +1,153 @@
+package graphqlapi
+
+import "strconv"
+
+// 검색 조건을 REST 검색과 같은 컬럼 이름의 파라미터로 바꿈. 필드가 곧 허용된 컬럼 목록
+
+type regionFilter struct {
+	RegionID        *string
+	RegionName      *string
+	CoordX          *int32
+	CoordY          *int32
+	MaxCapacity     *int32
+	CurrentCapacity *int32
+	IsFull          *bool
+	SaturatedAt     *string
+}
+
+func (f *regionFilter) params() map[string]string {
+	p := map[string]string{}
+	if f == nil {
+		return p
+	}
+	setParam(p, "region_id", f.RegionID)
+	setParam(p, "region_name", f.RegionName)
+	setParam(p, "coord_x", f.CoordX)
+	setParam(p, "coord_y", f.CoordY)
+	setParam(p, "max_capacity", f.MaxCapacity)
+	setParam(p, "current_capacity", f.CurrentCapacity)
+	setParam(p, "is_full", f.IsFull)
+	setParam(p, "saturated_at", f.SaturatedAt)
+	return p
+}
+
+type packageFilter struct {
+	PackageID     *int32
+	PackageType   *string
+	RegionID      *string
+	PackageStatus *string
+	RegisteredAt  *string
+}
+
+func (f *packageFilter) params() map[string]string {
+	p := map[string]string{}
+	if f == nil {
+		return p
+	}
+	setParam(p, "package_id", f.PackageID)
+	setParam(p, "package_type", f.PackageType)
+	setParam(p, "region_id", f.RegionID)
+	setParam(p, "package_status", f.PackageStatus)
+	setParam(p, "registered_at", f.RegisteredAt)
+	return p
+}
+
+type vehicleFilter struct {
+	InternalID        *int32
+	VehicleID         *string
+	CurrentLoad       *int32
+	MaxLoad           *int32
+	LedStatus         *string
+	NeedsConfirmation *bool
+	CoordX            *int32
+	CoordY            *int32
+}
+
+func (f *vehicleFilter) params() map[string]string {
+	p := map[string]string{}
+	if f == nil {
+		return p
+	}
+	setParam(p, "internal_id", f.InternalID)
+	setParam(p, "vehicle_id", f.VehicleID)
+	setParam(p, "current_load", f.CurrentLoad)
+	setParam(p, "max_load", f.MaxLoad)
+	setParam(p, "led_status", f.LedStatus)
+	setParam(p, "needs_confirmation", f.NeedsConfirmation)
+	setParam(p, "coord_x", f.CoordX)
+	setParam(p, "coord_y", f.CoordY)
+	return p
+}
+
+type tripLogFilter struct {
+	TripID      *int32
+	VehicleID   *string
+	DriverID    *int32
+	Status      *string
+	Destination *string
+	StartTime   *string
+	EndTime     *string
+}
+
+func (f *tripLogFilter) params() map[string]string {
+	p := map[string]string{}
+	if f == nil {
+		return p
+	}
+	setParam(p, "trip_id", f.TripID)
+	setParam(p, "vehicle_id", f.VehicleID)
+	setParam(p, "driver_id", f.DriverID)
+	setParam(p, "status", f.Status)
+	setParam(p, "destination", f.Destination)
+	setParam(p, "start_time", f.StartTime)
+	setParam(p, "end_time", f.EndTime)
+	return p
+}
+
+type deliveryLogFilter struct {
+	TripID              *int32
+	PackageID           *int32
+	RegionID            *string
+	LoadOrder           *int32
+	RegisteredAt        *string
+	FirstTransportTime  *string
+	InputTime           *string
+	SecondTransportTime *string
+	CompletedAt         *string
+}
+
+func (f *deliveryLogFilter) params() map[string]string {
+	p := map[string]string{}
+	if f == nil {
+		return p
+	}
+	setParam(p, "trip_id", f.TripID)
+	setParam(p, "package_id", f.PackageID)
+	setParam(p, "region_id", f.RegionID)
+	setParam(p, "load_order", f.LoadOrder)
+	setParam(p, "registered_at", f.RegisteredAt)
+	setParam(p, "first_transport_time", f.FirstTransportTime)
+	setParam(p, "input_time", f.InputTime)
+	setParam(p, "second_transport_time", f.SecondTransportTime)
+	setParam(p, "completed_at", f.CompletedAt)
+	return p
+}
+
+// setParam 지정된 조건만 REST 쿼리 문자열과 같은 형태로 추가. bool 은 1/0 으로 넣고 저장소가 bool 로 파싱해 비교
+func setParam[T string | int32 | bool](params map[string]string, column string, v *T) {
+	if v == nil {
+		return
+	}
+	switch v := any(*v).(type) {
+	case string:
+		params[column] = v
+	case int32:
+		params[column] = strconv.Itoa(int(v))
+	case bool:
+		if v {
+			params[column] = "1"
+		} else {
+			params[column] = "0"
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/graph-gophers/dataloader"
+)
+
+// loaders 연관 엔티티를 키별로 모아 한 번의 IN 쿼리로 조회
+type loaders struct {
+	region                *dataloader.Loader // region_id → *models.Region
+	pkg                   *dataloader.Loader // package_id → *models.Package
+	packagesByRegion      *dataloader.Loader // region_id → []models.Package
+	vehicle               *dataloader.Loader // vehicle_id → *models.Vehicle
+	tripLog               *dataloader.Loader // trip_id → *dto.TripLogResponse
+	tripLogsByVehicle     *dataloader.Loader // vehicle_id → []dto.TripLogResponse
+	deliveryLogsByPackage *dataloader.Loader // package_id → []dto.DeliveryLogResponse
+	deliveryLogsByTrip    *dataloader.Loader // trip_id → []dto.DeliveryLogResponse
+	employee              *dataloader.Loader // employee_id → *dto.EmployeeResponse
+}
+
+type loadersKey struct{}
+
+// withLoaders cache 가 false 이면 같은 배치 안에서만 묶고 결과를 재사용하지 않음 (구독)
+func withLoaders(ctx context.Context, svc services, cache bool) context.Context {
+	newLoader := func(batch dataloader.BatchFunc) *dataloader.Loader {
+		if cache {
+			return dataloader.NewBatchedLoader(batch)
+		}
+		return dataloader.NewBatchedLoader(batch, dataloader.WithCache(&dataloader.NoCache{}))
+	}
+	l := &loaders{
+		region: newLoader(batchOne(svc.regions.GetRegionsByIDs,
+			func(m models.Region) string { return m.RegionID })),
+		pkg: newLoader(batchOne(svc.packages.GetPackagesByIDs,
+			func(m models.Package) int { return m.PackageID })),
+		packagesByRegion: newLoader(batchMany(svc.packages.ListPackagesByRegions,
+			func(m models.Package) string { return m.RegionID })),
+		vehicle: newLoader(batchOne(svc.vehicles.GetVehiclesByVehicleIDs,
+			func(m models.Vehicle) string { return m.VehicleID })),
+		tripLog: newLoader(batchOne(svc.tripLogs.GetTripLogsByIDs,
+			func(r dto.TripLogResponse) int { return r.TripID })),
+		tripLogsByVehicle: newLoader(batchMany(svc.tripLogs.ListTripLogsByVehicles,
+			func(r dto.TripLogResponse) string { return r.VehicleID })),
+		deliveryLogsByPackage: newLoader(batchMany(svc.deliveryLogs.ListDeliveryLogsByPackages,
+			func(r dto.DeliveryLogResponse) int { return r.PackageID })),
+		deliveryLogsByTrip: newLoader(batchMany(svc.deliveryLogs.ListDeliveryLogsByTrips,
+			func(r dto.DeliveryLogResponse) int { return r.TripID })),
+		employee: newLoader(batchOne(svc.employees.GetEmployeesByIDs,
+			func(r dto.EmployeeResponse) int { return r.EmployeeID })),
+	}
+	return context.WithValue(ctx, loadersKey{}, l)
+}
+
+func loadersFrom(ctx context.Context) *loaders {
+	return ctx.Value(loadersKey{}).(*loaders)
+}
+
+type key[K comparable] struct {
+	v K
+}
+
+func (k key[K]) String() string { return fmt.Sprint(k.v) }
+func (k key[K]) Raw() any       { return k.v }
+
+func rawKeys[K comparable](keys dataloader.Keys) []K {
+	ks := make([]K, len(keys))
+	for i, k := range keys {
+		ks[i] = k.Raw().(K)
+	}
+	return ks
+}
+
+// batchOne 키마다 하나의 값. 없는 키는 nil
+func batchOne[K comparable, V any](fetch func(context.Context, []K) ([]V, error), keyOf func(V) K) dataloader.BatchFunc {
+	return func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		ks := rawKeys[K](keys)
+		items, err := fetch(ctx, ks)
+		if err != nil {
+			return failAll(len(keys), err)
+		}
+		byKey := make(map[K]*V, len(items))
+		for i := range items {
+			byKey[keyOf(items[i])] = &items[i]
+		}
+		results := make([]*dataloader.Result, len(ks))
+		for i, k := range ks {
+			results[i] = &dataloader.Result{Data: byKey[k]}
+		}
+		return results
+	}
+}
+
+// batchMany 키마다 여러 값. 없는 키는 빈 목록
+func batchMany[K comparable, V any](fetch func(context.Context, []K) ([]V, error), keyOf func(V) K) dataloader.BatchFunc {
+	return func(ctx context.Context, keys dataloader.Keys) []*dataloader.Result {
+		ks := rawKeys[K](keys)
+		items, err := fetch(ctx, ks)
+		if err != nil {
+			return failAll(len(keys), err)
+		}
+		byKey := make(map[K][]V, len(ks))
+		for _, item := range items {
+			k := keyOf(item)
+			byKey[k] = append(byKey[k], item)
+		}
+		results := make([]*dataloader.Result, len(ks))
+		for i, k := range ks {
+			results[i] = &dataloader.Result{Data: append([]V{}, byKey[k]...)}
+		}
+		return results
+	}
+}
+
+func failAll(n int, err error) []*dataloader.Result {
+	results := make([]*dataloader.Result, n)
+	for i := range results {
+		results[i] = &dataloader.Result{Error: err}
+	}
+	return results
+}
+
+// load 로더에서 키 하나의 값을 기다림
+func load[T any, K comparable](ctx context.Context, l *dataloader.Loader, k K) (T, error) {
+	v, err := l.Load(ctx, key[K]{k})()
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return v.(T), nil
+}
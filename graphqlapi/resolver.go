@@ -0,0 +1,212 @@
+package graphqlapi
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/outbox"
+)
+
+// defaultPageSize limit 을 생략했을 때의 페이지 크기
+const defaultPageSize = 20
+
+// resolver Query, Subscription 루트
+type resolver struct {
+	svc services
+	bus *outbox.Bus
+	cfg config.GraphQLConfig
+}
+
+// page limit 은 기본 20, 최대 max_page_size
+func (r *resolver) page(sortArg *string, limit, offset *int32) (dto.PageRequest, string, error) {
+	page := dto.PageRequest{Limit: defaultPageSize}
+	if limit != nil {
+		page.Limit = int(*limit)
+	}
+	if offset != nil {
+		page.Offset = int(*offset)
+	}
+	if page.Limit < 1 || page.Limit > r.cfg.MaxPageSize || page.Offset < 0 {
+		return page, "", &resolverError{err: apperror.Validation([]apperror.FieldError{{
+			Field: "limit", Rule: "range",
+			Message: fmt.Sprintf("limit must be between 1 and %d, offset must not be negative", r.cfg.MaxPageSize),
+		}})}
+	}
+	sort := ""
+	if sortArg != nil {
+		sort = *sortArg
+	}
+	return page, sort, nil
+}
+
+// pageInfo 목록 페이지의 공통 필드
+type pageInfo struct {
+	total int64
+	next  bool
+}
+
+func newPageInfo(page dto.PageRequest, count int, total int64) pageInfo {
+	return pageInfo{total: total, next: int64(page.Offset+count) < total}
+}
+
+func (p pageInfo) TotalCount() int32 { return int32(p.total) }
+func (p pageInfo) HasNextPage() bool { return p.next }
+
+func (r *resolver) Region(ctx context.Context, args struct{ RegionID string }) (*regionResolver, error) {
+	region, err := r.svc.regions.GetRegionByID(ctx, args.RegionID)
+	region, err = orNull(ctx, region, err, regionResource)
+	if region == nil {
+		return nil, err
+	}
+	return newRegionResolver(region), nil
+}
+
+type regionPage struct {
+	pageInfo
+	items []*regionResolver
+}
+
+func (p *regionPage) Items() []*regionResolver { return p.items }
+
+func (r *resolver) Regions(ctx context.Context, args struct {
+	Filter *regionFilter
+	Sort   *string
+	Limit  *int32
+	Offset *int32
+}) (*regionPage, error) {
+	page, sort, err := r.page(args.Sort, args.Limit, args.Offset)
+	if err != nil {
+		return nil, err
+	}
+	regions, total, err := r.svc.regions.PageRegions(ctx, args.Filter.params(), sort, page)
+	if err != nil {
+		return nil, toError(ctx, err, regionResource)
+	}
+	return &regionPage{pageInfo: newPageInfo(page, len(regions), total), items: wrapAll(regions, newRegionResolver)}, nil
+}
+
+func (r *resolver) Package(ctx context.Context, args struct{ PackageID int32 }) (*packageResolver, error) {
+	pkg, err := r.svc.packages.GetPackageByID(ctx, int(args.PackageID))
+	pkg, err = orNull(ctx, pkg, err, packageResource)
+	if pkg == nil {
+		return nil, err
+	}
+	return newPackageResolver(pkg), nil
+}
+
+type packagePage struct {
+	pageInfo
+	items []*packageResolver
+}
+
+func (p *packagePage) Items() []*packageResolver { return p.items }
+
+func (r *resolver) Packages(ctx context.Context, args struct {
+	Filter *packageFilter
+	Sort   *string
+	Limit  *int32
+	Offset *int32
+}) (*packagePage, error) {
+	page, sort, err := r.page(args.Sort, args.Limit, args.Offset)
+	if err != nil {
+		return nil, err
+	}
+	pkgs, total, err := r.svc.packages.PagePackages(ctx, args.Filter.params(), sort, page)
+	if err != nil {
+		return nil, toError(ctx, err, packageResource)
+	}
+	return &packagePage{pageInfo: newPageInfo(page, len(pkgs), total), items: wrapAll(pkgs, newPackageResolver)}, nil
+}
+
+func (r *resolver) Vehicle(ctx context.Context, args struct{ InternalID int32 }) (*vehicleResolver, error) {
+	vehicle, err := r.svc.vehicles.GetVehicleByID(ctx, int(args.InternalID))
+	vehicle, err = orNull(ctx, vehicle, err, vehicleResource)
+	if vehicle == nil {
+		return nil, err
+	}
+	return newVehicleResolver(vehicle), nil
+}
+
+type vehiclePage struct {
+	pageInfo
+	items []*vehicleResolver
+}
+
+func (p *vehiclePage) Items() []*vehicleResolver { return p.items }
+
+func (r *resolver) Vehicles(ctx context.Context, args struct {
+	Filter *vehicleFilter
+	Sort   *string
+	Limit  *int32
+	Offset *int32
+}) (*vehiclePage, error) {
+	page, sort, err := r.page(args.Sort, args.Limit, args.Offset)
+	if err != nil {
+		return nil, err
+	}
+	vehicles, total, err := r.svc.vehicles.PageVehicles(ctx, args.Filter.params(), sort, page)
+	if err != nil {
+		return nil, toError(ctx, err, vehicleResource)
+	}
+	return &vehiclePage{pageInfo: newPageInfo(page, len(vehicles), total), items: wrapAll(vehicles, newVehicleResolver)}, nil
+}
+
+func (r *resolver) TripLog(ctx context.Context, args struct{ TripID int32 }) (*tripLogResolver, error) {
+	trip, err := r.svc.tripLogs.GetTripLogByID(ctx, int(args.TripID))
+	trip, err = orNull(ctx, trip, err, tripLogResource)
+	if trip == nil {
+		return nil, err
+	}
+	return newTripLogResolver(trip), nil
+}
+
+type tripLogPage struct {
+	pageInfo
+	items []*tripLogResolver
+}
+
+func (p *tripLogPage) Items() []*tripLogResolver { return p.items }
+
+func (r *resolver) TripLogs(ctx context.Context, args struct {
+	Filter *tripLogFilter
+	Sort   *string
+	Limit  *int32
+	Offset *int32
+}) (*tripLogPage, error) {
+	page, sort, err := r.page(args.Sort, args.Limit, args.Offset)
+	if err != nil {
+		return nil, err
+	}
+	trips, total, err := r.svc.tripLogs.PageTripLogs(ctx, args.Filter.params(), sort, page)
+	if err != nil {
+		return nil, toError(ctx, err, tripLogResource)
+	}
+	return &tripLogPage{pageInfo: newPageInfo(page, len(trips), total), items: wrapAll(trips, newTripLogResolver)}, nil
+}
+
+type deliveryLogPage struct {
+	pageInfo
+	items []*deliveryLogResolver
+}
+
+func (p *deliveryLogPage) Items() []*deliveryLogResolver { return p.items }
+
+func (r *resolver) DeliveryLogs(ctx context.Context, args struct {
+	Filter *deliveryLogFilter
+	Sort   *string
+	Limit  *int32
+	Offset *int32
+}) (*deliveryLogPage, error) {
+	page, sort, err := r.page(args.Sort, args.Limit, args.Offset)
+	if err != nil {
+		return nil, err
+	}
+	logs, total, err := r.svc.deliveryLogs.PageDeliveryLogs(ctx, args.Filter.params(), sort, page)
+	if err != nil {
+		return nil, toError(ctx, err, deliveryLogResource)
+	}
+	return &deliveryLogPage{pageInfo: newPageInfo(page, len(logs), total), items: wrapAll(logs, newDeliveryLogResolver)}, nil
+}
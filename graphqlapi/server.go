@@ -0,0 +1,117 @@
+// Package graphqlapi 는 대시보드용 GraphQL 스키마와 구독(WebSocket) 전송을 제공합니다.
+//
+// 리졸버는 REST 핸들러와 같은 service.*Service 를 사용하고, 연관 엔티티는 요청마다 만든 dataloader 로
+// 묶어서 조회하여 N+1 쿼리를 피합니다. 구독은 아웃박스 버스의 이벤트로 갱신됩니다.
+package graphqlapi
+
+import (
+	"context"
+	_ "embed"
+	"log/slog"
+	"net/http"
+	"slices"
+
+	"github.com/baboyiban/go-api-server/cache"
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gorilla/websocket"
+	graphql "github.com/graph-gophers/graphql-go"
+	gqlerrors "github.com/graph-gophers/graphql-go/errors"
+	oteltrace "github.com/graph-gophers/graphql-go/trace/otel"
+	"go.opentelemetry.io/otel"
+)
+
+//go:embed schema.graphql
+var schemaSDL string
+
+// services 리졸버와 dataloader 가 사용하는 서비스 계층
+type services struct {
+	regions      *service.RegionService
+	packages     *service.PackageService
+	vehicles     *service.VehicleService
+	tripLogs     *service.TripLogService
+	deliveryLogs *service.DeliveryLogService
+	employees    *service.EmployeeService
+}
+
+// Server 파싱된 스키마와 WebSocket 전송 설정
+type Server struct {
+	cfg      config.GraphQLConfig
+	schema   *graphql.Schema
+	svc      services
+	auth     *middleware.Authenticator
+	upgrader websocket.Upgrader
+}
+
+// NewServer allowOrigins 는 CORS 설정과 같으며 WebSocket 연결의 Origin 검사에 사용.
+// readCache 는 REST 와 공유하는 지역, 차량 조회 캐시 (nil 이면 캐시하지 않음)
+func NewServer(cfg config.GraphQLConfig, allowOrigins []string, store repository.Store, bus *outbox.Bus, auth *middleware.Authenticator, employeeStatus *service.EmployeeStatusCache, readCache *cache.Cache) (*Server, error) {
+	svc := services{
+		regions:      service.NewRegionService(store, readCache),
+		packages:     service.NewPackageService(store, readCache),
+		vehicles:     service.NewVehicleService(store, readCache),
+		tripLogs:     service.NewTripLogService(store),
+		deliveryLogs: service.NewDeliveryLogService(store),
+		employees:    service.NewEmployeeService(store, employeeStatus),
+	}
+	opts := []graphql.SchemaOpt{
+		graphql.MaxDepth(cfg.MaxDepth),
+		graphql.Tracer(&oteltrace.Tracer{Tracer: otel.Tracer("github.com/baboyiban/go-api-server/graphqlapi")}),
+		graphql.Logger(panicLogger{}),
+		graphql.PanicHandler(panicLogger{}),
+	}
+	if !cfg.Introspection {
+		opts = append(opts, graphql.DisableIntrospection())
+	}
+	schema, err := graphql.ParseSchema(schemaSDL, &resolver{svc: svc, bus: bus, cfg: cfg}, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		cfg:    cfg,
+		schema: schema,
+		svc:    svc,
+		auth:   auth,
+		upgrader: websocket.Upgrader{
+			Subprotocols: []string{wsProtocol},
+			CheckOrigin:  checkOrigin(allowOrigins),
+		},
+	}, nil
+}
+
+// Exec 쿼리 실행. 연관 엔티티는 이 요청 안에서만 캐시됨
+func (s *Server) Exec(ctx context.Context, req dto.GraphQLRequest) *graphql.Response {
+	ctx = withLoaders(ctx, s.svc, true)
+	return s.schema.Exec(ctx, req.Query, req.OperationName, req.Variables)
+}
+
+// subscribe 구독 실행. 이벤트마다 최신 상태를 보내야 하므로 연관 엔티티를 캐시하지 않음
+func (s *Server) subscribe(ctx context.Context, req dto.GraphQLRequest) (<-chan any, error) {
+	ctx = withLoaders(ctx, s.svc, false)
+	return s.schema.Subscribe(ctx, req.Query, req.OperationName, req.Variables)
+}
+
+// checkOrigin 브라우저가 아닌 클라이언트(Origin 없음)와 CORS 허용 출처만 연결 허용
+func checkOrigin(allowOrigins []string) func(r *http.Request) bool {
+	return func(r *http.Request) bool {
+		origin := r.Header.Get("Origin")
+		return origin == "" || slices.Contains(allowOrigins, origin)
+	}
+}
+
+// panicLogger 리졸버 패닉을 기록하고 클라이언트에는 내부 에러만 알림
+type panicLogger struct{}
+
+func (panicLogger) LogPanic(ctx context.Context, value any) {
+	slog.ErrorContext(ctx, "graphql resolver panic", "panic", value)
+}
+
+func (panicLogger) MakePanicError(_ context.Context, _ any) *gqlerrors.QueryError {
+	err := gqlerrors.Errorf("Internal server error")
+	err.Extensions = map[string]any{"code": "INTERNAL_ERROR", "status": http.StatusInternalServerError}
+	return err
+}
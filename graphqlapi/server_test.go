@@ -0,0 +1,150 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"os"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/utils"
+)
+
+func TestMain(m *testing.M) {
+	utils.ConfigureJWT("test-secret-test-secret-test-secret", time.Hour)
+	os.Exit(m.Run())
+}
+
+// newTestServer 지역 두 곳, 택배 세 개, 차량, 직원 두 명(1 관리직, 2 운송직), 운행 기록과 배송 기록이 있는 서버
+func newTestServer(t *testing.T, configure func(cfg *config.GraphQLConfig)) (*Server, *repository.MemoryStore, *outbox.Bus) {
+	t.Helper()
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	driverID, destination := 2, "R01"
+	for _, err := range []error{
+		store.Regions().Create(ctx, &models.Region{RegionID: "R01", RegionName: "Seoul", MaxCapacity: 10}),
+		store.Regions().Create(ctx, &models.Region{RegionID: "R02", RegionName: "Busan", MaxCapacity: 10}),
+		store.Packages().Create(ctx, &models.Package{PackageType: "box", RegionID: "R01", PackageStatus: "등록됨"}),
+		store.Packages().Create(ctx, &models.Package{PackageType: "bag", RegionID: "R01", PackageStatus: "등록됨"}),
+		store.Packages().Create(ctx, &models.Package{PackageType: "envelope", RegionID: "R02", PackageStatus: "등록됨"}),
+		store.Vehicles().Create(ctx, &models.Vehicle{VehicleID: "A01", MaxLoad: 5}),
+		store.Employees().Create(ctx, &models.Employee{Name: "Kim", Password: "x", Position: "관리직", IsActive: true}),
+		store.Employees().Create(ctx, &models.Employee{Name: "Lee", Password: "x", Position: "운송직", IsActive: true}),
+		store.TripLogs().Create(ctx, &models.TripLog{VehicleID: "A01", DriverID: &driverID, Status: "운행중", Destination: &destination}),
+		store.DeliveryLogs().Create(ctx, &models.DeliveryLog{TripID: 1, PackageID: 1, RegionID: "R01", LoadOrder: 1}),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	cfg := config.Default().GraphQL
+	cfg.WatchResync = time.Hour
+	if configure != nil {
+		configure(&cfg)
+	}
+	status := service.NewEmployeeStatusCache(store, 0)
+	auth := middleware.NewAuthenticator(status, middleware.NewSession(config.Default().Auth.Session))
+	bus := outbox.NewBus()
+	srv, err := NewServer(cfg, []string{"https://dashboard.example.com"}, store, bus, auth, status, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return srv, store, bus
+}
+
+func TestServer_Exec(t *testing.T) {
+	tripQuery := `{ tripLog(tripId: 1) { vehicle { vehicleId } destinationRegion { regionName } driver { name }
+		deliveryLogs { package { packageType } } } }`
+	tests := []struct {
+		name      string
+		configure func(cfg *config.GraphQLConfig)
+		position  string // 비어 있으면 viewer 없음
+		query     string
+		wantData  string   // 비어 있으면 확인하지 않음
+		wantErrs  []string // 에러마다 extensions.code, 코드가 없으면 메시지 일부
+	}{
+		{name: "nested relations", position: "운송직",
+			query:    `{ region(regionId: "R01") { regionName packages { packageId region { regionId } } } }`,
+			wantData: `{"region":{"regionName":"Seoul","packages":[{"packageId":1,"region":{"regionId":"R01"}},{"packageId":2,"region":{"regionId":"R01"}}]}}`},
+		{name: "missing is null", position: "운송직",
+			query:    `{ region(regionId: "R99") { regionId } package(packageId: 99) { packageId } }`,
+			wantData: `{"region":null,"package":null}`},
+		{name: "filter sort page", position: "운송직",
+			query:    `{ packages(filter: {regionId: "R01"}, sort: "-package_id", limit: 1) { items { packageId } totalCount hasNextPage } }`,
+			wantData: `{"packages":{"items":[{"packageId":2}],"totalCount":2,"hasNextPage":true}}`},
+		{name: "last page", position: "운송직",
+			query:    `{ packages(limit: 2, offset: 2) { items { packageId } hasNextPage } }`,
+			wantData: `{"packages":{"items":[{"packageId":3}],"hasNextPage":false}}`},
+		{name: "page size limit", position: "운송직",
+			query:    `{ packages(limit: 1000) { totalCount } }`,
+			wantErrs: []string{"VALIDATION_FAILED"}},
+		{name: "date filter", position: "운송직",
+			query:    `{ packages(filter: {registeredAt: "2000-01-01"}) { totalCount } }`,
+			wantData: `{"packages":{"totalCount":0}}`},
+		{name: "driver visible to manager", position: "관리직", query: tripQuery,
+			wantData: `{"tripLog":{"vehicle":{"vehicleId":"A01"},"destinationRegion":{"regionName":"Seoul"},"driver":{"name":"Lee"},"deliveryLogs":[{"package":{"packageType":"box"}}]}}`},
+		// 직원 정보만 null 이 되고 나머지는 반환
+		{name: "driver hidden from driver", position: "운송직", query: tripQuery,
+			wantData: `{"tripLog":{"vehicle":{"vehicleId":"A01"},"destinationRegion":{"regionName":"Seoul"},"driver":null,"deliveryLogs":[{"package":{"packageType":"box"}}]}}`,
+			wantErrs: []string{"FORBIDDEN"}},
+		{name: "driver without viewer", query: `{ tripLog(tripId: 1) { driver { name } } }`,
+			wantData: `{"tripLog":{"driver":null}}`, wantErrs: []string{"UNAUTHORIZED"}},
+		{name: "depth limit", position: "운송직",
+			configure: func(cfg *config.GraphQLConfig) { cfg.MaxDepth = 3 },
+			query:     `{ region(regionId: "R01") { packages { region { regionId } } } }`,
+			wantErrs:  []string{"exceeds max depth"}},
+		{name: "introspection disabled", position: "운송직",
+			configure: func(cfg *config.GraphQLConfig) { cfg.Introspection = false },
+			query:     `{ __schema { queryType { name } } }`,
+			wantData:  `{}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _, _ := newTestServer(t, tt.configure)
+			ctx := context.Background()
+			if tt.position != "" {
+				ctx = WithViewer(ctx, 1, tt.position)
+			}
+			res := srv.Exec(ctx, dto.GraphQLRequest{Query: tt.query})
+
+			if tt.wantData != "" && !jsonEqual(t, res.Data, tt.wantData) {
+				t.Errorf("data = %s, want %s", res.Data, tt.wantData)
+			}
+			var got []string
+			for _, e := range res.Errors {
+				code, _ := e.Extensions["code"].(string)
+				got = append(got, code+" "+e.Message)
+			}
+			if len(got) != len(tt.wantErrs) {
+				t.Fatalf("errors = %q, want %q", got, tt.wantErrs)
+			}
+			for i, want := range tt.wantErrs {
+				if !strings.Contains(got[i], want) {
+					t.Errorf("error %d = %q, want %q", i, got[i], want)
+				}
+			}
+		})
+	}
+}
+
+func jsonEqual(t *testing.T, got json.RawMessage, want string) bool {
+	t.Helper()
+	var g, w any
+	if err := json.Unmarshal(got, &g); err != nil {
+		t.Fatalf("data %s: %v", got, err)
+	}
+	if err := json.Unmarshal([]byte(want), &w); err != nil {
+		t.Fatal(err)
+	}
+	return reflect.DeepEqual(g, w)
+}
@@ -0,0 +1,147 @@
+package graphqlapi
+
+import (
+	"context"
+	"log/slog"
+	"reflect"
+	"strconv"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/shutdown"
+)
+
+// watchSnapshot 구독 시작과 주기적 재조회로 보내는 이벤트의 타입
+const watchSnapshot = "snapshot"
+
+// watchBuffer 구독자별 이벤트 버퍼. 가득 차서 버려진 이벤트는 주기적 재조회로 보완됨
+const watchBuffer = 16
+
+// change 구독으로 보내는 변경 하나
+type change[T any] struct {
+	eventType  string
+	eventID    *string
+	occurredAt time.Time
+	value      T
+}
+
+func (c change[T]) Type() string       { return c.eventType }
+func (c change[T]) EventID() *string   { return c.eventID }
+func (c change[T]) OccurredAt() string { return c.occurredAt.Format(time.RFC3339) }
+
+// watch 현재 상태를 먼저 보내고, 대상의 아웃박스 이벤트가 오면 다시 조회해 전송.
+// 다른 인스턴스에서 처리된 이벤트는 이 프로세스의 버스로 오지 않으므로 resync 주기마다 다시 조회해 바뀐 경우에도 전송.
+// 대상이 삭제되면 마지막 상태를 보내고 종료
+func watch[T any, R any](ctx context.Context, bus *outbox.Bus, resync time.Duration, aggregateID string, types []string,
+	load func(context.Context) (T, error), wrap func(change[T]) R) (<-chan R, error) {
+	// 조회와 구독 사이의 변경을 놓치지 않도록 먼저 구독
+	events, unsubscribe := bus.Subscribe(watchBuffer, types...)
+	last, err := load(ctx)
+	if err != nil {
+		unsubscribe()
+		return nil, err
+	}
+
+	out := make(chan R, 1)
+	out <- wrap(change[T]{eventType: watchSnapshot, occurredAt: time.Now(), value: last})
+	go func() {
+		defer close(out)
+		defer unsubscribe()
+		send := func(c change[T]) bool {
+			select {
+			case out <- wrap(c):
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		}
+		ticker := time.NewTicker(resync)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-shutdown.Done():
+				return
+			case ev, ok := <-events:
+				if !ok {
+					return
+				}
+				if ev.AggregateID != aggregateID {
+					continue
+				}
+				current, err := load(ctx)
+				if isNotFound(err) {
+					send(change[T]{eventType: ev.Type, eventID: &ev.ID, occurredAt: ev.OccurredAt, value: last})
+					return
+				}
+				if err != nil {
+					slog.WarnContext(ctx, "graphql subscription reload failed", "aggregate_id", aggregateID, "error", err)
+					return
+				}
+				last = current
+				if !send(change[T]{eventType: ev.Type, eventID: &ev.ID, occurredAt: ev.OccurredAt, value: current}) {
+					return
+				}
+			case <-ticker.C:
+				current, err := load(ctx)
+				if err != nil {
+					return
+				}
+				if reflect.DeepEqual(current, last) {
+					continue
+				}
+				last = current
+				if !send(change[T]{eventType: watchSnapshot, occurredAt: time.Now(), value: current}) {
+					return
+				}
+			}
+		}
+	}()
+	return out, nil
+}
+
+type packageEventResolver struct {
+	change[*models.Package]
+}
+
+func (r *packageEventResolver) Package() *packageResolver { return newPackageResolver(r.value) }
+
+func (r *resolver) PackageChanged(ctx context.Context, args struct{ PackageID int32 }) (<-chan *packageEventResolver, error) {
+	id := int(args.PackageID)
+	ch, err := watch(ctx, r.bus, r.cfg.WatchResync, strconv.Itoa(id),
+		[]string{outbox.PackageCreated, outbox.PackageUpdated, outbox.PackageDeleted, outbox.PackageCompleted},
+		func(ctx context.Context) (*models.Package, error) { return r.svc.packages.GetPackageByID(ctx, id) },
+		func(c change[*models.Package]) *packageEventResolver { return &packageEventResolver{c} })
+	if err != nil {
+		return nil, subscriptionError(ctx, err, packageResource)
+	}
+	return ch, nil
+}
+
+type vehicleEventResolver struct {
+	change[*models.Vehicle]
+}
+
+func (r *vehicleEventResolver) Vehicle() *vehicleResolver { return newVehicleResolver(r.value) }
+
+func (r *resolver) VehicleChanged(ctx context.Context, args struct{ InternalID int32 }) (<-chan *vehicleEventResolver, error) {
+	id := int(args.InternalID)
+	// 차량 이벤트의 집계 ID 는 vehicle_id 이므로 먼저 조회
+	vehicle, err := r.svc.vehicles.GetVehicleByID(ctx, id)
+	if err != nil {
+		return nil, subscriptionError(ctx, err, vehicleResource)
+	}
+	ch, err := watch(ctx, r.bus, r.cfg.WatchResync, vehicle.VehicleID,
+		[]string{
+			outbox.VehicleCreated, outbox.VehicleUpdated, outbox.VehicleDeleted, outbox.VehicleLedChanged,
+			outbox.VehicleConfirmationRequested, outbox.VehicleConfirmationAcked,
+		},
+		func(ctx context.Context) (*models.Vehicle, error) { return r.svc.vehicles.GetVehicleByID(ctx, id) },
+		func(c change[*models.Vehicle]) *vehicleEventResolver { return &vehicleEventResolver{c} })
+	if err != nil {
+		return nil, subscriptionError(ctx, err, vehicleResource)
+	}
+	return ch, nil
+}
@@ -0,0 +1,268 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/shutdown"
+	"github.com/gorilla/websocket"
+	graphql "github.com/graph-gophers/graphql-go"
+)
+
+// wsProtocol 지원하는 WebSocket 하위 프로토콜 (https://github.com/enisdenjo/graphql-ws/blob/master/PROTOCOL.md)
+const wsProtocol = "graphql-transport-ws"
+
+const (
+	wsConnectionInit = "connection_init"
+	wsConnectionAck  = "connection_ack"
+	wsPing           = "ping"
+	wsPong           = "pong"
+	wsSubscribe      = "subscribe"
+	wsNext           = "next"
+	wsError          = "error"
+	wsComplete       = "complete"
+)
+
+// 프로토콜이 정의한 종료 코드
+const (
+	closeBadRequest   = 4400
+	closeUnauthorized = 4401
+	closeForbidden    = 4403
+	closeInitTimeout  = 4408
+	closeDuplicateID  = 4409
+	closeTooManyInit  = 4429
+)
+
+const (
+	wsInitTimeout  = 10 * time.Second
+	wsWriteTimeout = 10 * time.Second
+	wsReadLimit    = 64 << 10
+)
+
+type wsMessage struct {
+	ID      string          `json:"id,omitempty"`
+	Type    string          `json:"type"`
+	Payload json.RawMessage `json:"payload,omitempty"`
+}
+
+// ServeWebSocket graphql-transport-ws 연결 처리.
+// 브라우저는 WebSocket 에 헤더를 붙일 수 없으므로 토큰은 connection_init payload 의 authorization 으로도 받음
+func (s *Server) ServeWebSocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := s.upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// Upgrade 가 이미 에러 응답을 보냄
+		return
+	}
+	c := &wsConn{server: s, conn: conn, authorization: r.Header.Get("Authorization"), subs: map[string]*wsSubscription{}}
+	if conn.Subprotocol() != wsProtocol {
+		c.close(closeBadRequest, "Unsupported subprotocol")
+		return
+	}
+	c.run(r.Context())
+}
+
+type wsConn struct {
+	server        *Server
+	conn          *websocket.Conn
+	authorization string // 업그레이드 요청의 Authorization 헤더
+
+	writeMu sync.Mutex
+	mu      sync.Mutex
+	subs    map[string]*wsSubscription
+}
+
+type wsSubscription struct {
+	cancel context.CancelFunc
+}
+
+func (c *wsConn) run(ctx context.Context) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	defer c.conn.Close()
+	c.conn.SetReadLimit(wsReadLimit)
+
+	_ = c.conn.SetReadDeadline(time.Now().Add(wsInitTimeout))
+	msg, err := c.read()
+	if err != nil {
+		var netErr interface{ Timeout() bool }
+		if errors.As(err, &netErr) && netErr.Timeout() {
+			c.close(closeInitTimeout, "Connection initialisation timeout")
+		}
+		return
+	}
+	if msg.Type != wsConnectionInit {
+		c.close(closeUnauthorized, "Unauthorized")
+		return
+	}
+	ctx, err = c.authenticate(ctx, msg.Payload)
+	if err != nil {
+		appErr := apperror.Translate(err, "auth")
+		code := closeUnauthorized
+		if appErr.Status == http.StatusForbidden {
+			code = closeForbidden
+		}
+		c.close(code, appErr.Title)
+		return
+	}
+	_ = c.conn.SetReadDeadline(time.Time{})
+	if err := c.write(wsMessage{Type: wsConnectionAck}); err != nil {
+		return
+	}
+
+	go c.keepAlive(ctx)
+	for {
+		msg, err := c.read()
+		if err != nil {
+			return
+		}
+		switch msg.Type {
+		case wsPing:
+			_ = c.write(wsMessage{Type: wsPong})
+		case wsPong:
+		case wsSubscribe:
+			if !c.subscribe(ctx, msg) {
+				return
+			}
+		case wsComplete:
+			c.finish(msg.ID, nil)
+		case wsConnectionInit:
+			c.close(closeTooManyInit, "Too many initialisation requests")
+			return
+		default:
+			c.close(closeBadRequest, "Unknown message type")
+			return
+		}
+	}
+}
+
+func (c *wsConn) authenticate(ctx context.Context, payload json.RawMessage) (context.Context, error) {
+	authorization := c.authorization
+	var init struct {
+		Authorization string `json:"authorization"`
+	}
+	if len(payload) > 0 && json.Unmarshal(payload, &init) == nil && init.Authorization != "" {
+		authorization = init.Authorization
+	}
+	token, ok := strings.CutPrefix(authorization, "Bearer ")
+	if !ok || token == "" {
+		return ctx, apperror.ErrUnauthorized
+	}
+	employeeID, position, err := c.server.auth.Authenticate(ctx, token)
+	if err != nil {
+		return ctx, err
+	}
+	return WithViewer(ctx, employeeID, position), nil
+}
+
+// subscribe 구독마다 고루틴에서 결과를 보냄. 잘못된 메시지로 연결을 닫았으면 false
+func (c *wsConn) subscribe(ctx context.Context, msg wsMessage) bool {
+	var req dto.GraphQLRequest
+	if msg.ID == "" || json.Unmarshal(msg.Payload, &req) != nil || req.Query == "" {
+		c.close(closeBadRequest, "Invalid subscribe message")
+		return false
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	sub := &wsSubscription{cancel: cancel}
+	c.mu.Lock()
+	if _, exists := c.subs[msg.ID]; exists {
+		c.mu.Unlock()
+		cancel()
+		c.close(closeDuplicateID, "Subscriber for "+msg.ID+" already exists")
+		return false
+	}
+	c.subs[msg.ID] = sub
+	c.mu.Unlock()
+
+	go func() {
+		defer c.finish(msg.ID, sub)
+		responses, err := c.server.subscribe(ctx, req)
+		if err != nil {
+			c.sendErrors(msg.ID, []*dto.GraphQLError{{Message: err.Error()}})
+			return
+		}
+		failed := false
+		// 구독이 취소되어도 graphql-go 가 채널을 닫을 때까지 비워야 함
+		for res := range responses {
+			resp := res.(*graphql.Response)
+			if ctx.Err() != nil || failed {
+				continue
+			}
+			// 실행 전 에러(파싱, 검증, 구독 시작 실패)는 error 메시지로 보내고 구독을 끝냄
+			if resp.Data == nil && len(resp.Errors) > 0 {
+				failed = true
+				c.sendErrors(msg.ID, resp.Errors)
+				continue
+			}
+			payload, _ := json.Marshal(resp)
+			_ = c.write(wsMessage{ID: msg.ID, Type: wsNext, Payload: payload})
+		}
+		if ctx.Err() == nil && !failed {
+			_ = c.write(wsMessage{ID: msg.ID, Type: wsComplete})
+		}
+	}()
+	return true
+}
+
+func (c *wsConn) sendErrors(id string, errs any) {
+	payload, _ := json.Marshal(errs)
+	_ = c.write(wsMessage{ID: id, Type: wsError, Payload: payload})
+}
+
+// finish 구독 취소. sub 가 주어지면 같은 ID 로 새로 시작된 구독은 건드리지 않음
+func (c *wsConn) finish(id string, sub *wsSubscription) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	current, ok := c.subs[id]
+	if !ok || (sub != nil && current != sub) {
+		return
+	}
+	current.cancel()
+	delete(c.subs, id)
+}
+
+// keepAlive 프록시가 유휴 연결을 끊지 않도록 주기적으로 ping. 서버 종료 시 연결을 닫음
+func (c *wsConn) keepAlive(ctx context.Context) {
+	ticker := time.NewTicker(c.server.cfg.KeepAlive)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-shutdown.Done():
+			c.close(websocket.CloseGoingAway, "Server is shutting down")
+			return
+		case <-ticker.C:
+			if err := c.write(wsMessage{Type: wsPing}); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (c *wsConn) read() (wsMessage, error) {
+	var msg wsMessage
+	err := c.conn.ReadJSON(&msg)
+	return msg, err
+}
+
+func (c *wsConn) write(msg wsMessage) error {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.conn.SetWriteDeadline(time.Now().Add(wsWriteTimeout))
+	return c.conn.WriteJSON(msg)
+}
+
+// close 종료 코드를 보내고 연결을 닫음. 읽기 루프는 다음 읽기에서 끝남
+func (c *wsConn) close(code int, reason string) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = c.conn.WriteControl(websocket.CloseMessage, websocket.FormatCloseMessage(code, reason), time.Now().Add(wsWriteTimeout))
+	_ = c.conn.Close()
+}
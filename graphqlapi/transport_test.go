@@ -0,0 +1,148 @@
+package graphqlapi
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/utils"
+	"github.com/gorilla/websocket"
+)
+
+// dialWS 테스트 서버에 graphql-transport-ws 로 연결
+func dialWS(t *testing.T, srv *Server, header http.Header, protocols ...string) *websocket.Conn {
+	t.Helper()
+	ts := httptest.NewServer(http.HandlerFunc(srv.ServeWebSocket))
+	t.Cleanup(ts.Close)
+	dialer := websocket.Dialer{Subprotocols: protocols, HandshakeTimeout: 2 * time.Second}
+	conn, _, err := dialer.Dial("ws"+strings.TrimPrefix(ts.URL, "http"), header)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	_ = conn.SetReadDeadline(time.Now().Add(3 * time.Second))
+	return conn
+}
+
+func send(t *testing.T, conn *websocket.Conn, msg wsMessage) {
+	t.Helper()
+	if err := conn.WriteJSON(msg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+// next 서버의 다음 메시지. 서버가 연결을 닫았으면 종료 코드
+func next(t *testing.T, conn *websocket.Conn) (wsMessage, int) {
+	t.Helper()
+	var msg wsMessage
+	if err := conn.ReadJSON(&msg); err != nil {
+		var closeErr *websocket.CloseError
+		if errors.As(err, &closeErr) {
+			return msg, closeErr.Code
+		}
+		t.Fatal(err)
+	}
+	return msg, 0
+}
+
+func bearer(t *testing.T, employeeID int) string {
+	t.Helper()
+	token, err := utils.GenerateJWT(employeeID, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return "Bearer " + token
+}
+
+func TestServeWebSocket_Handshake(t *testing.T) {
+	tests := []struct {
+		name      string
+		protocols []string
+		header    http.Header
+		first     wsMessage // 보낼 첫 메시지
+		wantType  string    // 비어 있으면 wantClose 코드로 연결이 닫혀야 함
+		wantClose int
+	}{
+		{"token in payload", []string{wsProtocol}, nil,
+			wsMessage{Type: wsConnectionInit, Payload: json.RawMessage(`{"authorization":"` + bearer(t, 1) + `"}`)}, wsConnectionAck, 0},
+		{"token in header", []string{wsProtocol}, http.Header{"Authorization": {bearer(t, 1)}},
+			wsMessage{Type: wsConnectionInit}, wsConnectionAck, 0},
+		{"no token", []string{wsProtocol}, nil, wsMessage{Type: wsConnectionInit}, "", closeUnauthorized},
+		{"unknown employee", []string{wsProtocol}, nil,
+			wsMessage{Type: wsConnectionInit, Payload: json.RawMessage(`{"authorization":"` + bearer(t, 99) + `"}`)}, "", closeUnauthorized},
+		{"subscribe before init", []string{wsProtocol}, http.Header{"Authorization": {bearer(t, 1)}},
+			wsMessage{ID: "1", Type: wsSubscribe}, "", closeUnauthorized},
+		{"other subprotocol", []string{"graphql-ws"}, nil, wsMessage{Type: wsConnectionInit}, "", closeBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			srv, _, _ := newTestServer(t, nil)
+			conn := dialWS(t, srv, tt.header, tt.protocols...)
+			send(t, conn, tt.first)
+			msg, code := next(t, conn)
+			if msg.Type != tt.wantType || code != tt.wantClose {
+				t.Errorf("got %+v, close %d; want %q, close %d", msg, code, tt.wantType, tt.wantClose)
+			}
+		})
+	}
+}
+
+func TestServeWebSocket_Subscription(t *testing.T) {
+	srv, store, bus := newTestServer(t, nil)
+	conn := dialWS(t, srv, http.Header{"Authorization": {bearer(t, 1)}}, wsProtocol)
+	send(t, conn, wsMessage{Type: wsConnectionInit})
+	if msg, _ := next(t, conn); msg.Type != wsConnectionAck {
+		t.Fatalf("got %+v", msg)
+	}
+
+	subscribe := func(id string, packageID int) {
+		payload, _ := json.Marshal(dto.GraphQLRequest{
+			Query:     `subscription($id: Int!) { packageChanged(packageId: $id) { type package { packageStatus } } }`,
+			Variables: map[string]any{"id": packageID},
+		})
+		send(t, conn, wsMessage{ID: id, Type: wsSubscribe, Payload: payload})
+	}
+	expect := func(id, typ, data string) {
+		t.Helper()
+		msg, _ := next(t, conn)
+		if msg.ID != id || msg.Type != typ {
+			t.Fatalf("got %s %s %s, want %s %s", msg.ID, msg.Type, msg.Payload, id, typ)
+		}
+		if data != "" {
+			var res struct {
+				Data json.RawMessage `json:"data"`
+			}
+			if err := json.Unmarshal(msg.Payload, &res); err != nil || !jsonEqual(t, res.Data, data) {
+				t.Errorf("payload = %s, want data %s", msg.Payload, data)
+			}
+		}
+	}
+
+	subscribe("missing", 99)
+	expect("missing", wsError, "")
+
+	subscribe("pkg", 1)
+	expect("pkg", wsNext, `{"packageChanged":{"type":"snapshot","package":{"packageStatus":"등록됨"}}}`)
+
+	// 디스패처가 하듯 기록된 이벤트를 버스로 전달
+	if _, err := service.NewPackageService(store, nil).UpdatePackage(context.Background(), 1, dto.UpdatePackageRequest{PackageStatus: "A차운송중"}); err != nil {
+		t.Fatal(err)
+	}
+	for _, ev := range store.RecordedEvents() {
+		_ = bus.Handle(context.Background(), ev)
+	}
+	expect("pkg", wsNext, `{"packageChanged":{"type":"package.updated","package":{"packageStatus":"A차운송중"}}}`)
+
+	// 같은 ID 로 다시 구독하면 프로토콜 위반
+	subscribe("pkg", 1)
+	if _, code := next(t, conn); code != closeDuplicateID {
+		t.Errorf("duplicate id: close code = %d, want %d", code, closeDuplicateID)
+	}
+}
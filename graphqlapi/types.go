@@ -0,0 +1,208 @@
+package graphqlapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/utils"
+)
+
+// positionManager 직원 정보를 조회할 수 있는 직급 (REST /api/employee 와 같음)
+const positionManager = "관리직"
+
+type regionResolver struct {
+	m *models.Region
+}
+
+func newRegionResolver(m *models.Region) *regionResolver { return &regionResolver{m: m} }
+
+func (r *regionResolver) RegionID() string       { return r.m.RegionID }
+func (r *regionResolver) RegionName() string     { return r.m.RegionName }
+func (r *regionResolver) CoordX() int32          { return int32(r.m.CoordX) }
+func (r *regionResolver) CoordY() int32          { return int32(r.m.CoordY) }
+func (r *regionResolver) MaxCapacity() int32     { return int32(r.m.MaxCapacity) }
+func (r *regionResolver) CurrentCapacity() int32 { return int32(r.m.CurrentCapacity) }
+func (r *regionResolver) IsFull() bool           { return r.m.IsFull }
+func (r *regionResolver) SaturatedAt() *string   { return utils.FormatTimePtr(r.m.SaturatedAt) }
+
+func (r *regionResolver) Packages(ctx context.Context) ([]*packageResolver, error) {
+	pkgs, err := load[[]models.Package](ctx, loadersFrom(ctx).packagesByRegion, r.m.RegionID)
+	if err != nil {
+		return nil, toError(ctx, err, packageResource)
+	}
+	return wrapAll(pkgs, newPackageResolver), nil
+}
+
+type packageResolver struct {
+	m *models.Package
+}
+
+func newPackageResolver(m *models.Package) *packageResolver { return &packageResolver{m: m} }
+
+func (r *packageResolver) PackageID() int32      { return int32(r.m.PackageID) }
+func (r *packageResolver) PackageType() string   { return r.m.PackageType }
+func (r *packageResolver) RegionID() string      { return r.m.RegionID }
+func (r *packageResolver) PackageStatus() string { return r.m.PackageStatus }
+func (r *packageResolver) RegisteredAt() string  { return r.m.RegisteredAt.Format(time.RFC3339) }
+
+func (r *packageResolver) Region(ctx context.Context) (*regionResolver, error) {
+	return loadRegion(ctx, r.m.RegionID)
+}
+
+func (r *packageResolver) DeliveryLogs(ctx context.Context) ([]*deliveryLogResolver, error) {
+	logs, err := load[[]dto.DeliveryLogResponse](ctx, loadersFrom(ctx).deliveryLogsByPackage, r.m.PackageID)
+	if err != nil {
+		return nil, toError(ctx, err, deliveryLogResource)
+	}
+	return wrapAll(logs, newDeliveryLogResolver), nil
+}
+
+type vehicleResolver struct {
+	m *models.Vehicle
+}
+
+func newVehicleResolver(m *models.Vehicle) *vehicleResolver { return &vehicleResolver{m: m} }
+
+func (r *vehicleResolver) InternalID() int32       { return int32(r.m.InternalID) }
+func (r *vehicleResolver) VehicleID() string       { return r.m.VehicleID }
+func (r *vehicleResolver) CurrentLoad() int32      { return int32(r.m.CurrentLoad) }
+func (r *vehicleResolver) MaxLoad() int32          { return int32(r.m.MaxLoad) }
+func (r *vehicleResolver) LedStatus() string       { return r.m.LedStatus }
+func (r *vehicleResolver) NeedsConfirmation() bool { return r.m.NeedsConfirmation }
+func (r *vehicleResolver) CoordX() int32           { return int32(r.m.CoordX) }
+func (r *vehicleResolver) CoordY() int32           { return int32(r.m.CoordY) }
+
+func (r *vehicleResolver) TripLogs(ctx context.Context) ([]*tripLogResolver, error) {
+	trips, err := load[[]dto.TripLogResponse](ctx, loadersFrom(ctx).tripLogsByVehicle, r.m.VehicleID)
+	if err != nil {
+		return nil, toError(ctx, err, tripLogResource)
+	}
+	return wrapAll(trips, newTripLogResolver), nil
+}
+
+type tripLogResolver struct {
+	r *dto.TripLogResponse
+}
+
+func newTripLogResolver(r *dto.TripLogResponse) *tripLogResolver { return &tripLogResolver{r: r} }
+
+func (r *tripLogResolver) TripID() int32        { return int32(r.r.TripID) }
+func (r *tripLogResolver) VehicleID() string    { return r.r.VehicleID }
+func (r *tripLogResolver) DriverID() *int32     { return int32Ptr(r.r.DriverID) }
+func (r *tripLogResolver) StartTime() *string   { return r.r.StartTime }
+func (r *tripLogResolver) EndTime() *string     { return r.r.EndTime }
+func (r *tripLogResolver) Status() string       { return r.r.Status }
+func (r *tripLogResolver) Destination() *string { return r.r.Destination }
+
+func (r *tripLogResolver) Vehicle(ctx context.Context) (*vehicleResolver, error) {
+	vehicle, err := load[*models.Vehicle](ctx, loadersFrom(ctx).vehicle, r.r.VehicleID)
+	if err != nil || vehicle == nil {
+		return nil, toError(ctx, err, vehicleResource)
+	}
+	return newVehicleResolver(vehicle), nil
+}
+
+func (r *tripLogResolver) DestinationRegion(ctx context.Context) (*regionResolver, error) {
+	if r.r.Destination == nil {
+		return nil, nil
+	}
+	return loadRegion(ctx, *r.r.Destination)
+}
+
+func (r *tripLogResolver) Driver(ctx context.Context) (*employeeResolver, error) {
+	if r.r.DriverID == nil {
+		return nil, nil
+	}
+	if err := requirePosition(ctx, positionManager); err != nil {
+		return nil, err
+	}
+	emp, err := load[*dto.EmployeeResponse](ctx, loadersFrom(ctx).employee, *r.r.DriverID)
+	if err != nil || emp == nil {
+		return nil, toError(ctx, err, employeeResource)
+	}
+	return &employeeResolver{r: emp}, nil
+}
+
+func (r *tripLogResolver) DeliveryLogs(ctx context.Context) ([]*deliveryLogResolver, error) {
+	logs, err := load[[]dto.DeliveryLogResponse](ctx, loadersFrom(ctx).deliveryLogsByTrip, r.r.TripID)
+	if err != nil {
+		return nil, toError(ctx, err, deliveryLogResource)
+	}
+	return wrapAll(logs, newDeliveryLogResolver), nil
+}
+
+type deliveryLogResolver struct {
+	r *dto.DeliveryLogResponse
+}
+
+func newDeliveryLogResolver(r *dto.DeliveryLogResponse) *deliveryLogResolver {
+	return &deliveryLogResolver{r: r}
+}
+
+func (r *deliveryLogResolver) TripID() int32                { return int32(r.r.TripID) }
+func (r *deliveryLogResolver) PackageID() int32             { return int32(r.r.PackageID) }
+func (r *deliveryLogResolver) RegionID() string             { return r.r.RegionID }
+func (r *deliveryLogResolver) LoadOrder() int32             { return int32(r.r.LoadOrder) }
+func (r *deliveryLogResolver) RegisteredAt() *string        { return r.r.RegisteredAt }
+func (r *deliveryLogResolver) FirstTransportTime() *string  { return r.r.FirstTransportTime }
+func (r *deliveryLogResolver) InputTime() *string           { return r.r.InputTime }
+func (r *deliveryLogResolver) SecondTransportTime() *string { return r.r.SecondTransportTime }
+func (r *deliveryLogResolver) CompletedAt() *string         { return r.r.CompletedAt }
+
+func (r *deliveryLogResolver) Trip(ctx context.Context) (*tripLogResolver, error) {
+	trip, err := load[*dto.TripLogResponse](ctx, loadersFrom(ctx).tripLog, r.r.TripID)
+	if err != nil || trip == nil {
+		return nil, toError(ctx, err, tripLogResource)
+	}
+	return newTripLogResolver(trip), nil
+}
+
+func (r *deliveryLogResolver) Package(ctx context.Context) (*packageResolver, error) {
+	pkg, err := load[*models.Package](ctx, loadersFrom(ctx).pkg, r.r.PackageID)
+	if err != nil || pkg == nil {
+		return nil, toError(ctx, err, packageResource)
+	}
+	return newPackageResolver(pkg), nil
+}
+
+func (r *deliveryLogResolver) Region(ctx context.Context) (*regionResolver, error) {
+	return loadRegion(ctx, r.r.RegionID)
+}
+
+type employeeResolver struct {
+	r *dto.EmployeeResponse
+}
+
+func (r *employeeResolver) EmployeeID() int32 { return int32(r.r.EmployeeID) }
+func (r *employeeResolver) Name() string      { return r.r.Name }
+func (r *employeeResolver) Position() string  { return r.r.Position }
+func (r *employeeResolver) IsActive() bool    { return r.r.IsActive }
+func (r *employeeResolver) Phone() *string    { return r.r.Phone }
+func (r *employeeResolver) Email() *string    { return r.r.Email }
+
+func loadRegion(ctx context.Context, regionID string) (*regionResolver, error) {
+	region, err := load[*models.Region](ctx, loadersFrom(ctx).region, regionID)
+	if err != nil || region == nil {
+		return nil, toError(ctx, err, regionResource)
+	}
+	return newRegionResolver(region), nil
+}
+
+// wrapAll 목록의 각 항목을 리졸버로 감쌈
+func wrapAll[T any, R any](items []T, wrap func(*T) R) []R {
+	res := make([]R, len(items))
+	for i := range items {
+		res[i] = wrap(&items[i])
+	}
+	return res
+}
+
+func int32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
@@ -0,0 +1,33 @@
+package graphqlapi
+
+import (
+	"context"
+	"slices"
+
+	"github.com/baboyiban/go-api-server/apperror"
+)
+
+// viewer 요청한 직원. 인증은 HTTP 미들웨어 또는 WebSocket connection_init 에서 처리됨
+type viewer struct {
+	employeeID int
+	position   string
+}
+
+type viewerKey struct{}
+
+// WithViewer 인증된 직원 정보를 리졸버에 전달
+func WithViewer(ctx context.Context, employeeID int, position string) context.Context {
+	return context.WithValue(ctx, viewerKey{}, viewer{employeeID: employeeID, position: position})
+}
+
+// requirePosition REST 의 authRequired(..., positions) 와 같은 직급 검사
+func requirePosition(ctx context.Context, positions ...string) error {
+	v, ok := ctx.Value(viewerKey{}).(viewer)
+	if !ok {
+		return &resolverError{err: apperror.ErrUnauthorized}
+	}
+	if !slices.Contains(positions, v.position) {
+		return &resolverError{err: apperror.ErrForbidden}
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+package grpcapi
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin/binding"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// validate REST 요청과 같은 binding 태그로 검증
+func validate(v any) error {
+	return binding.Validator.ValidateStruct(v)
+}
+
+// searchParams 설정된 검색 필드를 REST 검색 쿼리 파라미터와 같은 맵으로 바꾸고 정렬 필드를 분리.
+// 메시지 필드 이름이 곧 컬럼 이름이므로 정의되지 않은 컬럼은 조건으로 들어올 수 없음
+func searchParams(m proto.Message) (map[string]string, string) {
+	params := map[string]string{}
+	sort := ""
+	m.ProtoReflect().Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		if fd.Name() == "sort" {
+			sort = v.String()
+		} else if s := v.String(); s != "" {
+			params[string(fd.Name())] = s
+		}
+		return true
+	})
+	return params, sort
+}
+
+func int32Ptr(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	i := int32(*v)
+	return &i
+}
+
+func intPtr(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	i := int(*v)
+	return &i
+}
+
+func formatTime(t time.Time) string {
+	return t.Format(time.RFC3339)
+}
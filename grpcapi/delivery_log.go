@@ -0,0 +1,110 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/dto"
+	apiv1 "github.com/baboyiban/go-api-server/proto/api/v1"
+	"github.com/baboyiban/go-api-server/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type deliveryLogServer struct {
+	apiv1.UnimplementedDeliveryLogServiceServer
+	svc *service.DeliveryLogService
+}
+
+func (s *deliveryLogServer) CreateDeliveryLog(ctx context.Context, req *apiv1.CreateDeliveryLogRequest) (*apiv1.DeliveryLog, error) {
+	in := dto.CreateDeliveryLogRequest{
+		TripID:              int(req.GetTripId()),
+		PackageID:           int(req.GetPackageId()),
+		RegionID:            req.GetRegionId(),
+		LoadOrder:           int(req.GetLoadOrder()),
+		RegisteredAt:        req.RegisteredAt,
+		FirstTransportTime:  req.FirstTransportTime,
+		InputTime:           req.InputTime,
+		SecondTransportTime: req.SecondTransportTime,
+		CompletedAt:         req.CompletedAt,
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, deliveryLogResource)
+	}
+	log, err := s.svc.CreateDeliveryLog(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, deliveryLogResource)
+	}
+	return toDeliveryLog(log), nil
+}
+
+func (s *deliveryLogServer) GetDeliveryLog(ctx context.Context, req *apiv1.GetDeliveryLogRequest) (*apiv1.DeliveryLog, error) {
+	log, err := s.svc.GetDeliveryLogByID(ctx, int(req.GetTripId()))
+	if err != nil {
+		return nil, toStatus(err, deliveryLogResource)
+	}
+	return toDeliveryLog(log), nil
+}
+
+func (s *deliveryLogServer) UpdateDeliveryLog(ctx context.Context, req *apiv1.UpdateDeliveryLogRequest) (*apiv1.DeliveryLog, error) {
+	in := dto.UpdateDeliveryLogRequest{
+		LoadOrder:           int(req.GetLoadOrder()),
+		RegisteredAt:        req.RegisteredAt,
+		FirstTransportTime:  req.FirstTransportTime,
+		InputTime:           req.InputTime,
+		SecondTransportTime: req.SecondTransportTime,
+		CompletedAt:         req.CompletedAt,
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, deliveryLogResource)
+	}
+	log, err := s.svc.UpdateDeliveryLog(ctx, int(req.GetTripId()), in)
+	if err != nil {
+		return nil, toStatus(err, deliveryLogResource)
+	}
+	return toDeliveryLog(log), nil
+}
+
+func (s *deliveryLogServer) DeleteDeliveryLog(ctx context.Context, req *apiv1.DeleteDeliveryLogRequest) (*emptypb.Empty, error) {
+	if err := s.svc.DeleteDeliveryLog(ctx, int(req.GetTripId())); err != nil {
+		return nil, toStatus(err, deliveryLogResource)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *deliveryLogServer) ListDeliveryLogs(ctx context.Context, req *apiv1.ListDeliveryLogsRequest) (*apiv1.ListDeliveryLogsResponse, error) {
+	logs, err := s.svc.ListDeliveryLogs(ctx, req.GetSort())
+	if err != nil {
+		return nil, toStatus(err, deliveryLogResource)
+	}
+	return toDeliveryLogList(logs), nil
+}
+
+func (s *deliveryLogServer) SearchDeliveryLogs(ctx context.Context, req *apiv1.SearchDeliveryLogsRequest) (*apiv1.ListDeliveryLogsResponse, error) {
+	params, sort := searchParams(req)
+	logs, err := s.svc.SearchDeliveryLogs(ctx, params, sort)
+	if err != nil {
+		return nil, toStatus(err, deliveryLogResource)
+	}
+	return toDeliveryLogList(logs), nil
+}
+
+func toDeliveryLog(r *dto.DeliveryLogResponse) *apiv1.DeliveryLog {
+	return &apiv1.DeliveryLog{
+		TripId:              int32(r.TripID),
+		PackageId:           int32(r.PackageID),
+		RegionId:            r.RegionID,
+		LoadOrder:           int32(r.LoadOrder),
+		RegisteredAt:        r.RegisteredAt,
+		FirstTransportTime:  r.FirstTransportTime,
+		InputTime:           r.InputTime,
+		SecondTransportTime: r.SecondTransportTime,
+		CompletedAt:         r.CompletedAt,
+	}
+}
+
+func toDeliveryLogList(logs []dto.DeliveryLogResponse) *apiv1.ListDeliveryLogsResponse {
+	res := &apiv1.ListDeliveryLogsResponse{DeliveryLogs: make([]*apiv1.DeliveryLog, 0, len(logs))}
+	for i := range logs {
+		res.DeliveryLogs = append(res.DeliveryLogs, toDeliveryLog(&logs[i]))
+	}
+	return res
+}
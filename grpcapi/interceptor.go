@@ -0,0 +1,245 @@
+package grpcapi
+
+import (
+	"context"
+	"log/slog"
+	"net"
+	"runtime/debug"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/metrics"
+	"github.com/baboyiban/go-api-server/middleware"
+	apiv1 "github.com/baboyiban/go-api-server/proto/api/v1"
+	"github.com/baboyiban/go-api-server/ratelimit"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// 메타데이터 키 (gRPC 메타데이터 키는 소문자)
+const (
+	authorizationKey = "authorization"
+	apiKeyKey        = "x-api-key"
+)
+
+type employeeIDKey struct{}
+
+// EmployeeID 인증 인터셉터가 저장한 로그인 직원 ID. 익명 메서드나 API 키로 호출하면 false
+func EmployeeID(ctx context.Context) (int, bool) {
+	id, ok := ctx.Value(employeeIDKey{}).(int)
+	return id, ok
+}
+
+// isPublic 인증과 요청 제한 없이 호출할 수 있는 메서드 (헬스체크, 리플렉션)
+func isPublic(fullMethod string) bool {
+	return strings.HasPrefix(fullMethod, "/grpc.health.v1.Health/") ||
+		strings.HasPrefix(fullMethod, "/grpc.reflection.")
+}
+
+// access 메서드별 인증 수준
+type access int
+
+const (
+	// accessCredential 직원 JWT 또는 등록된 API 키. 목록에 없는 메서드의 기본값
+	accessCredential access = iota
+	// accessAnonymous 요청 제한만 적용. REST 에서도 인증 없이 호출하는 라우트에 대응
+	accessAnonymous
+)
+
+// methodAccess REST 의 같은 라우트와 같은 인증 수준. 지역, 택배, 차량, 운행/배송 기록 CRUD 는
+// REST 에서 인증 없이 열려 있고, REST 에 없는 Watch 스트림은 직원 또는 장비(API 키)만 구독
+var methodAccess = map[string]access{
+	apiv1.RegionService_CreateRegion_FullMethodName:  accessAnonymous,
+	apiv1.RegionService_GetRegion_FullMethodName:     accessAnonymous,
+	apiv1.RegionService_UpdateRegion_FullMethodName:  accessAnonymous,
+	apiv1.RegionService_DeleteRegion_FullMethodName:  accessAnonymous,
+	apiv1.RegionService_ListRegions_FullMethodName:   accessAnonymous,
+	apiv1.RegionService_SearchRegions_FullMethodName: accessAnonymous,
+
+	apiv1.PackageService_CreatePackage_FullMethodName:  accessAnonymous,
+	apiv1.PackageService_GetPackage_FullMethodName:     accessAnonymous,
+	apiv1.PackageService_UpdatePackage_FullMethodName:  accessAnonymous,
+	apiv1.PackageService_DeletePackage_FullMethodName:  accessAnonymous,
+	apiv1.PackageService_ListPackages_FullMethodName:   accessAnonymous,
+	apiv1.PackageService_SearchPackages_FullMethodName: accessAnonymous,
+	apiv1.PackageService_WatchPackage_FullMethodName:   accessCredential,
+
+	apiv1.VehicleService_CreateVehicle_FullMethodName:  accessAnonymous,
+	apiv1.VehicleService_GetVehicle_FullMethodName:     accessAnonymous,
+	apiv1.VehicleService_UpdateVehicle_FullMethodName:  accessAnonymous,
+	apiv1.VehicleService_DeleteVehicle_FullMethodName:  accessAnonymous,
+	apiv1.VehicleService_ListVehicles_FullMethodName:   accessAnonymous,
+	apiv1.VehicleService_SearchVehicles_FullMethodName: accessAnonymous,
+	apiv1.VehicleService_WatchVehicle_FullMethodName:   accessCredential,
+
+	apiv1.TripLogService_CreateTripLog_FullMethodName:  accessAnonymous,
+	apiv1.TripLogService_GetTripLog_FullMethodName:     accessAnonymous,
+	apiv1.TripLogService_UpdateTripLog_FullMethodName:  accessAnonymous,
+	apiv1.TripLogService_DeleteTripLog_FullMethodName:  accessAnonymous,
+	apiv1.TripLogService_ListTripLogs_FullMethodName:   accessAnonymous,
+	apiv1.TripLogService_SearchTripLogs_FullMethodName: accessAnonymous,
+
+	apiv1.DeliveryLogService_CreateDeliveryLog_FullMethodName:  accessAnonymous,
+	apiv1.DeliveryLogService_GetDeliveryLog_FullMethodName:     accessAnonymous,
+	apiv1.DeliveryLogService_UpdateDeliveryLog_FullMethodName:  accessAnonymous,
+	apiv1.DeliveryLogService_DeleteDeliveryLog_FullMethodName:  accessAnonymous,
+	apiv1.DeliveryLogService_ListDeliveryLogs_FullMethodName:   accessAnonymous,
+	apiv1.DeliveryLogService_SearchDeliveryLogs_FullMethodName: accessAnonymous,
+}
+
+// guard REST 의 routeGuards 와 같은 순서로 요청 제한(IP, 등록된 API 키) → 인증 → 직원 기준 요청 제한을 적용.
+// 저장소와 API 키 목록을 REST 와 공유하므로 같은 클라이언트는 두 프로토콜을 합쳐 제한됨
+type guard struct {
+	auth     *middleware.Authenticator
+	apiKeys  *middleware.APIKeys
+	store    ratelimit.Store // nil 이면 요청 제한 없음
+	ip       ratelimit.Rate
+	apiKey   ratelimit.Rate
+	employee ratelimit.Rate
+}
+
+func newGuard(auth *middleware.Authenticator, apiKeys *middleware.APIKeys, store ratelimit.Store, cfg config.RateLimitConfig) *guard {
+	g := &guard{auth: auth, apiKeys: apiKeys}
+	if cfg.Enabled && store != nil {
+		g.store = store
+		g.ip = ratelimit.PerMinute(cfg.IP.PerMinute, cfg.IP.Burst)
+		g.apiKey = ratelimit.PerMinute(cfg.APIKey.PerMinute, cfg.APIKey.Burst)
+		g.employee = ratelimit.PerMinute(cfg.Employee.PerMinute, cfg.Employee.Burst)
+	}
+	return g
+}
+
+func (g *guard) check(ctx context.Context, fullMethod string) (context.Context, error) {
+	if isPublic(fullMethod) {
+		return ctx, nil
+	}
+	md, _ := metadata.FromIncomingContext(ctx)
+	if err := g.limit(ctx, "ip", g.ip, peerIP(ctx)); err != nil {
+		return ctx, err
+	}
+	apiKey := firstValue(md, apiKeyKey)
+	keyID, validKey := g.apiKeys.Lookup(apiKey)
+	if err := g.limit(ctx, "api_key", g.apiKey, keyID); err != nil {
+		return ctx, err
+	}
+	if methodAccess[fullMethod] == accessAnonymous {
+		return ctx, nil
+	}
+
+	// 토큰이 있으면 직원으로, 없으면 등록된 API 키를 가진 장비로 인증
+	token, ok := strings.CutPrefix(firstValue(md, authorizationKey), "Bearer ")
+	if !ok || token == "" {
+		switch {
+		case validKey:
+			return ctx, nil
+		case apiKey != "":
+			return ctx, toStatus(apperror.ErrInvalidAPIKey, "auth")
+		default:
+			return ctx, toStatus(apperror.ErrUnauthorized, "auth")
+		}
+	}
+	employeeID, _, err := g.auth.Authenticate(ctx, token)
+	if err != nil {
+		return ctx, toStatus(err, "auth")
+	}
+	if err := g.limit(ctx, "employee", g.employee, strconv.Itoa(employeeID)); err != nil {
+		return ctx, err
+	}
+	return context.WithValue(ctx, employeeIDKey{}, employeeID), nil
+}
+
+// limit 저장소 오류 시에는 서비스를 막지 않도록 통과
+func (g *guard) limit(ctx context.Context, name string, rate ratelimit.Rate, key string) error {
+	if g.store == nil || !rate.Enabled() || key == "" {
+		return nil
+	}
+	allowed, retryAfter, err := g.store.Allow(ctx, name+":"+key, rate)
+	if err != nil {
+		slog.WarnContext(ctx, "rate limit store error", "limiter", name, "error", err)
+		return nil
+	}
+	if !allowed {
+		return toStatus(apperror.ErrRateLimited.WithRetryAfter(retryAfter).
+			WithDetail("retry after %s", retryAfter.Round(time.Second)), "")
+	}
+	return nil
+}
+
+func (g *guard) unary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+	ctx, err := g.check(ctx, info.FullMethod)
+	if err != nil {
+		return nil, err
+	}
+	return handler(ctx, req)
+}
+
+func (g *guard) stream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	ctx, err := g.check(ss.Context(), info.FullMethod)
+	if err != nil {
+		return err
+	}
+	return handler(srv, &contextStream{ServerStream: ss, ctx: ctx})
+}
+
+// contextStream 인증 정보를 담은 컨텍스트를 스트림 핸들러에 전달
+type contextStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *contextStream) Context() context.Context {
+	return s.ctx
+}
+
+// observeUnary 호출 결과 메트릭을 기록하고 패닉을 Internal 에러로 바꿈
+func observeUnary(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ctx, info.FullMethod, r)
+		}
+		metrics.ObserveGRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+	}()
+	return handler(ctx, req)
+}
+
+func observeStream(srv any, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) (err error) {
+	start := time.Now()
+	defer func() {
+		if r := recover(); r != nil {
+			err = recovered(ss.Context(), info.FullMethod, r)
+		}
+		metrics.ObserveGRPC(info.FullMethod, status.Code(err).String(), time.Since(start))
+	}()
+	return handler(srv, ss)
+}
+
+func recovered(ctx context.Context, method string, r any) error {
+	slog.ErrorContext(ctx, "panic recovered", "method", method, "panic", r, "stack", string(debug.Stack()))
+	return status.Error(codes.Internal, "Internal server error")
+}
+
+func peerIP(ctx context.Context) string {
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func firstValue(md metadata.MD, key string) string {
+	if v := md.Get(key); len(v) > 0 {
+		return v[0]
+	}
+	return ""
+}
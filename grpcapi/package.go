@@ -0,0 +1,127 @@
+package grpcapi
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	apiv1 "github.com/baboyiban/go-api-server/proto/api/v1"
+	"github.com/baboyiban/go-api-server/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type packageServer struct {
+	apiv1.UnimplementedPackageServiceServer
+	svc    *service.PackageService
+	bus    *outbox.Bus
+	resync time.Duration
+}
+
+func (s *packageServer) CreatePackage(ctx context.Context, req *apiv1.CreatePackageRequest) (*apiv1.Package, error) {
+	in := dto.CreatePackageRequest{
+		PackageType:   req.GetPackageType(),
+		RegionID:      req.GetRegionId(),
+		PackageStatus: req.GetPackageStatus(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, packageResource)
+	}
+	pkg, err := s.svc.CreatePackage(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, packageResource)
+	}
+	return toPackage(pkg), nil
+}
+
+func (s *packageServer) GetPackage(ctx context.Context, req *apiv1.GetPackageRequest) (*apiv1.Package, error) {
+	pkg, err := s.svc.GetPackageByID(ctx, int(req.GetPackageId()))
+	if err != nil {
+		return nil, toStatus(err, packageResource)
+	}
+	return toPackage(pkg), nil
+}
+
+func (s *packageServer) UpdatePackage(ctx context.Context, req *apiv1.UpdatePackageRequest) (*apiv1.Package, error) {
+	in := dto.UpdatePackageRequest{
+		PackageType:   req.GetPackageType(),
+		RegionID:      req.GetRegionId(),
+		PackageStatus: req.GetPackageStatus(),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, packageResource)
+	}
+	pkg, err := s.svc.UpdatePackage(ctx, int(req.GetPackageId()), in)
+	if err != nil {
+		return nil, toStatus(err, packageResource)
+	}
+	return toPackage(pkg), nil
+}
+
+func (s *packageServer) DeletePackage(ctx context.Context, req *apiv1.DeletePackageRequest) (*emptypb.Empty, error) {
+	if err := s.svc.DeletePackage(ctx, int(req.GetPackageId())); err != nil {
+		return nil, toStatus(err, packageResource)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *packageServer) ListPackages(ctx context.Context, req *apiv1.ListPackagesRequest) (*apiv1.ListPackagesResponse, error) {
+	pkgs, err := s.svc.ListPackages(ctx, req.GetSort())
+	if err != nil {
+		return nil, toStatus(err, packageResource)
+	}
+	return toPackageList(pkgs), nil
+}
+
+func (s *packageServer) SearchPackages(ctx context.Context, req *apiv1.SearchPackagesRequest) (*apiv1.ListPackagesResponse, error) {
+	params, sort := searchParams(req)
+	pkgs, err := s.svc.SearchPackages(ctx, params, sort)
+	if err != nil {
+		return nil, toStatus(err, packageResource)
+	}
+	return toPackageList(pkgs), nil
+}
+
+func (s *packageServer) WatchPackage(req *apiv1.WatchPackageRequest, stream apiv1.PackageService_WatchPackageServer) error {
+	id := int(req.GetPackageId())
+	return watch(stream.Context(), s.bus, s.resync, watchTarget[*apiv1.Package]{
+		resource:    packageResource,
+		aggregateID: strconv.Itoa(id),
+		types:       []string{outbox.PackageCreated, outbox.PackageUpdated, outbox.PackageDeleted, outbox.PackageCompleted},
+		load: func(ctx context.Context) (*apiv1.Package, error) {
+			pkg, err := s.svc.GetPackageByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return toPackage(pkg), nil
+		},
+		send: func(eventType, eventID string, occurredAt time.Time, pkg *apiv1.Package) error {
+			return stream.Send(&apiv1.PackageEvent{
+				Type:       eventType,
+				EventId:    eventID,
+				OccurredAt: formatTime(occurredAt),
+				Package:    pkg,
+			})
+		},
+	})
+}
+
+func toPackage(m *models.Package) *apiv1.Package {
+	return &apiv1.Package{
+		PackageId:     int32(m.PackageID),
+		PackageType:   m.PackageType,
+		RegionId:      m.RegionID,
+		PackageStatus: m.PackageStatus,
+		RegisteredAt:  formatTime(m.RegisteredAt),
+	}
+}
+
+func toPackageList(pkgs []models.Package) *apiv1.ListPackagesResponse {
+	res := &apiv1.ListPackagesResponse{Packages: make([]*apiv1.Package, 0, len(pkgs))}
+	for i := range pkgs {
+		res.Packages = append(res.Packages, toPackage(&pkgs[i]))
+	}
+	return res
+}
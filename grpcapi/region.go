@@ -0,0 +1,108 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	apiv1 "github.com/baboyiban/go-api-server/proto/api/v1"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/utils"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type regionServer struct {
+	apiv1.UnimplementedRegionServiceServer
+	svc *service.RegionService
+}
+
+func (s *regionServer) CreateRegion(ctx context.Context, req *apiv1.CreateRegionRequest) (*apiv1.Region, error) {
+	in := dto.CreateRegionRequest{
+		RegionID:    req.GetRegionId(),
+		RegionName:  req.GetRegionName(),
+		CoordX:      int(req.GetCoordX()),
+		CoordY:      int(req.GetCoordY()),
+		MaxCapacity: int(req.GetMaxCapacity()),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, regionResource)
+	}
+	region, err := s.svc.CreateRegion(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, regionResource)
+	}
+	return toRegion(region), nil
+}
+
+func (s *regionServer) GetRegion(ctx context.Context, req *apiv1.GetRegionRequest) (*apiv1.Region, error) {
+	region, err := s.svc.GetRegionByID(ctx, req.GetRegionId())
+	if err != nil {
+		return nil, toStatus(err, regionResource)
+	}
+	return toRegion(region), nil
+}
+
+func (s *regionServer) UpdateRegion(ctx context.Context, req *apiv1.UpdateRegionRequest) (*apiv1.Region, error) {
+	in := dto.UpdateRegionRequest{
+		RegionName:      req.GetRegionName(),
+		CoordX:          int(req.GetCoordX()),
+		CoordY:          int(req.GetCoordY()),
+		MaxCapacity:     int(req.GetMaxCapacity()),
+		CurrentCapacity: int(req.GetCurrentCapacity()),
+		IsFull:          req.GetIsFull(),
+		SaturatedAt:     req.SaturatedAt,
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, regionResource)
+	}
+	region, err := s.svc.UpdateRegion(ctx, req.GetRegionId(), in)
+	if err != nil {
+		return nil, toStatus(err, regionResource)
+	}
+	return toRegion(region), nil
+}
+
+func (s *regionServer) DeleteRegion(ctx context.Context, req *apiv1.DeleteRegionRequest) (*emptypb.Empty, error) {
+	if err := s.svc.DeleteRegion(ctx, req.GetRegionId()); err != nil {
+		return nil, toStatus(err, regionResource)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *regionServer) ListRegions(ctx context.Context, req *apiv1.ListRegionsRequest) (*apiv1.ListRegionsResponse, error) {
+	regions, err := s.svc.ListRegions(ctx, req.GetSort())
+	if err != nil {
+		return nil, toStatus(err, regionResource)
+	}
+	return toRegionList(regions), nil
+}
+
+func (s *regionServer) SearchRegions(ctx context.Context, req *apiv1.SearchRegionsRequest) (*apiv1.ListRegionsResponse, error) {
+	params, sort := searchParams(req)
+	regions, err := s.svc.SearchRegions(ctx, params, sort)
+	if err != nil {
+		return nil, toStatus(err, regionResource)
+	}
+	return toRegionList(regions), nil
+}
+
+func toRegion(m *models.Region) *apiv1.Region {
+	return &apiv1.Region{
+		RegionId:        m.RegionID,
+		RegionName:      m.RegionName,
+		CoordX:          int32(m.CoordX),
+		CoordY:          int32(m.CoordY),
+		MaxCapacity:     int32(m.MaxCapacity),
+		CurrentCapacity: int32(m.CurrentCapacity),
+		IsFull:          m.IsFull,
+		SaturatedAt:     utils.FormatTimePtr(m.SaturatedAt),
+	}
+}
+
+func toRegionList(regions []models.Region) *apiv1.ListRegionsResponse {
+	res := &apiv1.ListRegionsResponse{Regions: make([]*apiv1.Region, 0, len(regions))}
+	for i := range regions {
+		res.Regions = append(res.Regions, toRegion(&regions[i]))
+	}
+	return res
+}
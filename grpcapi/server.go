@@ -0,0 +1,62 @@
+// Package grpcapi 는 REST 핸들러와 같은 서비스 계층(service.*Service)을 gRPC 로 제공합니다.
+//
+// 인증은 REST 의 같은 라우트와 맞춥니다. CRUD 메서드는 REST 처럼 인증 없이 호출할 수 있고,
+// Watch 스트림은 authorization 메타데이터(Bearer <token>)의 직원 JWT 나 x-api-key 의 등록된 API 키가 필요합니다.
+// 요청 제한도 REST 와 같은 저장소와 규칙(IP, 등록된 API 키, 직원)을 사용합니다.
+// 에러는 apperror 를 거쳐 gRPC 상태 코드로 바뀌며, 에러 코드는 ErrorInfo 상세의 Reason 으로 전달됩니다.
+package grpcapi
+
+import (
+	"github.com/baboyiban/go-api-server/cache"
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/outbox"
+	apiv1 "github.com/baboyiban/go-api-server/proto/api/v1"
+	"github.com/baboyiban/go-api-server/ratelimit"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/shutdown"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/reflection"
+)
+
+// 에러 코드 생성에 사용하는 리소스 이름 (REST 핸들러와 같음)
+const (
+	regionResource      = "region"
+	packageResource     = "package"
+	vehicleResource     = "vehicle"
+	tripLogResource     = "trip_log"
+	deliveryLogResource = "delivery_log"
+)
+
+// NewServer 서비스를 등록한 gRPC 서버. store 는 REST 와 공유하는 요청 제한 저장소 (nil 이면 제한 없음),
+// apiKeys 는 REST 와 같은 API 키 목록, readCache 는 REST 와 공유하는 지역, 차량 조회 캐시 (nil 이면 캐시하지 않음)
+func NewServer(cfg config.GRPCConfig, rateLimit config.RateLimitConfig, store ratelimit.Store, auth *middleware.Authenticator, apiKeys *middleware.APIKeys, repo repository.Store, bus *outbox.Bus, readCache *cache.Cache) *grpc.Server {
+	g := newGuard(auth, apiKeys, store, rateLimit)
+	srv := grpc.NewServer(
+		grpc.StatsHandler(otelgrpc.NewServerHandler()),
+		grpc.ChainUnaryInterceptor(observeUnary, g.unary),
+		grpc.ChainStreamInterceptor(observeStream, g.stream),
+	)
+
+	apiv1.RegisterRegionServiceServer(srv, &regionServer{svc: service.NewRegionService(repo, readCache)})
+	apiv1.RegisterPackageServiceServer(srv, &packageServer{svc: service.NewPackageService(repo, readCache), bus: bus, resync: cfg.WatchResync})
+	apiv1.RegisterVehicleServiceServer(srv, &vehicleServer{svc: service.NewVehicleService(repo, readCache), bus: bus, resync: cfg.WatchResync})
+	apiv1.RegisterTripLogServiceServer(srv, &tripLogServer{svc: service.NewTripLogService(repo)})
+	apiv1.RegisterDeliveryLogServiceServer(srv, &deliveryLogServer{svc: service.NewDeliveryLogService(repo)})
+
+	// 종료가 시작되면 readiness 와 마찬가지로 NOT_SERVING 으로 바꿈
+	healthServer := health.NewServer()
+	healthpb.RegisterHealthServer(srv, healthServer)
+	go func() {
+		<-shutdown.Done()
+		healthServer.Shutdown()
+	}()
+	if cfg.Reflection {
+		reflection.Register(srv)
+	}
+	return srv
+}
@@ -0,0 +1,328 @@
+package grpcapi
+
+import (
+	"context"
+	"io"
+	"net"
+	"os"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	apiv1 "github.com/baboyiban/go-api-server/proto/api/v1"
+	"github.com/baboyiban/go-api-server/ratelimit"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/utils"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+func TestMain(m *testing.M) {
+	utils.ConfigureJWT("test-secret-test-secret-test-secret", time.Hour)
+	os.Exit(m.Run())
+}
+
+// testAPIKey 테스트 서버에 등록된 장비 API 키
+const testAPIKey = "device-key-device-key-device"
+
+// testServer 메모리 저장소를 쓰는 서버에 bufconn 으로 연결한 클라이언트
+type testServer struct {
+	conn  *grpc.ClientConn
+	store *repository.MemoryStore
+	bus   *outbox.Bus
+}
+
+// newTestServer 지역 R01, 택배 1, 직원 두 명(1 활성, 2 비활성)이 있는 서버.
+// rateLimit 이 nil 이면 요청 제한 없음
+func newTestServer(t *testing.T, rateLimit *config.RateLimitConfig) *testServer {
+	t.Helper()
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	for _, err := range []error{
+		store.Regions().Create(ctx, &models.Region{RegionID: "R01", RegionName: "Seoul", MaxCapacity: 10}),
+		store.Packages().Create(ctx, &models.Package{PackageType: "box", RegionID: "R01", PackageStatus: "등록됨"}),
+		store.Employees().Create(ctx, &models.Employee{Name: "Kim", Password: "x", Position: "관리직", IsActive: true}),
+		store.Employees().Create(ctx, &models.Employee{Name: "Lee", Password: "x", Position: "운송직"}),
+	} {
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	auth := middleware.NewAuthenticator(service.NewEmployeeStatusCache(store, 0), middleware.NewSession(config.Default().Auth.Session))
+	cfg := config.Default().GRPC
+	cfg.WatchResync = time.Hour
+	var limits config.RateLimitConfig
+	var limitStore ratelimit.Store
+	if rateLimit != nil {
+		limits = *rateLimit
+		limitCtx, cancel := context.WithCancel(context.Background())
+		t.Cleanup(cancel)
+		limitStore = ratelimit.NewMemoryStore(limitCtx, time.Minute)
+	}
+	bus := outbox.NewBus()
+	srv := NewServer(cfg, limits, limitStore, auth, middleware.NewAPIKeys([]string{testAPIKey, "key-2-key-2-key-2-key-2-k"}), store, bus, nil)
+
+	ln := bufconn.Listen(1 << 20)
+	go func() { _ = srv.Serve(ln) }()
+	t.Cleanup(srv.Stop)
+	conn, err := grpc.NewClient("passthrough:///bufconn",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return ln.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+	return &testServer{conn: conn, store: store, bus: bus}
+}
+
+// withToken employeeID 직원의 JWT 를 authorization 메타데이터로
+func withToken(t *testing.T, employeeID int) context.Context {
+	t.Helper()
+	token, err := utils.GenerateJWT(employeeID, "", 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return metadata.AppendToOutgoingContext(context.Background(), authorizationKey, "Bearer "+token)
+}
+
+// reason ErrorInfo 상세에 담긴 REST 와 같은 에러 코드
+func reason(err error) string {
+	for _, d := range status.Convert(err).Details() {
+		if info, ok := d.(*errdetails.ErrorInfo); ok {
+			return info.Reason
+		}
+	}
+	return ""
+}
+
+func TestServer_Auth(t *testing.T) {
+	ts := newTestServer(t, nil)
+	withAPIKey := func(key string) context.Context {
+		return metadata.AppendToOutgoingContext(context.Background(), apiKeyKey, key)
+	}
+	getRegion := func(ctx context.Context) error {
+		_, err := apiv1.NewRegionServiceClient(ts.conn).GetRegion(ctx, &apiv1.GetRegionRequest{RegionId: "R01"})
+		return err
+	}
+	// 스트림의 인증 실패는 첫 메시지를 받을 때 드러남
+	watchPackage := func(ctx context.Context) error {
+		ctx, cancel := context.WithTimeout(ctx, 3*time.Second)
+		defer cancel()
+		stream, err := apiv1.NewPackageServiceClient(ts.conn).WatchPackage(ctx, &apiv1.WatchPackageRequest{PackageId: 1})
+		if err != nil {
+			return err
+		}
+		_, err = stream.Recv()
+		return err
+	}
+	tests := []struct {
+		name   string
+		call   func(ctx context.Context) error
+		ctx    context.Context
+		code   codes.Code
+		reason string
+	}{
+		// REST 의 GET /api/region/:id 처럼 인증 없이 호출
+		{"anonymous read", getRegion, context.Background(), codes.OK, ""},
+		{"anonymous read ignores token", getRegion, withToken(t, 2), codes.OK, ""},
+		{"watch without credentials", watchPackage, context.Background(), codes.Unauthenticated, "UNAUTHORIZED"},
+		{"watch with invalid token", watchPackage, metadata.AppendToOutgoingContext(context.Background(), authorizationKey, "Bearer nope"), codes.Unauthenticated, "INVALID_TOKEN"},
+		{"watch as inactive employee", watchPackage, withToken(t, 2), codes.PermissionDenied, "ACCOUNT_DISABLED"},
+		{"watch as employee", watchPackage, withToken(t, 1), codes.OK, ""},
+		{"watch with api key", watchPackage, withAPIKey(testAPIKey), codes.OK, ""},
+		{"watch with unknown api key", watchPackage, withAPIKey("rotated-random-value"), codes.Unauthenticated, "INVALID_API_KEY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call(tt.ctx)
+			if status.Code(err) != tt.code || reason(err) != tt.reason {
+				t.Errorf("err = %v (reason %q), want %s %q", err, reason(err), tt.code, tt.reason)
+			}
+		})
+	}
+
+	// 헬스체크는 인증 없이 호출
+	res, err := healthpb.NewHealthClient(ts.conn).Check(context.Background(), &healthpb.HealthCheckRequest{})
+	if err != nil || res.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+		t.Errorf("health = %v, %v", res, err)
+	}
+}
+
+func TestServer_Errors(t *testing.T) {
+	ts := newTestServer(t, nil)
+	ctx := withToken(t, 1)
+	packages := apiv1.NewPackageServiceClient(ts.conn)
+	regions := apiv1.NewRegionServiceClient(ts.conn)
+	tests := []struct {
+		name   string
+		call   func() error
+		code   codes.Code
+		reason string
+		field  string // BadRequest 상세에 있어야 하는 필드
+	}{
+		{"created", func() error {
+			_, err := packages.CreatePackage(ctx, &apiv1.CreatePackageRequest{PackageType: "bag", RegionId: "R01"})
+			return err
+		}, codes.OK, "", ""},
+		{"validation", func() error {
+			_, err := packages.CreatePackage(ctx, &apiv1.CreatePackageRequest{RegionId: "R01"})
+			return err
+		}, codes.InvalidArgument, "VALIDATION_FAILED", "package_type"},
+		{"not found", func() error {
+			_, err := packages.GetPackage(ctx, &apiv1.GetPackageRequest{PackageId: 99})
+			return err
+		}, codes.NotFound, "PACKAGE_NOT_FOUND", ""},
+		{"duplicate", func() error {
+			_, err := regions.CreateRegion(ctx, &apiv1.CreateRegionRequest{RegionId: "R01", RegionName: "Seoul"})
+			return err
+		}, codes.AlreadyExists, "DUPLICATE_REGION", ""},
+		{"conflict", func() error {
+			_, err := packages.UpdatePackage(ctx, &apiv1.UpdatePackageRequest{PackageId: 1, PackageStatus: "투입됨"})
+			return err
+		}, codes.FailedPrecondition, "SORTER_MANAGED", ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if status.Code(err) != tt.code || reason(err) != tt.reason {
+				t.Fatalf("err = %v (reason %q), want %s %q", err, reason(err), tt.code, tt.reason)
+			}
+			if tt.field == "" {
+				return
+			}
+			for _, d := range status.Convert(err).Details() {
+				if br, ok := d.(*errdetails.BadRequest); ok {
+					for _, v := range br.GetFieldViolations() {
+						if v.GetField() == tt.field {
+							return
+						}
+					}
+				}
+			}
+			t.Errorf("no field violation for %s in %v", tt.field, status.Convert(err).Details())
+		})
+	}
+}
+
+func TestServer_Search(t *testing.T) {
+	ts := newTestServer(t, nil)
+	ctx := withToken(t, 1)
+	packages := apiv1.NewPackageServiceClient(ts.conn)
+	if _, err := packages.CreatePackage(ctx, &apiv1.CreatePackageRequest{PackageType: "bag", RegionId: "R01", PackageStatus: "A차운송중"}); err != nil {
+		t.Fatal(err)
+	}
+	inTransit, region := "A차운송중", "R01"
+	tests := []struct {
+		name string
+		req  *apiv1.SearchPackagesRequest
+		want []int32
+	}{
+		{"by status", &apiv1.SearchPackagesRequest{PackageStatus: &inTransit}, []int32{2}},
+		{"by region sorted", &apiv1.SearchPackagesRequest{RegionId: &region, Sort: "-package_id"}, []int32{2, 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := packages.SearchPackages(ctx, tt.req)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int32
+			for _, p := range res.GetPackages() {
+				got = append(got, p.GetPackageId())
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestServer_RateLimit(t *testing.T) {
+	ts := newTestServer(t, &config.RateLimitConfig{
+		Enabled: true,
+		IP:      config.RateRule{PerMinute: 1, Burst: 3},
+		APIKey:  config.RateRule{PerMinute: 1, Burst: 1},
+	})
+	regions := apiv1.NewRegionServiceClient(ts.conn)
+	call := func(apiKey string) error {
+		ctx := metadata.AppendToOutgoingContext(context.Background(), apiKeyKey, apiKey)
+		_, err := regions.GetRegion(ctx, &apiv1.GetRegionRequest{RegionId: "R01"})
+		return err
+	}
+	tests := []struct {
+		name   string
+		apiKey string
+		code   codes.Code
+	}{
+		{"first call", testAPIKey, codes.OK},
+		{"same key limited", testAPIKey, codes.ResourceExhausted},
+		{"other registered key", "key-2-key-2-key-2-key-2-k", codes.OK},
+		// 등록되지 않은 키는 별도 버킷이 없어 값을 바꿔도 IP 기준 제한에 걸림
+		{"rotated unknown key", "random-1", codes.ResourceExhausted},
+	}
+	for _, tt := range tests {
+		if err := call(tt.apiKey); status.Code(err) != tt.code {
+			t.Fatalf("%s: err = %v, want %s", tt.name, err, tt.code)
+		}
+	}
+}
+
+func TestServer_WatchPackage(t *testing.T) {
+	ts := newTestServer(t, nil)
+	ctx, cancel := context.WithTimeout(withToken(t, 1), 3*time.Second)
+	defer cancel()
+	stream, err := apiv1.NewPackageServiceClient(ts.conn).WatchPackage(ctx, &apiv1.WatchPackageRequest{PackageId: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	expect := func(eventType, packageStatus string) {
+		t.Helper()
+		ev, err := stream.Recv()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if ev.GetType() != eventType || ev.GetPackage().GetPackageStatus() != packageStatus {
+			t.Errorf("event = %s %s, want %s %s", ev.GetType(), ev.GetPackage().GetPackageStatus(), eventType, packageStatus)
+		}
+	}
+	// 디스패처가 하듯 새로 기록된 이벤트를 버스로 전달
+	delivered := 0
+	dispatch := func() {
+		events := ts.store.RecordedEvents()
+		for _, ev := range events[delivered:] {
+			_ = ts.bus.Handle(context.Background(), ev)
+		}
+		delivered = len(events)
+	}
+	svc := service.NewPackageService(ts.store, nil)
+
+	expect("snapshot", "등록됨")
+	if _, err := svc.UpdatePackage(context.Background(), 1, dto.UpdatePackageRequest{PackageStatus: "A차운송중"}); err != nil {
+		t.Fatal(err)
+	}
+	dispatch()
+	expect(outbox.PackageUpdated, "A차운송중")
+
+	// 삭제되면 마지막 상태를 보내고 스트림을 정상 종료
+	if err := svc.DeletePackage(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	dispatch()
+	expect(outbox.PackageDeleted, "A차운송중")
+	if _, err := stream.Recv(); err != io.EOF {
+		t.Errorf("stream not closed after delete: %v", err)
+	}
+}
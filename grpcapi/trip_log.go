@@ -0,0 +1,104 @@
+package grpcapi
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/dto"
+	apiv1 "github.com/baboyiban/go-api-server/proto/api/v1"
+	"github.com/baboyiban/go-api-server/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type tripLogServer struct {
+	apiv1.UnimplementedTripLogServiceServer
+	svc *service.TripLogService
+}
+
+func (s *tripLogServer) CreateTripLog(ctx context.Context, req *apiv1.CreateTripLogRequest) (*apiv1.TripLog, error) {
+	in := dto.CreateTripLogRequest{
+		VehicleID:   req.GetVehicleId(),
+		DriverID:    intPtr(req.DriverId),
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Status:      req.GetStatus(),
+		Destination: req.Destination,
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, tripLogResource)
+	}
+	trip, err := s.svc.CreateTripLog(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, tripLogResource)
+	}
+	return toTripLog(trip), nil
+}
+
+func (s *tripLogServer) GetTripLog(ctx context.Context, req *apiv1.GetTripLogRequest) (*apiv1.TripLog, error) {
+	trip, err := s.svc.GetTripLogByID(ctx, int(req.GetTripId()))
+	if err != nil {
+		return nil, toStatus(err, tripLogResource)
+	}
+	return toTripLog(trip), nil
+}
+
+func (s *tripLogServer) UpdateTripLog(ctx context.Context, req *apiv1.UpdateTripLogRequest) (*apiv1.TripLog, error) {
+	in := dto.UpdateTripLogRequest{
+		DriverID:    intPtr(req.DriverId),
+		StartTime:   req.StartTime,
+		EndTime:     req.EndTime,
+		Status:      req.GetStatus(),
+		Destination: req.Destination,
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, tripLogResource)
+	}
+	trip, err := s.svc.UpdateTripLog(ctx, int(req.GetTripId()), in)
+	if err != nil {
+		return nil, toStatus(err, tripLogResource)
+	}
+	return toTripLog(trip), nil
+}
+
+func (s *tripLogServer) DeleteTripLog(ctx context.Context, req *apiv1.DeleteTripLogRequest) (*emptypb.Empty, error) {
+	if err := s.svc.DeleteTripLog(ctx, int(req.GetTripId())); err != nil {
+		return nil, toStatus(err, tripLogResource)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *tripLogServer) ListTripLogs(ctx context.Context, req *apiv1.ListTripLogsRequest) (*apiv1.ListTripLogsResponse, error) {
+	trips, err := s.svc.ListTripLogs(ctx, req.GetSort())
+	if err != nil {
+		return nil, toStatus(err, tripLogResource)
+	}
+	return toTripLogList(trips), nil
+}
+
+func (s *tripLogServer) SearchTripLogs(ctx context.Context, req *apiv1.SearchTripLogsRequest) (*apiv1.ListTripLogsResponse, error) {
+	params, sort := searchParams(req)
+	trips, err := s.svc.SearchTripLogs(ctx, params, sort)
+	if err != nil {
+		return nil, toStatus(err, tripLogResource)
+	}
+	return toTripLogList(trips), nil
+}
+
+func toTripLog(r *dto.TripLogResponse) *apiv1.TripLog {
+	return &apiv1.TripLog{
+		TripId:      int32(r.TripID),
+		VehicleId:   r.VehicleID,
+		DriverId:    int32Ptr(r.DriverID),
+		StartTime:   r.StartTime,
+		EndTime:     r.EndTime,
+		Status:      r.Status,
+		Destination: r.Destination,
+	}
+}
+
+func toTripLogList(trips []dto.TripLogResponse) *apiv1.ListTripLogsResponse {
+	res := &apiv1.ListTripLogsResponse{TripLogs: make([]*apiv1.TripLog, 0, len(trips))}
+	for i := range trips {
+		res.TripLogs = append(res.TripLogs, toTripLog(&trips[i]))
+	}
+	return res
+}
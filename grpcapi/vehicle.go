@@ -0,0 +1,137 @@
+package grpcapi
+
+import (
+	"context"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	apiv1 "github.com/baboyiban/go-api-server/proto/api/v1"
+	"github.com/baboyiban/go-api-server/service"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+type vehicleServer struct {
+	apiv1.UnimplementedVehicleServiceServer
+	svc    *service.VehicleService
+	bus    *outbox.Bus
+	resync time.Duration
+}
+
+func (s *vehicleServer) CreateVehicle(ctx context.Context, req *apiv1.CreateVehicleRequest) (*apiv1.Vehicle, error) {
+	in := dto.CreateVehicleRequest{
+		VehicleID: req.GetVehicleId(),
+		MaxLoad:   int(req.GetMaxLoad()),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, vehicleResource)
+	}
+	vehicle, err := s.svc.CreateVehicle(ctx, in)
+	if err != nil {
+		return nil, toStatus(err, vehicleResource)
+	}
+	return toVehicle(vehicle), nil
+}
+
+func (s *vehicleServer) GetVehicle(ctx context.Context, req *apiv1.GetVehicleRequest) (*apiv1.Vehicle, error) {
+	vehicle, err := s.svc.GetVehicleByID(ctx, int(req.GetInternalId()))
+	if err != nil {
+		return nil, toStatus(err, vehicleResource)
+	}
+	return toVehicle(vehicle), nil
+}
+
+func (s *vehicleServer) UpdateVehicle(ctx context.Context, req *apiv1.UpdateVehicleRequest) (*apiv1.Vehicle, error) {
+	in := dto.UpdateVehicleRequest{
+		MaxLoad:   int(req.GetMaxLoad()),
+		LedStatus: req.LedStatus,
+		CoordX:    int(req.GetCoordX()),
+		CoordY:    int(req.GetCoordY()),
+	}
+	if err := validate(&in); err != nil {
+		return nil, toStatus(err, vehicleResource)
+	}
+	vehicle, err := s.svc.UpdateVehicle(ctx, int(req.GetInternalId()), in)
+	if err != nil {
+		return nil, toStatus(err, vehicleResource)
+	}
+	return toVehicle(vehicle), nil
+}
+
+func (s *vehicleServer) DeleteVehicle(ctx context.Context, req *apiv1.DeleteVehicleRequest) (*emptypb.Empty, error) {
+	if err := s.svc.DeleteVehicle(ctx, int(req.GetInternalId())); err != nil {
+		return nil, toStatus(err, vehicleResource)
+	}
+	return &emptypb.Empty{}, nil
+}
+
+func (s *vehicleServer) ListVehicles(ctx context.Context, req *apiv1.ListVehiclesRequest) (*apiv1.ListVehiclesResponse, error) {
+	vehicles, err := s.svc.ListVehicles(ctx, req.GetSort())
+	if err != nil {
+		return nil, toStatus(err, vehicleResource)
+	}
+	return toVehicleList(vehicles), nil
+}
+
+func (s *vehicleServer) SearchVehicles(ctx context.Context, req *apiv1.SearchVehiclesRequest) (*apiv1.ListVehiclesResponse, error) {
+	params, sort := searchParams(req)
+	vehicles, err := s.svc.SearchVehicles(ctx, params, sort)
+	if err != nil {
+		return nil, toStatus(err, vehicleResource)
+	}
+	return toVehicleList(vehicles), nil
+}
+
+func (s *vehicleServer) WatchVehicle(req *apiv1.WatchVehicleRequest, stream apiv1.VehicleService_WatchVehicleServer) error {
+	id := int(req.GetInternalId())
+	// 차량 이벤트의 집계 ID 는 vehicle_id 이므로 먼저 조회
+	vehicle, err := s.svc.GetVehicleByID(stream.Context(), id)
+	if err != nil {
+		return toStatus(err, vehicleResource)
+	}
+	return watch(stream.Context(), s.bus, s.resync, watchTarget[*apiv1.Vehicle]{
+		resource:    vehicleResource,
+		aggregateID: vehicle.VehicleID,
+		types: []string{
+			outbox.VehicleCreated, outbox.VehicleUpdated, outbox.VehicleDeleted, outbox.VehicleLedChanged,
+			outbox.VehicleConfirmationRequested, outbox.VehicleConfirmationAcked,
+		},
+		load: func(ctx context.Context) (*apiv1.Vehicle, error) {
+			vehicle, err := s.svc.GetVehicleByID(ctx, id)
+			if err != nil {
+				return nil, err
+			}
+			return toVehicle(vehicle), nil
+		},
+		send: func(eventType, eventID string, occurredAt time.Time, vehicle *apiv1.Vehicle) error {
+			return stream.Send(&apiv1.VehicleEvent{
+				Type:       eventType,
+				EventId:    eventID,
+				OccurredAt: formatTime(occurredAt),
+				Vehicle:    vehicle,
+			})
+		},
+	})
+}
+
+func toVehicle(m *models.Vehicle) *apiv1.Vehicle {
+	return &apiv1.Vehicle{
+		InternalId:        int32(m.InternalID),
+		VehicleId:         m.VehicleID,
+		CurrentLoad:       int32(m.CurrentLoad),
+		MaxLoad:           int32(m.MaxLoad),
+		LedStatus:         m.LedStatus,
+		NeedsConfirmation: m.NeedsConfirmation,
+		CoordX:            int32(m.CoordX),
+		CoordY:            int32(m.CoordY),
+	}
+}
+
+func toVehicleList(vehicles []models.Vehicle) *apiv1.ListVehiclesResponse {
+	res := &apiv1.ListVehiclesResponse{Vehicles: make([]*apiv1.Vehicle, 0, len(vehicles))}
+	for i := range vehicles {
+		res.Vehicles = append(res.Vehicles, toVehicle(&vehicles[i]))
+	}
+	return res
+}
@@ -0,0 +1,95 @@
+package grpcapi
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/shutdown"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+)
+
+// watchSnapshot 스트림 시작과 주기적 재조회로 보내는 메시지의 타입
+const watchSnapshot = "snapshot"
+
+// watchBuffer 구독자별 이벤트 버퍼. 가득 차서 버려진 이벤트는 주기적 재조회로 보완됨
+const watchBuffer = 16
+
+// watchTarget 감시 대상 하나의 조회와 전송 방법
+type watchTarget[T proto.Message] struct {
+	resource    string
+	aggregateID string
+	types       []string
+	load        func(ctx context.Context) (T, error)
+	send        func(eventType, eventID string, occurredAt time.Time, v T) error
+}
+
+// watch 현재 상태를 먼저 보내고, 대상의 아웃박스 이벤트가 오면 다시 조회해 전송.
+// 다른 인스턴스에서 처리된 이벤트는 이 프로세스의 버스로 오지 않으므로 resync 주기마다 다시 조회해 바뀐 경우에도 전송.
+// 대상이 삭제되면 마지막 상태를 보내고 정상 종료
+func watch[T proto.Message](ctx context.Context, bus *outbox.Bus, resync time.Duration, t watchTarget[T]) error {
+	// 조회와 구독 사이의 변경을 놓치지 않도록 먼저 구독
+	events, unsubscribe := bus.Subscribe(watchBuffer, t.types...)
+	defer unsubscribe()
+
+	last, err := t.load(ctx)
+	if err != nil {
+		return toStatus(err, t.resource)
+	}
+	if err := t.send(watchSnapshot, "", time.Now(), last); err != nil {
+		return err
+	}
+
+	ticker := time.NewTicker(resync)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return toStatus(ctx.Err(), t.resource)
+		case <-shutdown.Done():
+			return status.Error(codes.Unavailable, "server is shutting down")
+		case ev, ok := <-events:
+			if !ok {
+				return nil
+			}
+			if ev.AggregateID != t.aggregateID {
+				continue
+			}
+			current, err := t.load(ctx)
+			if isNotFound(err) {
+				return t.send(ev.Type, ev.ID, ev.OccurredAt, last)
+			}
+			if err != nil {
+				return toStatus(err, t.resource)
+			}
+			last = current
+			if err := t.send(ev.Type, ev.ID, ev.OccurredAt, current); err != nil {
+				return err
+			}
+		case <-ticker.C:
+			current, err := t.load(ctx)
+			if err != nil {
+				return toStatus(err, t.resource)
+			}
+			if proto.Equal(current, last) {
+				continue
+			}
+			last = current
+			if err := t.send(watchSnapshot, "", time.Now(), current); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+func isNotFound(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) {
+		return false
+	}
+	return apperror.Translate(err, "").Status == http.StatusNotFound
+}
@@ -1,119 +1,173 @@
 package handlers
 
 import (
-	"log"
 	"net/http"
+	"strconv"
 
+	"github.com/baboyiban/go-api-server/apperror"
 	"github.com/baboyiban/go-api-server/dto"
-	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/middleware"
 	"github.com/baboyiban/go-api-server/service"
 	"github.com/baboyiban/go-api-server/utils"
 	"github.com/gin-gonic/gin"
 )
 
+const authResource = "auth"
+
 // AuthHandler handles authentication-related endpoints
 type AuthHandler struct {
 	service *service.AuthService
+	session *middleware.Session
 }
 
 // NewAuthHandler creates a new AuthHandler
-func NewAuthHandler(s *service.AuthService) *AuthHandler {
-	return &AuthHandler{service: s}
+func NewAuthHandler(s *service.AuthService, session *middleware.Session) *AuthHandler {
+	return &AuthHandler{service: s, session: session}
 }
 
 // @Summary      로그인
-// @Description  직원 ID와 비밀번호로 로그인합니다. 성공 시 JWT 토큰을 HttpOnly Secure 쿠키로 반환합니다.
+// @Description  직원 ID 또는 로그인 아이디(username)와 비밀번호로 로그인합니다. 쿠키 세션이 활성화되어 있으면 JWT 를 HttpOnly 쿠키(token)로,
+// @Description  CSRF 토큰을 스크립트에서 읽을 수 있는 쿠키(csrf_token)로 함께 발급합니다.
 // @Tags         auth
 // @Accept       json
 // @Produce      json
 // @Param        login  body      dto.LoginRequest  true  "로그인 정보"
-// @Success      200    {object}  dto.LoginResponse "JWT 토큰이 HttpOnly Secure 쿠키(token)로도 반환됨"
-// @Failure      400    {object}  dto.ErrorResponse
-// @Failure      401    {object}  dto.ErrorResponse
+// @Success      200    {object}  dto.LoginResponse
+// @Failure      400    {object}  dto.Problem
+// @Failure      401    {object}  dto.Problem
+// @Failure      403    {object}  dto.Problem "비활성화된 계정"
+// @Failure      429    {object}  dto.Problem "요청 한도 초과 또는 계정 잠금 (Retry-After 헤더 포함)"
 // @Router       /api/auth/login [post]
 func (h *AuthHandler) Login(c *gin.Context) {
 	var req dto.LoginRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request"})
+		apperror.Abort(c, err, authResource)
 		return
 	}
 	token, emp, err := h.service.Login(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "Invalid credentials"})
+		apperror.Abort(c, err, authResource)
 		return
 	}
 
-	// SameSite 속성 설정 - 이 부분을 추가해야 합니다
-	// c.SetSameSite(http.SameSiteNoneMode) // 크로스 도메인 요청을 위해 None으로 설정
-
-	// domain := os.Getenv("BACKEND_DOMAIN")
-	// // JWT를 HttpOnly Secure 쿠키로 설정
-	// c.SetCookie(
-	// 	"token",
-	// 	token,
-	// 	60*60*8, // 8시간
-	// 	"/",
-	// 	domain, // 도메인 (필요시 지정)
-	// 	true,   // Secure (운영환경에서는 true, 개발환경에서는 false 가능)
-	// 	true,   // HttpOnly
-	// )
+	if err := h.session.Start(c, token, utils.JWTTTL()); err != nil {
+		apperror.Abort(c, apperror.Internal(err), authResource)
+		return
+	}
 
 	c.JSON(http.StatusOK, dto.LoginResponse{
-		Token: token, // 필요 없다면 바디에서 제거해도 됨
-		Employee: dto.EmployeeResponse{
-			EmployeeID: emp.EmployeeID,
-			Position:   emp.Position,
-			IsActive:   emp.IsActive,
-		},
+		Token:    token,
+		Employee: *emp,
 	})
 }
 
+// @Summary      로그아웃
+// @Description  세션 쿠키와 CSRF 쿠키를 삭제합니다. Bearer 토큰은 클라이언트에서 폐기해야 합니다.
+// @Tags         auth
+// @Success      204  "No Content"
+// @Router       /api/auth/logout [post]
+func (h *AuthHandler) Logout(c *gin.Context) {
+	h.session.End(c)
+	c.Status(http.StatusNoContent)
+}
+
 // @Summary      내 정보 조회
-// @Description  JWT 토큰을 Authorization 헤더 또는 HttpOnly 쿠키(token)로 전달하여 로그인한 직원의 정보를 반환합니다.
+// @Description  JWT 토큰을 Authorization 헤더 또는 세션 쿠키(token)로 전달하여 로그인한 직원의 정보를 반환합니다.
 // @Tags         auth
 // @Produce      json
 // @Success      200  {object}  dto.EmployeeResponse
-// @Failure      401  {object}  dto.ErrorResponse
+// @Failure      401  {object}  dto.Problem
+// @Failure      403  {object}  dto.Problem
 // @Security     ApiKeyAuth
 // @Router       /api/auth/me [get]
 func (h *AuthHandler) Me(c *gin.Context) {
-	authHeader := c.GetHeader("Authorization")
-	log.Println("Authorization:", authHeader)
-
-	var tokenStr string
-
-	if len(authHeader) >= 8 && authHeader[:7] == "Bearer " {
-		tokenStr = authHeader[7:]
-	} else {
-		// 쿠키에서 토큰 시도
-		cookie, err := c.Cookie("token")
-		log.Println("token:", cookie)
-
-		if err != nil || cookie == "" {
-			c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "Missing or invalid token"})
-			return
-		}
-		tokenStr = cookie
+	employeeID, ok := middleware.EmployeeID(c)
+	if !ok {
+		apperror.Abort(c, apperror.ErrInvalidToken.WithDetail("invalid token claims"), authResource)
+		return
 	}
-
-	claims, err := utils.ParseJWT(tokenStr)
+	emp, err := h.service.Me(c.Request.Context(), employeeID)
 	if err != nil {
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "Invalid token"})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
-	employeeID, ok := claims["employee_id"].(float64)
+	c.JSON(http.StatusOK, emp)
+}
+
+// @Summary      비밀번호 변경
+// @Description  현재 비밀번호를 확인한 뒤 로그인한 직원의 비밀번호를 변경합니다. 기존에 발급된 토큰과 세션은 모두 무효화되므로 다시 로그인해야 합니다.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        password  body  dto.ChangePasswordRequest  true  "현재 비밀번호와 새 비밀번호"
+// @Success      204  "No Content"
+// @Failure      400  {object}  dto.Problem "현재 비밀번호 불일치 또는 비밀번호 정책 위반"
+// @Failure      401  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/auth/password [post]
+func (h *AuthHandler) ChangePassword(c *gin.Context) {
+	employeeID, ok := middleware.EmployeeID(c)
 	if !ok {
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "Invalid token claims"})
+		apperror.Abort(c, apperror.ErrInvalidToken.WithDetail("invalid token claims"), authResource)
 		return
 	}
-	var emp models.Employee
-	if err := h.service.DB().Where("employee_id = ?", int(employeeID)).First(&emp).Error; err != nil {
-		c.JSON(http.StatusUnauthorized, dto.ErrorResponse{Error: "User not found"})
+	var req dto.ChangePasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Abort(c, err, authResource)
 		return
 	}
-	c.JSON(http.StatusOK, dto.EmployeeResponse{
-		EmployeeID: emp.EmployeeID,
-		Position:   emp.Position,
-		IsActive:   emp.IsActive,
-	})
+	if err := h.service.ChangePassword(c.Request.Context(), employeeID, req); err != nil {
+		apperror.Abort(c, err, employeeResource)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      비밀번호 재설정
+// @Description  관리자가 발급한 일회용 토큰으로 비밀번호를 재설정합니다. 성공 시 계정 잠금이 해제되고 기존 토큰과 세션은 무효화됩니다.
+// @Tags         auth
+// @Accept       json
+// @Produce      json
+// @Param        reset  body  dto.ResetPasswordRequest  true  "재설정 토큰과 새 비밀번호"
+// @Success      204  "No Content"
+// @Failure      400  {object}  dto.Problem "토큰이 유효하지 않거나 비밀번호 정책 위반"
+// @Failure      429  {object}  dto.Problem
+// @Router       /api/auth/password/reset [post]
+func (h *AuthHandler) ResetPassword(c *gin.Context) {
+	var req dto.ResetPasswordRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Abort(c, err, authResource)
+		return
+	}
+	if err := h.service.ResetPassword(c.Request.Context(), req); err != nil {
+		apperror.Abort(c, err, authResource)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// @Summary      비밀번호 재설정 토큰 발급
+// @Description  직원의 일회용 비밀번호 재설정 토큰을 발급합니다. 토큰은 응답에서 한 번만 노출되며, 기존 미사용 토큰은 무효화됩니다.
+// @Tags         employee
+// @Produce      json
+// @Param        id   path      int  true  "직원 ID"
+// @Success      201  {object}  dto.PasswordResetTokenResponse
+// @Failure      400  {object}  dto.Problem
+// @Failure      403  {object}  dto.Problem
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/employee/{id}/password-reset [post]
+func (h *AuthHandler) IssueResetToken(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(employeeResource), employeeResource)
+		return
+	}
+	issuedBy, _ := middleware.EmployeeID(c)
+	res, err := h.service.IssueResetToken(c.Request.Context(), id, issuedBy)
+	if err != nil {
+		apperror.Abort(c, err, employeeResource)
+		return
+	}
+	c.JSON(http.StatusCreated, res)
 }
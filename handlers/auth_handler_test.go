@@ -0,0 +1,116 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/utils"
+	"github.com/gin-gonic/gin"
+)
+
+const testPassword = "correct-horse"
+
+// newAuthRouter 직원 1(관리직, admin)과 비활성화된 직원 2(운송직)가 있는 라우터
+func newAuthRouter(t *testing.T) (http.Handler, *service.AuthService) {
+	hash, err := utils.HashPassword(testPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Employee{Password: hash, Position: "관리직", IsActive: true, Name: "Kim", Username: ptr("admin")},
+		&models.Employee{Password: hash, Position: "운송직", IsActive: false, Name: "Lee"})
+	cfg := config.Default().Auth
+	svc := service.NewAuthService(store, service.NewEmployeeStatusCache(store, 0), cfg)
+	h := NewAuthHandler(svc, middleware.NewSession(cfg.Session))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/auth/login", h.Login)
+		r.POST("/api/auth/logout", h.Logout)
+		r.GET("/api/auth/me", h.Me)
+		r.POST("/api/auth/password", h.ChangePassword)
+		r.POST("/api/auth/password/reset", h.ResetPassword)
+		r.POST("/api/employee/:id/password-reset", h.IssueResetToken)
+	}), svc
+}
+
+func TestAuthHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "login by id", method: http.MethodPost, path: "/api/auth/login",
+			body: map[string]any{"employee_id": 1, "password": testPassword}, status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				res := decode[dto.LoginResponse](t, body)
+				if res.Token == "" || res.Employee.EmployeeID != 1 {
+					t.Errorf("login = %+v", res)
+				}
+				if claims, err := utils.ParseJWT(res.Token); err != nil || claims["employee_id"] != float64(1) || claims["position"] != "관리직" {
+					t.Errorf("token claims = %v (err %v)", claims, err)
+				}
+			}},
+		{name: "login by username", method: http.MethodPost, path: "/api/auth/login",
+			body: map[string]any{"username": "admin", "password": testPassword}, status: http.StatusOK},
+		{name: "login missing identifier", method: http.MethodPost, path: "/api/auth/login",
+			body: map[string]any{"password": testPassword}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "login wrong password", method: http.MethodPost, path: "/api/auth/login",
+			body: map[string]any{"employee_id": 1, "password": "wrong-password"}, status: http.StatusUnauthorized, code: "INVALID_CREDENTIALS"},
+		{name: "login deactivated", method: http.MethodPost, path: "/api/auth/login",
+			body: map[string]any{"employee_id": 2, "password": testPassword}, status: http.StatusForbidden, code: "ACCOUNT_DISABLED"},
+		{name: "logout", method: http.MethodPost, path: "/api/auth/logout", status: http.StatusNoContent},
+		{name: "me", method: http.MethodGet, path: "/api/auth/me", employee: "1:관리직", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if e := decode[dto.EmployeeResponse](t, body); e.Name != "Kim" {
+					t.Errorf("me = %+v", e)
+				}
+			}},
+		{name: "me without claims", method: http.MethodGet, path: "/api/auth/me", status: http.StatusUnauthorized, code: "INVALID_TOKEN"},
+		{name: "me deleted employee", method: http.MethodGet, path: "/api/auth/me", employee: "99:관리직", status: http.StatusNotFound, code: "EMPLOYEE_NOT_FOUND"},
+		{name: "change password", method: http.MethodPost, path: "/api/auth/password", employee: "1:관리직",
+			body: map[string]any{"old_password": testPassword, "new_password": "battery-staple"}, status: http.StatusNoContent},
+		{name: "change password wrong old", method: http.MethodPost, path: "/api/auth/password", employee: "1:관리직",
+			body: map[string]any{"old_password": "wrong-password", "new_password": "battery-staple"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "change password too short", method: http.MethodPost, path: "/api/auth/password", employee: "1:관리직",
+			body: map[string]any{"old_password": testPassword, "new_password": "short"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "reset unknown token", method: http.MethodPost, path: "/api/auth/password/reset",
+			body: map[string]any{"token": "nope", "new_password": "battery-staple"}, status: http.StatusBadRequest, code: "INVALID_RESET_TOKEN"},
+		{name: "issue reset token", method: http.MethodPost, path: "/api/employee/2/password-reset", employee: "1:관리직", status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if res := decode[dto.PasswordResetTokenResponse](t, body); res.EmployeeID != 2 || res.Token == "" || !res.ExpiresAt.After(time.Now()) {
+					t.Errorf("reset token = %+v", res)
+				}
+			}},
+		{name: "issue reset token missing employee", method: http.MethodPost, path: "/api/employee/99/password-reset", employee: "1:관리직",
+			status: http.StatusNotFound, code: "EMPLOYEE_NOT_FOUND"},
+	}, func(t *testing.T) http.Handler {
+		router, _ := newAuthRouter(t)
+		return router
+	})
+}
+
+func TestAuthHandler_ResetPasswordFlow(t *testing.T) {
+	router, svc := newAuthRouter(t)
+	res, err := svc.IssueResetToken(context.Background(), 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, tc := range []httpCase{
+		{name: "reset", method: http.MethodPost, path: "/api/auth/password/reset",
+			body: map[string]any{"token": res.Token, "new_password": "battery-staple"}, status: http.StatusNoContent},
+		{name: "token already used", method: http.MethodPost, path: "/api/auth/password/reset",
+			body: map[string]any{"token": res.Token, "new_password": "another-one"}, status: http.StatusBadRequest, code: "INVALID_RESET_TOKEN"},
+		{name: "old password rejected", method: http.MethodPost, path: "/api/auth/login",
+			body: map[string]any{"employee_id": 1, "password": testPassword}, status: http.StatusUnauthorized, code: "INVALID_CREDENTIALS"},
+		{name: "new password accepted", method: http.MethodPost, path: "/api/auth/login",
+			body: map[string]any{"employee_id": 1, "password": "battery-staple"}, status: http.StatusOK},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.run(t, router)
+		})
+	}
+}
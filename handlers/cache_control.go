@@ -0,0 +1,18 @@
+package handlers
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// setCacheControl 서버 캐시와 같은 시간 동안 브라우저가 응답을 재사용하도록 허용.
+// 인증 없이 누구에게나 같은 응답이지만, 변경 시 서버 캐시는 바로 비워도 프록시에 남은 응답은 지울 수 없으므로
+// 공유 캐시에는 저장하지 않음(private). ttl 이 0 이면 헤더를 붙이지 않음
+func setCacheControl(c *gin.Context, ttl time.Duration) {
+	if ttl <= 0 {
+		return
+	}
+	c.Header("Cache-Control", "private, max-age="+strconv.Itoa(int(ttl.Seconds())))
+}
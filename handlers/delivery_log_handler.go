@@ -4,12 +4,14 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/baboyiban/go-api-server/apperror"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/service"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
+const deliveryLogResource = "delivery_log"
+
 type DeliveryLogHandler struct {
 	service *service.DeliveryLogService
 }
@@ -26,18 +28,20 @@ func NewDeliveryLogHandler(s *service.DeliveryLogService) *DeliveryLogHandler {
 // @Produce      json
 // @Param        delivery_log  body      dto.CreateDeliveryLogRequest  true  "배송 로그 정보"
 // @Success      201           {object}  dto.DeliveryLogResponse
-// @Failure      400           {object}  dto.ErrorResponse
-// @Failure      500           {object}  dto.ErrorResponse
+// @Failure      400           {object}  dto.Problem
+// @Failure      409           {object}  dto.Problem
+// @Failure      422           {object}  dto.Problem
+// @Failure      500           {object}  dto.Problem
 // @Router       /api/delivery-log [post]
 func (h *DeliveryLogHandler) CreateDeliveryLog(c *gin.Context) {
 	var req dto.CreateDeliveryLogRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, deliveryLogResource)
 		return
 	}
 	log, err := h.service.CreateDeliveryLog(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to create delivery_log", Details: err.Error()})
+		apperror.Abort(c, err, deliveryLogResource)
 		return
 	}
 	c.JSON(http.StatusCreated, log)
@@ -50,22 +54,18 @@ func (h *DeliveryLogHandler) CreateDeliveryLog(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "배송 로그 trip_id"
 // @Success      200  {object}  dto.DeliveryLogResponse
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/delivery-log/{id} [get]
 func (h *DeliveryLogHandler) GetDeliveryLogByID(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid delivery_log id"})
+		apperror.Abort(c, apperror.InvalidID(deliveryLogResource), deliveryLogResource)
 		return
 	}
 	log, err := h.service.GetDeliveryLogByID(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "DeliveryLog not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to get delivery_log", Details: err.Error()})
+		apperror.Abort(c, err, deliveryLogResource)
 		return
 	}
 	c.JSON(http.StatusOK, log)
@@ -78,22 +78,18 @@ func (h *DeliveryLogHandler) GetDeliveryLogByID(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "배송 로그 trip_id"
 // @Success      204  "No Content"
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/delivery-log/{id} [delete]
 func (h *DeliveryLogHandler) DeleteDeliveryLog(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid delivery_log id"})
+		apperror.Abort(c, apperror.InvalidID(deliveryLogResource), deliveryLogResource)
 		return
 	}
 	err = h.service.DeleteDeliveryLog(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "DeliveryLog not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to delete delivery_log", Details: err.Error()})
+		apperror.Abort(c, err, deliveryLogResource)
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -108,28 +104,26 @@ func (h *DeliveryLogHandler) DeleteDeliveryLog(c *gin.Context) {
 // @Param        id           path      int                          true  "배송 로그 trip_id"
 // @Param        delivery_log body      dto.UpdateDeliveryLogRequest true  "수정할 배송 로그 정보"
 // @Success      200          {object}  dto.DeliveryLogResponse
-// @Failure      400          {object}  dto.ErrorResponse
-// @Failure      404          {object}  dto.ErrorResponse
-// @Failure      500          {object}  dto.ErrorResponse
+// @Failure      400          {object}  dto.Problem
+// @Failure      409          {object}  dto.Problem
+// @Failure      422          {object}  dto.Problem
+// @Failure      404          {object}  dto.Problem
+// @Failure      500          {object}  dto.Problem
 // @Router       /api/delivery-log/{id} [put]
 func (h *DeliveryLogHandler) UpdateDeliveryLog(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid delivery_log id"})
+		apperror.Abort(c, apperror.InvalidID(deliveryLogResource), deliveryLogResource)
 		return
 	}
 	var req dto.UpdateDeliveryLogRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, deliveryLogResource)
 		return
 	}
 	log, err := h.service.UpdateDeliveryLog(c.Request.Context(), id, req)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "DeliveryLog not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to update delivery_log", Details: err.Error()})
+		apperror.Abort(c, err, deliveryLogResource)
 		return
 	}
 	c.JSON(http.StatusOK, log)
@@ -147,7 +141,7 @@ func (h *DeliveryLogHandler) ListDeliveryLogs(c *gin.Context) {
 	sortParam := c.Query("sort")
 	logs, err := h.service.ListDeliveryLogs(c.Request.Context(), sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to list delivery_log", Details: err.Error()})
+		apperror.Abort(c, err, deliveryLogResource)
 		return
 	}
 	c.JSON(http.StatusOK, logs)
@@ -185,7 +179,7 @@ func (h *DeliveryLogHandler) SearchDeliveryLogs(c *gin.Context) {
 	sortParam := c.Query("sort")
 	logs, err := h.service.SearchDeliveryLogs(c.Request.Context(), params, sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to search delivery_log", Details: err.Error()})
+		apperror.Abort(c, err, deliveryLogResource)
 		return
 	}
 	c.JSON(http.StatusOK, logs)
@@ -0,0 +1,85 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newDeliveryLogRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul"},
+		&models.Package{PackageType: "box", RegionID: "R01"},
+		&models.Package{PackageType: "bag", RegionID: "R01"},
+		&models.Vehicle{VehicleID: "A01"},
+		&models.TripLog{VehicleID: "A01", Status: "운행중"},
+		&models.TripLog{VehicleID: "A01", Status: "비운행중"},
+		&models.DeliveryLog{TripID: 1, PackageID: 1, RegionID: "R01", LoadOrder: 1})
+	h := NewDeliveryLogHandler(service.NewDeliveryLogService(store))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/delivery-log", h.CreateDeliveryLog)
+		r.GET("/api/delivery-log/:id", h.GetDeliveryLogByID)
+		r.PUT("/api/delivery-log/:id", h.UpdateDeliveryLog)
+		r.DELETE("/api/delivery-log/:id", h.DeleteDeliveryLog)
+		r.GET("/api/delivery-log", h.ListDeliveryLogs)
+		r.GET("/api/delivery-log/search", h.SearchDeliveryLogs)
+	})
+}
+
+func deliveryPackageIDs(want ...int) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		logs := decode[[]dto.DeliveryLogResponse](t, body)
+		if len(logs) != len(want) {
+			t.Fatalf("delivery logs = %+v, want packages %v", logs, want)
+		}
+		for i, log := range logs {
+			if log.PackageID != want[i] {
+				t.Fatalf("delivery logs = %+v, want packages %v", logs, want)
+			}
+		}
+	}
+}
+
+func TestDeliveryLogHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "create", method: http.MethodPost, path: "/api/delivery-log",
+			body: map[string]any{"trip_id": 2, "package_id": 2, "region_id": "R01", "load_order": 1}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if log := decode[dto.DeliveryLogResponse](t, body); log.TripID != 2 || log.RegisteredAt == nil {
+					t.Errorf("delivery log = %+v", log)
+				}
+			}},
+		{name: "create missing trip", method: http.MethodPost, path: "/api/delivery-log",
+			body: map[string]any{"package_id": 2, "region_id": "R01"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create unknown package", method: http.MethodPost, path: "/api/delivery-log",
+			body: map[string]any{"trip_id": 2, "package_id": 99, "region_id": "R01"}, status: http.StatusUnprocessableEntity, code: "INVALID_DELIVERY_LOG_REFERENCE"},
+		{name: "get", method: http.MethodGet, path: "/api/delivery-log/1", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if log := decode[dto.DeliveryLogResponse](t, body); log.PackageID != 1 || log.LoadOrder != 1 {
+					t.Errorf("delivery log = %+v", log)
+				}
+			}},
+		{name: "get invalid id", method: http.MethodGet, path: "/api/delivery-log/x", status: http.StatusBadRequest, code: "INVALID_DELIVERY_LOG_ID"},
+		{name: "get missing", method: http.MethodGet, path: "/api/delivery-log/2", status: http.StatusNotFound, code: "DELIVERY_LOG_NOT_FOUND"},
+		{name: "update", method: http.MethodPut, path: "/api/delivery-log/1",
+			body: map[string]any{"load_order": 3, "completed_at": "2026-03-02T18:00:00Z"}, status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if log := decode[dto.DeliveryLogResponse](t, body); log.LoadOrder != 3 || log.CompletedAt == nil {
+					t.Errorf("delivery log = %+v", log)
+				}
+			}},
+		{name: "update missing", method: http.MethodPut, path: "/api/delivery-log/2",
+			body: map[string]any{"load_order": 3}, status: http.StatusNotFound, code: "DELIVERY_LOG_NOT_FOUND"},
+		{name: "delete", method: http.MethodDelete, path: "/api/delivery-log/1", status: http.StatusNoContent},
+		{name: "delete missing", method: http.MethodDelete, path: "/api/delivery-log/2", status: http.StatusNotFound, code: "DELIVERY_LOG_NOT_FOUND"},
+		{name: "list", method: http.MethodGet, path: "/api/delivery-log", status: http.StatusOK, check: deliveryPackageIDs(1)},
+		{name: "search trip", method: http.MethodGet, path: "/api/delivery-log/search?trip_id=2", status: http.StatusOK, check: deliveryPackageIDs()},
+		{name: "search region", method: http.MethodGet, path: "/api/delivery-log/search?region_id=R01", status: http.StatusOK, check: deliveryPackageIDs(1)},
+	}, newDeliveryLogRouter)
+}
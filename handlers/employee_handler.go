@@ -4,12 +4,15 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/baboyiban/go-api-server/apperror"
 	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/middleware"
 	"github.com/baboyiban/go-api-server/service"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
+const employeeResource = "employee"
+
 type EmployeeHandler struct {
 	service *service.EmployeeService
 }
@@ -26,18 +29,20 @@ func NewEmployeeHandler(s *service.EmployeeService) *EmployeeHandler {
 // @Produce      json
 // @Param        employee  body      dto.CreateEmployeeRequest  true  "직원 정보"
 // @Success      201       {object}  dto.EmployeeResponse
-// @Failure      400       {object}  dto.ErrorResponse
-// @Failure      500       {object}  dto.ErrorResponse
+// @Failure      400       {object}  dto.Problem
+// @Failure      409       {object}  dto.Problem
+// @Failure      422       {object}  dto.Problem
+// @Failure      500       {object}  dto.Problem
 // @Router       /api/employee [post]
 func (h *EmployeeHandler) CreateEmployee(c *gin.Context) {
 	var req dto.CreateEmployeeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
 	emp, err := h.service.CreateEmployee(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to create employee", Details: err.Error()})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
 	c.JSON(http.StatusCreated, emp)
@@ -50,22 +55,18 @@ func (h *EmployeeHandler) CreateEmployee(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "직원 ID"
 // @Success      200  {object}  dto.EmployeeResponse
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/employee/{id} [get]
 func (h *EmployeeHandler) GetEmployeeByID(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid employee id"})
+		apperror.Abort(c, apperror.InvalidID(employeeResource), employeeResource)
 		return
 	}
 	emp, err := h.service.GetEmployeeByID(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Employee not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to get employee", Details: err.Error()})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
 	c.JSON(http.StatusOK, emp)
@@ -78,22 +79,18 @@ func (h *EmployeeHandler) GetEmployeeByID(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "직원 ID"
 // @Success      204  "No Content"
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/employee/{id} [delete]
 func (h *EmployeeHandler) DeleteEmployee(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid employee id"})
+		apperror.Abort(c, apperror.InvalidID(employeeResource), employeeResource)
 		return
 	}
 	err = h.service.DeleteEmployee(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Employee not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to delete employee", Details: err.Error()})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -108,28 +105,27 @@ func (h *EmployeeHandler) DeleteEmployee(c *gin.Context) {
 // @Param        id       path      int                      true  "직원 ID"
 // @Param        employee body      dto.UpdateEmployeeRequest true  "수정할 직원 정보"
 // @Success      200      {object}  dto.EmployeeResponse
-// @Failure      400      {object}  dto.ErrorResponse
-// @Failure      404      {object}  dto.ErrorResponse
-// @Failure      500      {object}  dto.ErrorResponse
+// @Failure      400      {object}  dto.Problem
+// @Failure      409      {object}  dto.Problem "중복된 값이거나 본인 계정을 비활성화하려는 경우"
+// @Failure      422      {object}  dto.Problem
+// @Failure      404      {object}  dto.Problem
+// @Failure      500      {object}  dto.Problem
 // @Router       /api/employee/{id} [put]
 func (h *EmployeeHandler) UpdateEmployee(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid employee id"})
+		apperror.Abort(c, apperror.InvalidID(employeeResource), employeeResource)
 		return
 	}
 	var req dto.UpdateEmployeeRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
-		return
-	}
-	emp, err := h.service.UpdateEmployee(c.Request.Context(), id, req)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Employee not found"})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
+	actorID, _ := middleware.EmployeeID(c)
+	emp, err := h.service.UpdateEmployee(c.Request.Context(), id, actorID, req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to update employee", Details: err.Error()})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
 	c.JSON(http.StatusOK, emp)
@@ -140,14 +136,14 @@ func (h *EmployeeHandler) UpdateEmployee(c *gin.Context) {
 // @Description  모든 직원 정보를 반환합니다.
 // @Tags         employee
 // @Produce      json
-// @Param        sort  query     string  false  "정렬 필드 (예: -employee_id, -position 등)"
+// @Param        sort  query     string  false  "정렬 필드 (예: -employee_id, name, -hire_date 등)"
 // @Success      200   {array}   dto.EmployeeResponse
 // @Router       /api/employee [get]
 func (h *EmployeeHandler) ListEmployees(c *gin.Context) {
 	sortParam := c.Query("sort")
 	emps, err := h.service.ListEmployees(c.Request.Context(), sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to list employees", Details: err.Error()})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
 	c.JSON(http.StatusOK, emps)
@@ -159,15 +155,17 @@ func (h *EmployeeHandler) ListEmployees(c *gin.Context) {
 // @Tags         employee
 // @Produce      json
 // @Param        employee_id  query     int     false  "직원 ID"
+// @Param        name         query     string  false  "이름 (부분 일치)"
+// @Param        username     query     string  false  "로그인 아이디"
 // @Param        position     query     string  false  "직책"
 // @Param        is_active    query     bool    false  "활성 여부"
-// @Param        sort         query     string  false  "정렬 필드 (예: -employee_id, -position 등)"
+// @Param        sort         query     string  false  "정렬 필드 (예: -employee_id, name, -hire_date 등)"
 // @Success      200  {array}   dto.EmployeeResponse
-// @Failure      400  {object}  dto.ErrorResponse
+// @Failure      400  {object}  dto.Problem
 // @Router       /api/employee/search [get]
 func (h *EmployeeHandler) SearchEmployees(c *gin.Context) {
 	params := map[string]string{}
-	for _, key := range []string{"employee_id", "position", "is_active"} {
+	for _, key := range []string{"employee_id", "name", "username", "position", "is_active"} {
 		if v := c.Query(key); v != "" {
 			params[key] = v
 		}
@@ -175,8 +173,54 @@ func (h *EmployeeHandler) SearchEmployees(c *gin.Context) {
 	sortParam := c.Query("sort")
 	emps, err := h.service.SearchEmployees(c.Request.Context(), params, sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to search employees", Details: err.Error()})
+		apperror.Abort(c, err, employeeResource)
 		return
 	}
 	c.JSON(http.StatusOK, emps)
 }
+
+// DeactivateEmployee godoc
+// @Summary      직원 비활성화
+// @Description  직원 계정을 비활성화합니다. 이미 발급된 토큰으로도 더 이상 요청할 수 없습니다.
+// @Tags         employee
+// @Produce      json
+// @Param        id   path      int  true  "직원 ID"
+// @Success      200  {object}  dto.EmployeeResponse
+// @Failure      400  {object}  dto.Problem
+// @Failure      404  {object}  dto.Problem
+// @Failure      409  {object}  dto.Problem "본인 계정은 비활성화할 수 없음"
+// @Security     ApiKeyAuth
+// @Router       /api/employee/{id}/deactivate [post]
+func (h *EmployeeHandler) DeactivateEmployee(c *gin.Context) {
+	h.setActive(c, false)
+}
+
+// ReactivateEmployee godoc
+// @Summary      직원 재활성화
+// @Description  비활성화된 직원 계정을 다시 활성화합니다.
+// @Tags         employee
+// @Produce      json
+// @Param        id   path      int  true  "직원 ID"
+// @Success      200  {object}  dto.EmployeeResponse
+// @Failure      400  {object}  dto.Problem
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/employee/{id}/reactivate [post]
+func (h *EmployeeHandler) ReactivateEmployee(c *gin.Context) {
+	h.setActive(c, true)
+}
+
+func (h *EmployeeHandler) setActive(c *gin.Context, active bool) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(employeeResource), employeeResource)
+		return
+	}
+	actorID, _ := middleware.EmployeeID(c)
+	emp, err := h.service.SetActive(c.Request.Context(), id, actorID, active)
+	if err != nil {
+		apperror.Abort(c, err, employeeResource)
+		return
+	}
+	c.JSON(http.StatusOK, emp)
+}
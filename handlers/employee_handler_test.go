@@ -0,0 +1,108 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newEmployeeRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Vehicle{VehicleID: "A01"},
+		&models.Employee{Password: "x", Position: "관리직", IsActive: true, Name: "Kim", Username: ptr("admin")},
+		&models.Employee{Password: "x", Position: "운송직", IsActive: true, Name: "Lee", AssignedVehicleID: ptr("A01")},
+		&models.Employee{Password: "x", Position: "운송직", IsActive: false, Name: "Park"})
+	h := NewEmployeeHandler(service.NewEmployeeService(store, service.NewEmployeeStatusCache(store, 0)))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/employee", h.CreateEmployee)
+		r.GET("/api/employee/:id", h.GetEmployeeByID)
+		r.PUT("/api/employee/:id", h.UpdateEmployee)
+		r.DELETE("/api/employee/:id", h.DeleteEmployee)
+		r.GET("/api/employee", h.ListEmployees)
+		r.GET("/api/employee/search", h.SearchEmployees)
+		r.POST("/api/employee/:id/deactivate", h.DeactivateEmployee)
+		r.POST("/api/employee/:id/reactivate", h.ReactivateEmployee)
+	})
+}
+
+func employeeNames(want ...string) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		emps := decode[[]dto.EmployeeResponse](t, body)
+		if len(emps) != len(want) {
+			t.Fatalf("employees = %+v, want %v", emps, want)
+		}
+		for i, e := range emps {
+			if e.Name != want[i] {
+				t.Fatalf("employees = %+v, want %v", emps, want)
+			}
+		}
+	}
+}
+
+func employeeActive(want bool) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		if e := decode[dto.EmployeeResponse](t, body); e.IsActive != want {
+			t.Errorf("employee = %+v, want is_active %v", e, want)
+		}
+	}
+}
+
+func TestEmployeeHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "create", method: http.MethodPost, path: "/api/employee",
+			body: map[string]any{"password": "long-enough", "position": "운송직", "name": "Choi", "hire_date": "2026-03-02"}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				e := decode[dto.EmployeeResponse](t, body)
+				if e.EmployeeID != 4 || !e.IsActive || e.HireDate == nil || *e.HireDate != "2026-03-02" {
+					t.Errorf("employee = %+v", e)
+				}
+			}},
+		{name: "create invalid position", method: http.MethodPost, path: "/api/employee",
+			body: map[string]any{"password": "long-enough", "position": "사장", "name": "Choi"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create weak password", method: http.MethodPost, path: "/api/employee",
+			body: map[string]any{"password": "short", "position": "운송직", "name": "Choi"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create with assigned vehicle", method: http.MethodPost, path: "/api/employee",
+			body: map[string]any{"password": "long-enough", "position": "운송직", "name": "Choi", "assigned_vehicle_id": "A01"}, status: http.StatusConflict, code: "DUPLICATE_EMPLOYEE"},
+		{name: "create duplicate username", method: http.MethodPost, path: "/api/employee",
+			body: map[string]any{"password": "long-enough", "position": "운송직", "name": "Choi", "username": "admin"}, status: http.StatusConflict, code: "DUPLICATE_EMPLOYEE"},
+		{name: "get hides password", method: http.MethodGet, path: "/api/employee/1", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if m := decode[map[string]any](t, body); m["password"] != nil || m["name"] != "Kim" {
+					t.Errorf("employee = %v", m)
+				}
+			}},
+		{name: "get invalid id", method: http.MethodGet, path: "/api/employee/me", status: http.StatusBadRequest, code: "INVALID_EMPLOYEE_ID"},
+		{name: "get missing", method: http.MethodGet, path: "/api/employee/99", status: http.StatusNotFound, code: "EMPLOYEE_NOT_FOUND"},
+		{name: "update", method: http.MethodPut, path: "/api/employee/2",
+			body: map[string]any{"name": "Lee Jr", "assigned_vehicle_id": "A01"}, status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				e := decode[dto.EmployeeResponse](t, body)
+				if e.Name != "Lee Jr" || e.AssignedVehicleID == nil || *e.AssignedVehicleID != "A01" {
+					t.Errorf("employee = %+v", e)
+				}
+			}},
+		{name: "update vehicle assigned to another", method: http.MethodPut, path: "/api/employee/3",
+			body: map[string]any{"assigned_vehicle_id": "A01"}, status: http.StatusConflict, code: "DUPLICATE_EMPLOYEE"},
+		{name: "update unknown vehicle", method: http.MethodPut, path: "/api/employee/2",
+			body: map[string]any{"assigned_vehicle_id": "Z99"}, status: http.StatusUnprocessableEntity, code: "INVALID_EMPLOYEE_REFERENCE"},
+		{name: "update self deactivation", method: http.MethodPut, path: "/api/employee/1", employee: "1:관리직",
+			body: map[string]any{"is_active": false}, status: http.StatusConflict, code: "CANNOT_DEACTIVATE_SELF"},
+		{name: "update missing", method: http.MethodPut, path: "/api/employee/99",
+			body: map[string]any{"name": "Nobody"}, status: http.StatusNotFound, code: "EMPLOYEE_NOT_FOUND"},
+		{name: "delete", method: http.MethodDelete, path: "/api/employee/3", status: http.StatusNoContent},
+		{name: "delete missing", method: http.MethodDelete, path: "/api/employee/99", status: http.StatusNotFound, code: "EMPLOYEE_NOT_FOUND"},
+		{name: "list sorted", method: http.MethodGet, path: "/api/employee?sort=-name", status: http.StatusOK, check: employeeNames("Park", "Lee", "Kim")},
+		{name: "search inactive", method: http.MethodGet, path: "/api/employee/search?is_active=false", status: http.StatusOK, check: employeeNames("Park")},
+		{name: "search position", method: http.MethodGet, path: "/api/employee/search?position=운송직&sort=name", status: http.StatusOK, check: employeeNames("Lee", "Park")},
+		{name: "deactivate", method: http.MethodPost, path: "/api/employee/2/deactivate", employee: "1:관리직", status: http.StatusOK, check: employeeActive(false)},
+		{name: "deactivate self", method: http.MethodPost, path: "/api/employee/1/deactivate", employee: "1:관리직", status: http.StatusConflict, code: "CANNOT_DEACTIVATE_SELF"},
+		{name: "deactivate missing", method: http.MethodPost, path: "/api/employee/99/deactivate", employee: "1:관리직", status: http.StatusNotFound, code: "EMPLOYEE_NOT_FOUND"},
+		{name: "reactivate", method: http.MethodPost, path: "/api/employee/3/reactivate", employee: "1:관리직", status: http.StatusOK, check: employeeActive(true)},
+	}, newEmployeeRouter)
+}
@@ -0,0 +1,54 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/graphqlapi"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+const graphqlResource = "graphql"
+
+type GraphQLHandler struct {
+	server *graphqlapi.Server
+}
+
+func NewGraphQLHandler(server *graphqlapi.Server) *GraphQLHandler {
+	return &GraphQLHandler{server: server}
+}
+
+// Query godoc
+// @Summary      GraphQL 쿼리 실행
+// @Description  대시보드용 GraphQL 엔드포인트입니다. 지역, 택배, 차량, 운행 기록, 배송 기록과 그 관계를 한 번에 조회합니다. 목록은 REST 검색과 같은 필터, 정렬, limit/offset 페이지를 지원합니다. 직원 정보(TripLog.driver)는 관리직만 조회할 수 있습니다. 필드 에러는 200 응답의 errors 에 REST 와 같은 code 로 담깁니다. 구독은 같은 경로의 WebSocket(graphql-transport-ws)을 사용합니다.
+// @Tags         graphql
+// @Accept       json
+// @Produce      json
+// @Param        request  body      dto.GraphQLRequest  true  "쿼리와 변수"
+// @Success      200      {object}  dto.GraphQLResponse
+// @Failure      400      {object}  dto.Problem
+// @Failure      401      {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /graphql [post]
+func (h *GraphQLHandler) Query(c *gin.Context) {
+	var req dto.GraphQLRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Abort(c, err, graphqlResource)
+		return
+	}
+	employeeID, _ := middleware.EmployeeID(c)
+	ctx := graphqlapi.WithViewer(c.Request.Context(), employeeID, c.GetString("position"))
+	c.JSON(http.StatusOK, h.server.Exec(ctx, req))
+}
+
+// Subscribe godoc
+// @Summary      GraphQL 구독 (WebSocket)
+// @Description  graphql-transport-ws 하위 프로토콜로 연결합니다. 토큰은 Authorization 헤더 또는 connection_init payload 의 authorization("Bearer <token>")으로 전달합니다. packageChanged, vehicleChanged 구독은 현재 상태를 먼저 보내고 변경될 때마다 다시 보냅니다.
+// @Tags         graphql
+// @Success      101
+// @Router       /graphql [get]
+func (h *GraphQLHandler) Subscribe(c *gin.Context) {
+	h.server.ServeWebSocket(c.Writer, c.Request)
+}
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/graphqlapi"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newGraphQLRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul", MaxCapacity: 3},
+		&models.Package{PackageType: "box", RegionID: "R01"},
+		&models.Vehicle{VehicleID: "A01", MaxLoad: 5},
+		&models.Employee{Name: "Lee", Password: "x", Position: "운송직", IsActive: true},
+		&models.TripLog{VehicleID: "A01", DriverID: ptr(1), Status: "운행중"})
+	status := service.NewEmployeeStatusCache(store, 0)
+	auth := middleware.NewAuthenticator(status, middleware.NewSession(config.Default().Auth.Session))
+	server, err := graphqlapi.NewServer(config.Default().GraphQL, nil, store, outbox.NewBus(), auth, status, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	h := NewGraphQLHandler(server)
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/graphql", h.Query)
+		r.GET("/graphql", h.Subscribe)
+	})
+}
+
+// graphqlResult 응답의 data 는 JSON 그대로(필드는 쿼리 순서), 에러는 code 목록으로 비교
+func graphqlResult(wantData string, wantCodes ...string) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		res := decode[struct {
+			Data   json.RawMessage    `json:"data"`
+			Errors []dto.GraphQLError `json:"errors"`
+		}](t, body)
+		if string(res.Data) != wantData {
+			t.Errorf("data = %s, want %s", res.Data, wantData)
+		}
+		var codes []string
+		for _, e := range res.Errors {
+			code, _ := e.Extensions["code"].(string)
+			codes = append(codes, code)
+		}
+		if len(codes) != len(wantCodes) {
+			t.Fatalf("error codes = %v, want %v", codes, wantCodes)
+		}
+		for i := range codes {
+			if codes[i] != wantCodes[i] {
+				t.Errorf("error codes = %v, want %v", codes, wantCodes)
+			}
+		}
+	}
+}
+
+func TestGraphQLHandler(t *testing.T) {
+	driverQuery := map[string]any{"query": `{ tripLog(tripId: 1) { vehicleId driver { name } } }`}
+	runCases(t, []httpCase{
+		{name: "query", method: http.MethodPost, path: "/graphql", employee: "1:운송직",
+			body:   map[string]any{"query": `query($id: String!) { region(regionId: $id) { regionName packages { packageType } } }`, "variables": map[string]any{"id": "R01"}},
+			status: http.StatusOK, check: graphqlResult(`{"region":{"regionName":"Seoul","packages":[{"packageType":"box"}]}}`)},
+		{name: "employee field as manager", method: http.MethodPost, path: "/graphql", employee: "2:관리직",
+			body: driverQuery, status: http.StatusOK, check: graphqlResult(`{"tripLog":{"vehicleId":"A01","driver":{"name":"Lee"}}}`)},
+		// 필드 에러는 200 응답의 errors 에 REST 와 같은 code 로
+		{name: "employee field as driver", method: http.MethodPost, path: "/graphql", employee: "1:운송직",
+			body: driverQuery, status: http.StatusOK, check: graphqlResult(`{"tripLog":{"vehicleId":"A01","driver":null}}`, "FORBIDDEN")},
+		{name: "syntax error", method: http.MethodPost, path: "/graphql", employee: "1:운송직",
+			body: map[string]any{"query": `{ region(`}, status: http.StatusOK, check: graphqlResult("", "")},
+		{name: "missing query", method: http.MethodPost, path: "/graphql", employee: "1:운송직",
+			body: map[string]any{}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "malformed body", method: http.MethodPost, path: "/graphql", employee: "1:운송직",
+			body: `{"query":`, status: http.StatusBadRequest, code: "MALFORMED_REQUEST"},
+		// WebSocket 업그레이드가 아닌 GET 은 거부
+		{name: "subscribe without upgrade", method: http.MethodGet, path: "/graphql", status: http.StatusBadRequest},
+	}, newGraphQLRouter)
+}
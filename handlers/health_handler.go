@@ -0,0 +1,99 @@
+package handlers
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/baboyiban/go-api-server/buildinfo"
+	"github.com/baboyiban/go-api-server/database"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/shutdown"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+// readinessTimeout DB 점검에 허용하는 최대 시간
+const readinessTimeout = 2 * time.Second
+
+// HealthHandler handles liveness, readiness and build info endpoints
+type HealthHandler struct {
+	db *gorm.DB
+}
+
+// NewHealthHandler creates a new HealthHandler
+func NewHealthHandler(db *gorm.DB) *HealthHandler {
+	return &HealthHandler{db: db}
+}
+
+// Healthz godoc
+// @Summary      Liveness 점검
+// @Description  프로세스가 요청을 처리할 수 있는지 확인합니다. 외부 의존성은 점검하지 않습니다.
+// @Tags         health
+// @Produce      json
+// @Success      200  {object}  dto.HealthResponse
+// @Router       /healthz [get]
+func (h *HealthHandler) Healthz(c *gin.Context) {
+	c.JSON(http.StatusOK, dto.HealthResponse{Status: "ok"})
+}
+
+// Readyz godoc
+// @Summary      Readiness 점검
+// @Description  DB 연결과 마이그레이션 상태를 점검합니다. 종료 중이거나 점검에 실패하면 503을 반환합니다. 읽기 복제본이 설정되어 있으면 정상인 복제본 수(replicas)를 함께 반환하며, 복제본 상태는 결과에 영향을 주지 않습니다.
+// @Tags         health
+// @Produce      json
+// @Success      200  {object}  dto.ReadinessResponse
+// @Failure      503  {object}  dto.ReadinessResponse
+// @Router       /readyz [get]
+func (h *HealthHandler) Readyz(c *gin.Context) {
+	res := dto.ReadinessResponse{Status: "ready", Checks: map[string]string{}}
+
+	if shutdown.InProgress() {
+		res.Status = "shutting_down"
+		c.JSON(http.StatusServiceUnavailable, res)
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(c.Request.Context(), readinessTimeout)
+	defer cancel()
+
+	res.Checks["database"] = "ok"
+	sqlDB, err := h.db.DB()
+	if err == nil {
+		err = sqlDB.PingContext(ctx)
+	}
+	if err != nil {
+		res.Status = "not_ready"
+		res.Checks["database"] = "unreachable"
+		c.JSON(http.StatusServiceUnavailable, res)
+		return
+	}
+
+	// 복제본이 내려가도 주 DB 로 조회하므로 준비 상태에는 영향을 주지 않음
+	if up, total := database.ReplicaHealth(h.db); total > 0 {
+		res.Checks["replicas"] = fmt.Sprintf("%d/%d", up, total)
+	}
+
+	res.Checks["migrations"] = "ok"
+	if pending := database.PendingMigrations(h.db.WithContext(ctx)); len(pending) > 0 {
+		res.Status = "not_ready"
+		res.Checks["migrations"] = "pending"
+		res.PendingMigrations = pending
+		c.JSON(http.StatusServiceUnavailable, res)
+		return
+	}
+
+	c.JSON(http.StatusOK, res)
+}
+
+// Version godoc
+// @Summary      빌드 정보 조회
+// @Description  git 커밋, 빌드 시각, Go 버전을 반환합니다.
+// @Tags         health
+// @Produce      json
+// @Success      200  {object}  buildinfo.Info
+// @Router       /version [get]
+func (h *HealthHandler) Version(c *gin.Context) {
+	c.JSON(http.StatusOK, buildinfo.Get())
+}
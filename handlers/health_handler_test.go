@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"context"
+	"net/http"
+	"path/filepath"
+	"runtime"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/buildinfo"
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/database"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/gin-gonic/gin"
+	"gorm.io/gorm"
+)
+
+func TestHealthHandler(t *testing.T) {
+	tests := []httpCase{
+		{name: "healthz", method: http.MethodGet, path: "/healthz", status: http.StatusOK},
+		{name: "readyz", method: http.MethodGet, path: "/readyz", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				res := decode[dto.ReadinessResponse](t, body)
+				if res.Status != "ready" || res.Checks["database"] != "ok" || res.Checks["migrations"] != "ok" {
+					t.Errorf("readiness = %+v", res)
+				}
+				if _, ok := res.Checks["replicas"]; ok {
+					t.Error("replicas reported without replicas configured")
+				}
+			}},
+		{name: "version", method: http.MethodGet, path: "/version", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if info := decode[buildinfo.Info](t, body); info.GoVersion != runtime.Version() {
+					t.Errorf("info = %+v", info)
+				}
+			}},
+	}
+	runCases(t, tests, func(t *testing.T) http.Handler {
+		return newHealthRouter(newTestDB(t))
+	})
+}
+
+func TestHealthHandler_NotReady(t *testing.T) {
+	tests := []struct {
+		name    string
+		damage  func(t *testing.T, db *gorm.DB)
+		checks  map[string]string
+		pending string
+	}{
+		{"database closed", func(t *testing.T, db *gorm.DB) {
+			if err := database.Close(db); err != nil {
+				t.Fatal(err)
+			}
+		}, map[string]string{"database": "unreachable"}, ""},
+		{"table missing", func(t *testing.T, db *gorm.DB) {
+			if err := db.Migrator().DropTable("webhook_delivery"); err != nil {
+				t.Fatal(err)
+			}
+		}, map[string]string{"database": "ok", "migrations": "pending"}, "webhook_delivery"},
+		{"column missing", func(t *testing.T, db *gorm.DB) {
+			if err := db.Exec("ALTER TABLE employee DROP COLUMN token_version").Error; err != nil {
+				t.Fatal(err)
+			}
+		}, map[string]string{"database": "ok", "migrations": "pending"}, "employee.token_version"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db := newTestDB(t)
+			tt.damage(t, db)
+			httpCase{method: http.MethodGet, path: "/readyz", status: http.StatusServiceUnavailable,
+				check: func(t *testing.T, body []byte) {
+					res := decode[dto.ReadinessResponse](t, body)
+					if res.Status != "not_ready" {
+						t.Errorf("status = %s", res.Status)
+					}
+					for k, v := range tt.checks {
+						if res.Checks[k] != v {
+							t.Errorf("checks[%s] = %q, want %q", k, res.Checks[k], v)
+						}
+					}
+					if tt.pending != "" && !slices.Contains(res.PendingMigrations, tt.pending) {
+						t.Errorf("pending = %v, want %s", res.PendingMigrations, tt.pending)
+					}
+				}}.run(t, newHealthRouter(db))
+		})
+	}
+}
+
+func newHealthRouter(db *gorm.DB) http.Handler {
+	h := NewHealthHandler(db)
+	return newRouter(func(r gin.IRoutes) {
+		r.GET("/healthz", h.Healthz)
+		r.GET("/readyz", h.Readyz)
+		r.GET("/version", h.Version)
+	})
+}
+
+// newTestDB 모든 테이블을 마이그레이션한 임시 SQLite DB
+func newTestDB(t *testing.T) *gorm.DB {
+	t.Helper()
+	db, err := database.InitDB(context.Background(), config.DBConfig{
+		Driver:       config.DriverSQLite,
+		Name:         filepath.Join(t.TempDir(), "test.db"),
+		MaxOpenConns: 1,
+		AutoMigrate:  true,
+	}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = database.Close(db) })
+	return db
+}
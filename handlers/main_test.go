@@ -0,0 +1,146 @@
+package handlers
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/utils"
+	"github.com/gin-gonic/gin"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	utils.ConfigurePassword(bcrypt.MinCost, utils.PasswordPolicy{MinLength: 8})
+	utils.ConfigureJWT("test-secret-test-secret-test-secret", time.Hour)
+	os.Exit(m.Run())
+}
+
+// testEmployeeHeader 요청한 직원을 "ID:직급" 형식으로 지정. 인증 미들웨어 대신 fakeAuth 가 읽음
+const testEmployeeHeader = "X-Test-Employee"
+
+// fakeAuth 인증 미들웨어가 JWT 클레임에서 설정하는 값을 테스트 헤더로부터 설정
+func fakeAuth(c *gin.Context) {
+	id, position, ok := strings.Cut(c.GetHeader(testEmployeeHeader), ":")
+	if !ok {
+		return
+	}
+	n, _ := strconv.Atoi(id)
+	c.Set("employee_id", float64(n))
+	c.Set("position", position)
+}
+
+// newRouter fakeAuth 를 거치는 테스트용 라우터. register 로 테스트할 경로를 등록
+func newRouter(register func(r gin.IRoutes)) *gin.Engine {
+	r := gin.New()
+	register(r.Group("", fakeAuth))
+	return r
+}
+
+// httpCase 한 번의 요청과 기대하는 응답
+type httpCase struct {
+	name     string
+	method   string
+	path     string
+	body     any    // JSON 으로 보냄. string 이면 그대로 보냄
+	employee string // testEmployeeHeader 값
+	status   int
+	code     string                          // 에러 응답의 code. 비어 있으면 확인하지 않음
+	check    func(t *testing.T, body []byte) // 응답 본문 추가 검사
+}
+
+func (tc httpCase) run(t *testing.T, router http.Handler) {
+	t.Helper()
+	var body []byte
+	switch b := tc.body.(type) {
+	case nil:
+	case string:
+		body = []byte(b)
+	default:
+		body, _ = json.Marshal(b)
+	}
+	req := httptest.NewRequest(tc.method, tc.path, bytes.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	if tc.employee != "" {
+		req.Header.Set(testEmployeeHeader, tc.employee)
+	}
+	rec := httptest.NewRecorder()
+	router.ServeHTTP(rec, req)
+
+	if rec.Code != tc.status {
+		t.Fatalf("%s %s: status = %d, want %d; body %s", tc.method, tc.path, rec.Code, tc.status, rec.Body)
+	}
+	if tc.code != "" {
+		var problem dto.Problem
+		if err := json.Unmarshal(rec.Body.Bytes(), &problem); err != nil || problem.Code != tc.code {
+			t.Fatalf("%s %s: code = %q, want %q; body %s", tc.method, tc.path, problem.Code, tc.code, rec.Body)
+		}
+	}
+	if tc.check != nil {
+		tc.check(t, rec.Body.Bytes())
+	}
+}
+
+// runCases 케이스마다 새 저장소와 라우터로 요청을 보냄
+func runCases(t *testing.T, cases []httpCase, setup func(t *testing.T) http.Handler) {
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.run(t, setup(t))
+		})
+	}
+}
+
+// decode 응답 본문을 v 로 디코딩
+func decode[T any](t *testing.T, body []byte) T {
+	t.Helper()
+	var v T
+	if err := json.Unmarshal(body, &v); err != nil {
+		t.Fatalf("decode %T: %v; body %s", v, err, body)
+	}
+	return v
+}
+
+// seed 모델 값을 저장소에 미리 넣음
+func seed(t *testing.T, store repository.Store, rows ...any) {
+	t.Helper()
+	ctx := context.Background()
+	for _, row := range rows {
+		var err error
+		switch r := row.(type) {
+		case *models.Region:
+			err = store.Regions().Create(ctx, r)
+		case *models.Package:
+			err = store.Packages().Create(ctx, r)
+		case *models.Vehicle:
+			err = store.Vehicles().Create(ctx, r)
+		case *models.TripLog:
+			err = store.TripLogs().Create(ctx, r)
+		case *models.DeliveryLog:
+			err = store.DeliveryLogs().Create(ctx, r)
+		case *models.Employee:
+			err = store.Employees().Create(ctx, r)
+		case *models.Shift:
+			err = store.Shifts().Create(ctx, r)
+		default:
+			t.Fatalf("seed: unsupported type %T", row)
+		}
+		if err != nil {
+			t.Fatalf("seed %T: %v", row, err)
+		}
+	}
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -4,12 +4,14 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/baboyiban/go-api-server/apperror"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/service"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
+const packageResource = "package"
+
 type PackageHandler struct {
 	service *service.PackageService
 }
@@ -20,24 +22,26 @@ func NewPackageHandler(s *service.PackageService) *PackageHandler {
 
 // CreatePackage godoc
 // @Summary      패키지 생성
-// @Description  새로운 패키지를 생성합니다.
+// @Description  새로운 패키지를 생성합니다. 투입됨 상태는 분류기 스캔(POST /api/sorter/scan)으로만 설정할 수 있습니다 (409 SORTER_MANAGED).
 // @Tags         package
 // @Accept       json
 // @Produce      json
 // @Param        package  body      dto.CreatePackageRequest  true  "패키지 정보"
 // @Success      201      {object}  dto.PackageResponse
-// @Failure      400      {object}  dto.ErrorResponse
-// @Failure      500      {object}  dto.ErrorResponse
+// @Failure      400      {object}  dto.Problem
+// @Failure      409      {object}  dto.Problem
+// @Failure      422      {object}  dto.Problem
+// @Failure      500      {object}  dto.Problem
 // @Router       /api/package [post]
 func (h *PackageHandler) CreatePackage(c *gin.Context) {
 	var req dto.CreatePackageRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, packageResource)
 		return
 	}
 	pkg, err := h.service.CreatePackage(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to create package", Details: err.Error()})
+		apperror.Abort(c, err, packageResource)
 		return
 	}
 	c.JSON(http.StatusCreated, pkg)
@@ -50,22 +54,18 @@ func (h *PackageHandler) CreatePackage(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "패키지 ID"
 // @Success      200  {object}  dto.PackageResponse
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/package/{id} [get]
 func (h *PackageHandler) GetPackageByID(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid package id"})
+		apperror.Abort(c, apperror.InvalidID(packageResource), packageResource)
 		return
 	}
 	pkg, err := h.service.GetPackageByID(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Package not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to get package", Details: err.Error()})
+		apperror.Abort(c, err, packageResource)
 		return
 	}
 	c.JSON(http.StatusOK, pkg)
@@ -73,27 +73,23 @@ func (h *PackageHandler) GetPackageByID(c *gin.Context) {
 
 // DeletePackage godoc
 // @Summary      패키지 삭제
-// @Description  패키지 ID로 패키지를 삭제합니다.
+// @Description  패키지 ID로 패키지를 삭제합니다. 투입된 패키지면 지역 적재량을 하나 줄이고, 최대 용량 아래로 내려가면 포화 표시를 해제합니다.
 // @Tags         package
 // @Produce      json
 // @Param        id   path      int  true  "패키지 ID"
 // @Success      204  "No Content"
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/package/{id} [delete]
 func (h *PackageHandler) DeletePackage(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid package id"})
+		apperror.Abort(c, apperror.InvalidID(packageResource), packageResource)
 		return
 	}
 	err = h.service.DeletePackage(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Package not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to delete package", Details: err.Error()})
+		apperror.Abort(c, err, packageResource)
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -101,35 +97,33 @@ func (h *PackageHandler) DeletePackage(c *gin.Context) {
 
 // UpdatePackage godoc
 // @Summary      패키지 정보 수정
-// @Description  패키지 ID로 패키지 정보를 수정합니다.
+// @Description  패키지 ID로 패키지 정보를 수정합니다. 투입됨으로 바꾸거나 투입된 패키지의 지역을 바꾸는 것은 분류기 스캔만 할 수 있으므로 409 SORTER_MANAGED 를 반환합니다. 투입된 패키지를 다른 상태로 바꾸면 지역 적재량을 하나 줄이고, 최대 용량 아래로 내려가면 포화 표시를 해제합니다.
 // @Tags         package
 // @Accept       json
 // @Produce      json
 // @Param        id      path      int                     true  "패키지 ID"
 // @Param        package body      dto.UpdatePackageRequest true  "수정할 패키지 정보"
 // @Success      200     {object}  dto.PackageResponse
-// @Failure      400     {object}  dto.ErrorResponse
-// @Failure      404     {object}  dto.ErrorResponse
-// @Failure      500     {object}  dto.ErrorResponse
+// @Failure      400     {object}  dto.Problem
+// @Failure      409     {object}  dto.Problem
+// @Failure      422     {object}  dto.Problem
+// @Failure      404     {object}  dto.Problem
+// @Failure      500     {object}  dto.Problem
 // @Router       /api/package/{id} [put]
 func (h *PackageHandler) UpdatePackage(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid package id"})
+		apperror.Abort(c, apperror.InvalidID(packageResource), packageResource)
 		return
 	}
 	var req dto.UpdatePackageRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, packageResource)
 		return
 	}
 	pkg, err := h.service.UpdatePackage(c.Request.Context(), id, req)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Package not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to update package", Details: err.Error()})
+		apperror.Abort(c, err, packageResource)
 		return
 	}
 	c.JSON(http.StatusOK, pkg)
@@ -147,7 +141,7 @@ func (h *PackageHandler) ListPackages(c *gin.Context) {
 	sortParam := c.Query("sort")
 	pkgs, err := h.service.ListPackages(c.Request.Context(), sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to list packages", Details: err.Error()})
+		apperror.Abort(c, err, packageResource)
 		return
 	}
 	c.JSON(http.StatusOK, pkgs)
@@ -176,7 +170,7 @@ func (h *PackageHandler) SearchPackages(c *gin.Context) {
 	sortParam := c.Query("sort")
 	pkgs, err := h.service.SearchPackages(c.Request.Context(), params, sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to search packages", Details: err.Error()})
+		apperror.Abort(c, err, packageResource)
 		return
 	}
 	c.JSON(http.StatusOK, pkgs)
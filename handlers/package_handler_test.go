@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newPackageRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul"},
+		&models.Region{RegionID: "R02", RegionName: "Busan", IsFull: true},
+		&models.Package{PackageType: "box", RegionID: "R01"},
+		&models.Package{PackageType: "bag", RegionID: "R01", PackageStatus: "투입됨"})
+	h := NewPackageHandler(service.NewPackageService(store, nil))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/package", h.CreatePackage)
+		r.GET("/api/package/:id", h.GetPackageByID)
+		r.PUT("/api/package/:id", h.UpdatePackage)
+		r.DELETE("/api/package/:id", h.DeletePackage)
+		r.GET("/api/package", h.ListPackages)
+		r.GET("/api/package/search", h.SearchPackages)
+	})
+}
+
+func packageIDs(want ...int) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		pkgs := decode[[]models.Package](t, body)
+		if len(pkgs) != len(want) {
+			t.Fatalf("packages = %+v, want ids %v", pkgs, want)
+		}
+		for i, p := range pkgs {
+			if p.PackageID != want[i] {
+				t.Fatalf("packages = %+v, want ids %v", pkgs, want)
+			}
+		}
+	}
+}
+
+func TestPackageHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "create", method: http.MethodPost, path: "/api/package",
+			body: map[string]any{"package_type": "crate", "region_id": "R01"}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if p := decode[models.Package](t, body); p.PackageID != 3 || p.PackageStatus != "등록됨" {
+					t.Errorf("package = %+v", p)
+				}
+			}},
+		{name: "create missing type", method: http.MethodPost, path: "/api/package",
+			body: map[string]any{"region_id": "R01"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create region full", method: http.MethodPost, path: "/api/package",
+			body: map[string]any{"package_type": "crate", "region_id": "R02"}, status: http.StatusConflict, code: "REGION_FULL"},
+		{name: "create unknown region", method: http.MethodPost, path: "/api/package",
+			body: map[string]any{"package_type": "crate", "region_id": "R99"}, status: http.StatusUnprocessableEntity, code: "INVALID_PACKAGE_REFERENCE"},
+		{name: "create duplicate", method: http.MethodPost, path: "/api/package",
+			body: map[string]any{"package_type": "box", "region_id": "R01"}, status: http.StatusConflict, code: "DUPLICATE_PACKAGE"},
+		{name: "get", method: http.MethodGet, path: "/api/package/1", status: http.StatusOK},
+		{name: "get invalid id", method: http.MethodGet, path: "/api/package/abc", status: http.StatusBadRequest, code: "INVALID_PACKAGE_ID"},
+		{name: "get missing", method: http.MethodGet, path: "/api/package/99", status: http.StatusNotFound, code: "PACKAGE_NOT_FOUND"},
+		{name: "update", method: http.MethodPut, path: "/api/package/1",
+			body: map[string]any{"package_status": "완료됨"}, status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if p := decode[models.Package](t, body); p.PackageStatus != "완료됨" || p.PackageType != "box" {
+					t.Errorf("package = %+v", p)
+				}
+			}},
+		{name: "update induct without scan", method: http.MethodPut, path: "/api/package/1",
+			body: map[string]any{"package_status": "투입됨"}, status: http.StatusConflict, code: "SORTER_MANAGED"},
+		{name: "update move inducted package", method: http.MethodPut, path: "/api/package/2",
+			body: map[string]any{"region_id": "R02"}, status: http.StatusConflict, code: "SORTER_MANAGED"},
+		{name: "create inducted", method: http.MethodPost, path: "/api/package",
+			body: map[string]any{"package_type": "crate", "region_id": "R01", "package_status": "투입됨"}, status: http.StatusConflict, code: "SORTER_MANAGED"},
+		{name: "update missing", method: http.MethodPut, path: "/api/package/99",
+			body: map[string]any{"package_type": "crate"}, status: http.StatusNotFound, code: "PACKAGE_NOT_FOUND"},
+		{name: "delete", method: http.MethodDelete, path: "/api/package/2", status: http.StatusNoContent},
+		{name: "delete invalid id", method: http.MethodDelete, path: "/api/package/x", status: http.StatusBadRequest, code: "INVALID_PACKAGE_ID"},
+		{name: "list sorted", method: http.MethodGet, path: "/api/package?sort=-package_id", status: http.StatusOK, check: packageIDs(2, 1)},
+		{name: "search status", method: http.MethodGet, path: "/api/package/search?package_status=투입됨", status: http.StatusOK, check: packageIDs(2)},
+		{name: "search region", method: http.MethodGet, path: "/api/package/search?region_id=R02", status: http.StatusOK, check: packageIDs()},
+	}, newPackageRouter)
+}
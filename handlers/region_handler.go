@@ -3,12 +3,14 @@ package handlers
 import (
 	"net/http"
 
+	"github.com/baboyiban/go-api-server/apperror"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/service"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
+const regionResource = "region"
+
 type RegionHandler struct {
 	service *service.RegionService
 }
@@ -25,18 +27,20 @@ func NewRegionHandler(s *service.RegionService) *RegionHandler {
 // @Produce      json
 // @Param        region  body      dto.CreateRegionRequest  true  "지역 정보"
 // @Success      201     {object}  dto.RegionResponse
-// @Failure      400     {object}  dto.ErrorResponse
-// @Failure      500     {object}  dto.ErrorResponse
+// @Failure      400     {object}  dto.Problem
+// @Failure      409     {object}  dto.Problem
+// @Failure      422     {object}  dto.Problem
+// @Failure      500     {object}  dto.Problem
 // @Router       /api/region [post]
 func (h *RegionHandler) CreateRegion(c *gin.Context) {
 	var req dto.CreateRegionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, regionResource)
 		return
 	}
 	region, err := h.service.CreateRegion(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to create region", Details: err.Error()})
+		apperror.Abort(c, err, regionResource)
 		return
 	}
 	c.JSON(http.StatusCreated, region)
@@ -49,20 +53,18 @@ func (h *RegionHandler) CreateRegion(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      string  true  "지역 ID"
 // @Success      200  {object}  dto.RegionResponse
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Header       200  {string}  Cache-Control  "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/region/{id} [get]
 func (h *RegionHandler) GetRegionByID(c *gin.Context) {
 	id := c.Param("id")
 	region, err := h.service.GetRegionByID(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Region not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to get region", Details: err.Error()})
+		apperror.Abort(c, err, regionResource)
 		return
 	}
+	setCacheControl(c, h.service.CacheTTL())
 	c.JSON(http.StatusOK, region)
 }
 
@@ -73,18 +75,14 @@ func (h *RegionHandler) GetRegionByID(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      string  true  "지역 ID"
 // @Success      204  "No Content"
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/region/{id} [delete]
 func (h *RegionHandler) DeleteRegion(c *gin.Context) {
 	id := c.Param("id")
 	err := h.service.DeleteRegion(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Region not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to delete region", Details: err.Error()})
+		apperror.Abort(c, err, regionResource)
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -99,24 +97,22 @@ func (h *RegionHandler) DeleteRegion(c *gin.Context) {
 // @Param        id      path      string                  true  "지역 ID"
 // @Param        region  body      dto.UpdateRegionRequest true  "수정할 지역 정보"
 // @Success      200     {object}  dto.RegionResponse
-// @Failure      400     {object}  dto.ErrorResponse
-// @Failure      404     {object}  dto.ErrorResponse
-// @Failure      500     {object}  dto.ErrorResponse
+// @Failure      400     {object}  dto.Problem
+// @Failure      409     {object}  dto.Problem
+// @Failure      422     {object}  dto.Problem
+// @Failure      404     {object}  dto.Problem
+// @Failure      500     {object}  dto.Problem
 // @Router       /api/region/{id} [put]
 func (h *RegionHandler) UpdateRegion(c *gin.Context) {
 	id := c.Param("id")
 	var req dto.UpdateRegionRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, regionResource)
 		return
 	}
 	region, err := h.service.UpdateRegion(c.Request.Context(), id, req)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Region not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to update region", Details: err.Error()})
+		apperror.Abort(c, err, regionResource)
 		return
 	}
 	c.JSON(http.StatusOK, region)
@@ -129,14 +125,16 @@ func (h *RegionHandler) UpdateRegion(c *gin.Context) {
 // @Produce      json
 // @Param        sort  query     string  false  "정렬 필드 (예: -registered_at는 최신순, region_id 등)"
 // @Success      200   {array}   dto.RegionResponse
+// @Header       200  {string}  Cache-Control  "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
 // @Router       /api/region [get]
 func (h *RegionHandler) ListRegions(c *gin.Context) {
 	sortParam := c.Query("sort")
 	regions, err := h.service.ListRegions(c.Request.Context(), sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to list regions", Details: err.Error()})
+		apperror.Abort(c, err, regionResource)
 		return
 	}
+	setCacheControl(c, h.service.CacheTTL())
 	c.JSON(http.StatusOK, regions)
 }
 
@@ -155,6 +153,7 @@ func (h *RegionHandler) ListRegions(c *gin.Context) {
 // @Param        saturated_at     query     string  false  "포화 시각 (YYYY-MM-DD)"
 // @Param        sort             query     string  false  "정렬 필드 (예: -region_id, -max_capacity, -saturated_at 등)"
 // @Success      200  {array}   dto.RegionResponse
+// @Header       200  {string}  Cache-Control  "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
 // @Router       /api/region/search [get]
 func (h *RegionHandler) SearchRegions(c *gin.Context) {
 	params := map[string]string{}
@@ -166,8 +165,9 @@ func (h *RegionHandler) SearchRegions(c *gin.Context) {
 	sortParam := c.Query("sort")
 	regions, err := h.service.SearchRegions(c.Request.Context(), params, sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to search regions", Details: err.Error()})
+		apperror.Abort(c, err, regionResource)
 		return
 	}
+	setCacheControl(c, h.service.CacheTTL())
 	c.JSON(http.StatusOK, regions)
 }
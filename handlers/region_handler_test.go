@@ -0,0 +1,81 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newRegionRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul", MaxCapacity: 3},
+		&models.Region{RegionID: "R02", RegionName: "Busan", MaxCapacity: 1, IsFull: true},
+		&models.Package{PackageType: "box", RegionID: "R01"})
+	h := NewRegionHandler(service.NewRegionService(store, nil))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/region", h.CreateRegion)
+		r.GET("/api/region/:id", h.GetRegionByID)
+		r.PUT("/api/region/:id", h.UpdateRegion)
+		r.DELETE("/api/region/:id", h.DeleteRegion)
+		r.GET("/api/region", h.ListRegions)
+		r.GET("/api/region/search", h.SearchRegions)
+	})
+}
+
+func regionIDs(want ...string) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		regions := decode[[]models.Region](t, body)
+		var got []string
+		for _, r := range regions {
+			got = append(got, r.RegionID)
+		}
+		if len(got) != len(want) {
+			t.Fatalf("regions = %v, want %v", got, want)
+		}
+		for i := range got {
+			if got[i] != want[i] {
+				t.Fatalf("regions = %v, want %v", got, want)
+			}
+		}
+	}
+}
+
+func TestRegionHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "create", method: http.MethodPost, path: "/api/region",
+			body: map[string]any{"region_id": "R03", "region_name": "Daegu", "max_capacity": 2}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if r := decode[models.Region](t, body); r.RegionID != "R03" || r.MaxCapacity != 2 {
+					t.Errorf("region = %+v", r)
+				}
+			}},
+		{name: "create duplicate", method: http.MethodPost, path: "/api/region",
+			body: map[string]any{"region_id": "R01", "region_name": "Seoul"}, status: http.StatusConflict, code: "DUPLICATE_REGION"},
+		{name: "create invalid id length", method: http.MethodPost, path: "/api/region",
+			body: map[string]any{"region_id": "R1", "region_name": "Seoul"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create malformed json", method: http.MethodPost, path: "/api/region",
+			body: `{"region_id":`, status: http.StatusBadRequest, code: "MALFORMED_REQUEST"},
+		{name: "get", method: http.MethodGet, path: "/api/region/R01", status: http.StatusOK},
+		{name: "get missing", method: http.MethodGet, path: "/api/region/R99", status: http.StatusNotFound, code: "REGION_NOT_FOUND"},
+		{name: "update", method: http.MethodPut, path: "/api/region/R01",
+			body: map[string]any{"region_name": "Incheon", "is_full": true}, status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if r := decode[models.Region](t, body); r.RegionName != "Incheon" || !r.IsFull {
+					t.Errorf("region = %+v", r)
+				}
+			}},
+		{name: "update missing", method: http.MethodPut, path: "/api/region/R99",
+			body: map[string]any{"region_name": "Nowhere"}, status: http.StatusNotFound, code: "REGION_NOT_FOUND"},
+		{name: "delete", method: http.MethodDelete, path: "/api/region/R02", status: http.StatusNoContent},
+		{name: "delete in use", method: http.MethodDelete, path: "/api/region/R01", status: http.StatusConflict, code: "REGION_IN_USE"},
+		{name: "delete missing", method: http.MethodDelete, path: "/api/region/R99", status: http.StatusNotFound, code: "REGION_NOT_FOUND"},
+		{name: "list sorted", method: http.MethodGet, path: "/api/region?sort=-region_id", status: http.StatusOK, check: regionIDs("R02", "R01")},
+		{name: "search full", method: http.MethodGet, path: "/api/region/search?is_full=true", status: http.StatusOK, check: regionIDs("R02")},
+		{name: "search by name", method: http.MethodGet, path: "/api/region/search?region_name=Seoul", status: http.StatusOK, check: regionIDs("R01")},
+	}, newRegionRouter)
+}
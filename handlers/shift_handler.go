@@ -0,0 +1,199 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+const shiftResource = "shift"
+
+type ShiftHandler struct {
+	service *service.ShiftService
+}
+
+func NewShiftHandler(s *service.ShiftService) *ShiftHandler {
+	return &ShiftHandler{service: s}
+}
+
+// CreateShift godoc
+// @Summary      근무 등록
+// @Description  운송직 직원에게 차량을 배정하는 근무를 등록합니다. 같은 차량이나 같은 직원의 근무 시간이 겹치면 거부됩니다.
+// @Tags         shift
+// @Accept       json
+// @Produce      json
+// @Param        shift  body      dto.CreateShiftRequest  true  "근무 정보"
+// @Success      201    {object}  dto.ShiftResponse
+// @Failure      400    {object}  dto.Problem
+// @Failure      409    {object}  dto.Problem "근무 시간 겹침"
+// @Failure      422    {object}  dto.Problem "존재하지 않는 직원/차량 또는 운송직이 아닌 직원"
+// @Security     ApiKeyAuth
+// @Router       /api/shift [post]
+func (h *ShiftHandler) CreateShift(c *gin.Context) {
+	var req dto.CreateShiftRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Abort(c, err, shiftResource)
+		return
+	}
+	createdBy, _ := middleware.EmployeeID(c)
+	shift, err := h.service.CreateShift(c.Request.Context(), req, createdBy)
+	if err != nil {
+		apperror.Abort(c, err, shiftResource)
+		return
+	}
+	c.JSON(http.StatusCreated, shift)
+}
+
+// GetShiftByID godoc
+// @Summary      근무 단건 조회
+// @Tags         shift
+// @Produce      json
+// @Param        id   path      int  true  "근무 ID"
+// @Success      200  {object}  dto.ShiftResponse
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/shift/{id} [get]
+func (h *ShiftHandler) GetShiftByID(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(shiftResource), shiftResource)
+		return
+	}
+	shift, err := h.service.GetShiftByID(c.Request.Context(), id)
+	if err != nil {
+		apperror.Abort(c, err, shiftResource)
+		return
+	}
+	c.JSON(http.StatusOK, shift)
+}
+
+// ListShifts godoc
+// @Summary      근무 목록 조회
+// @Description  근무 목록을 최근 시작 순으로 반환합니다.
+// @Tags         shift
+// @Produce      json
+// @Param        employee_id  query     int     false  "직원 ID"
+// @Param        vehicle_id   query     string  false  "차량 ID"
+// @Param        active       query     bool    false  "현재 진행 중인 근무만"
+// @Success      200  {array}   dto.ShiftResponse
+// @Security     ApiKeyAuth
+// @Router       /api/shift [get]
+func (h *ShiftHandler) ListShifts(c *gin.Context) {
+	params := map[string]string{}
+	for _, key := range []string{"employee_id", "vehicle_id"} {
+		if v := c.Query(key); v != "" {
+			params[key] = v
+		}
+	}
+	active, _ := strconv.ParseBool(c.Query("active"))
+	shifts, err := h.service.ListShifts(c.Request.Context(), params, active)
+	if err != nil {
+		apperror.Abort(c, err, shiftResource)
+		return
+	}
+	c.JSON(http.StatusOK, shifts)
+}
+
+// EndShift godoc
+// @Summary      근무 종료
+// @Description  진행 중이거나 예정된 근무를 지금 종료합니다.
+// @Tags         shift
+// @Produce      json
+// @Param        id   path      int  true  "근무 ID"
+// @Success      200  {object}  dto.ShiftResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      409  {object}  dto.Problem "이미 종료된 근무"
+// @Security     ApiKeyAuth
+// @Router       /api/shift/{id}/end [post]
+func (h *ShiftHandler) EndShift(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(shiftResource), shiftResource)
+		return
+	}
+	shift, err := h.service.EndShift(c.Request.Context(), id)
+	if err != nil {
+		apperror.Abort(c, err, shiftResource)
+		return
+	}
+	c.JSON(http.StatusOK, shift)
+}
+
+// DeleteShift godoc
+// @Summary      근무 삭제
+// @Tags         shift
+// @Param        id   path      int  true  "근무 ID"
+// @Success      204  "No Content"
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/shift/{id} [delete]
+func (h *ShiftHandler) DeleteShift(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(shiftResource), shiftResource)
+		return
+	}
+	if err := h.service.DeleteShift(c.Request.Context(), id); err != nil {
+		apperror.Abort(c, err, shiftResource)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// MyAssignment godoc
+// @Summary      내 현재 배정 조회
+// @Description  로그인한 운송직 직원의 현재 진행 중인 근무(배정 차량)를 반환합니다.
+// @Tags         shift
+// @Produce      json
+// @Success      200  {object}  dto.ShiftResponse
+// @Failure      404  {object}  dto.Problem "진행 중인 근무 없음"
+// @Security     ApiKeyAuth
+// @Router       /api/auth/me/assignment [get]
+func (h *ShiftHandler) MyAssignment(c *gin.Context) {
+	employeeID, ok := middleware.EmployeeID(c)
+	if !ok {
+		apperror.Abort(c, apperror.ErrInvalidToken.WithDetail("invalid token claims"), authResource)
+		return
+	}
+	shift, err := h.service.CurrentShift(c.Request.Context(), employeeID)
+	if err != nil {
+		apperror.Abort(c, err, shiftResource)
+		return
+	}
+	c.JSON(http.StatusOK, shift)
+}
+
+// EmployeeTrips godoc
+// @Summary      직원 운행 이력 조회
+// @Description  직원이 운전한 A/B 차량 운행 로그를 반환합니다. 관리직은 모든 직원, 그 외에는 본인만 조회할 수 있습니다.
+// @Tags         employee
+// @Produce      json
+// @Param        id   path      int  true  "직원 ID"
+// @Success      200  {object}  dto.EmployeeTripsResponse
+// @Failure      403  {object}  dto.Problem
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/employee/{id}/trips [get]
+func (h *ShiftHandler) EmployeeTrips(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(employeeResource), employeeResource)
+		return
+	}
+	self, _ := middleware.EmployeeID(c)
+	if id != self && c.GetString("position") != "관리직" {
+		apperror.Abort(c, apperror.ErrForbidden, authResource)
+		return
+	}
+	trips, err := h.service.EmployeeTrips(c.Request.Context(), id)
+	if err != nil {
+		apperror.Abort(c, err, employeeResource)
+		return
+	}
+	c.JSON(http.StatusOK, trips)
+}
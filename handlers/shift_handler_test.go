@@ -0,0 +1,87 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newShiftRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Vehicle{VehicleID: "A01"},
+		&models.Vehicle{VehicleID: "B01"},
+		&models.Employee{Position: "관리직", IsActive: true, Name: "Kim"},
+		&models.Employee{Position: "운송직", IsActive: true, Name: "Lee", AssignedVehicleID: ptr("A01")},
+		&models.Employee{Position: "운송직", IsActive: true, Name: "Park"},
+		&models.Shift{EmployeeID: 2, VehicleID: "A01", StartTime: time.Now().Add(-time.Hour), CreatedBy: 1},
+		&models.TripLog{VehicleID: "A01", DriverID: ptr(2), Status: "운행중"})
+	h := NewShiftHandler(service.NewShiftService(store))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/shift", h.CreateShift)
+		r.GET("/api/shift/:id", h.GetShiftByID)
+		r.DELETE("/api/shift/:id", h.DeleteShift)
+		r.GET("/api/shift", h.ListShifts)
+		r.POST("/api/shift/:id/end", h.EndShift)
+		r.GET("/api/employee/:id/trips", h.EmployeeTrips)
+		r.GET("/api/auth/me/assignment", h.MyAssignment)
+	})
+}
+
+func TestShiftHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "create", method: http.MethodPost, path: "/api/shift", employee: "1:관리직",
+			body: map[string]any{"employee_id": 3, "vehicle_id": "B01"}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if s := decode[dto.ShiftResponse](t, body); s.ShiftID != 2 || s.VehicleID != "B01" || s.CreatedBy != 1 {
+					t.Errorf("shift = %+v", s)
+				}
+			}},
+		{name: "create missing employee id", method: http.MethodPost, path: "/api/shift", employee: "1:관리직",
+			body: map[string]any{"vehicle_id": "B01"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create overlap", method: http.MethodPost, path: "/api/shift", employee: "1:관리직",
+			body: map[string]any{"employee_id": 3, "vehicle_id": "A01"}, status: http.StatusConflict, code: "SHIFT_OVERLAP"},
+		{name: "create not a driver", method: http.MethodPost, path: "/api/shift", employee: "1:관리직",
+			body: map[string]any{"employee_id": 1, "vehicle_id": "B01"}, status: http.StatusUnprocessableEntity, code: "INVALID_DRIVER"},
+		{name: "get", method: http.MethodGet, path: "/api/shift/1", status: http.StatusOK},
+		{name: "get missing", method: http.MethodGet, path: "/api/shift/99", status: http.StatusNotFound, code: "SHIFT_NOT_FOUND"},
+		{name: "list active", method: http.MethodGet, path: "/api/shift?active=true&vehicle_id=A01", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if shifts := decode[[]dto.ShiftResponse](t, body); len(shifts) != 1 || shifts[0].EmployeeID != 2 {
+					t.Errorf("shifts = %+v", shifts)
+				}
+			}},
+		{name: "end", method: http.MethodPost, path: "/api/shift/1/end", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if s := decode[dto.ShiftResponse](t, body); s.EndTime == nil {
+					t.Errorf("shift = %+v", s)
+				}
+			}},
+		{name: "end invalid id", method: http.MethodPost, path: "/api/shift/x/end", status: http.StatusBadRequest, code: "INVALID_SHIFT_ID"},
+		{name: "delete", method: http.MethodDelete, path: "/api/shift/1", status: http.StatusNoContent},
+		{name: "delete missing", method: http.MethodDelete, path: "/api/shift/99", status: http.StatusNotFound, code: "SHIFT_NOT_FOUND"},
+		{name: "my assignment", method: http.MethodGet, path: "/api/auth/me/assignment", employee: "2:운송직", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if s := decode[dto.ShiftResponse](t, body); s.VehicleID != "A01" {
+					t.Errorf("shift = %+v", s)
+				}
+			}},
+		{name: "my assignment off shift", method: http.MethodGet, path: "/api/auth/me/assignment", employee: "3:운송직", status: http.StatusNotFound, code: "SHIFT_NOT_FOUND"},
+		{name: "my assignment without claims", method: http.MethodGet, path: "/api/auth/me/assignment", status: http.StatusUnauthorized, code: "INVALID_TOKEN"},
+		{name: "own trips", method: http.MethodGet, path: "/api/employee/2/trips", employee: "2:운송직", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if res := decode[dto.EmployeeTripsResponse](t, body); res.EmployeeID != 2 || len(res.TripsA) != 1 || len(res.TripsB) != 0 {
+					t.Errorf("trips = %+v", res)
+				}
+			}},
+		{name: "admin reads other trips", method: http.MethodGet, path: "/api/employee/2/trips", employee: "1:관리직", status: http.StatusOK},
+		{name: "driver reads other trips", method: http.MethodGet, path: "/api/employee/2/trips", employee: "3:운송직", status: http.StatusForbidden, code: "FORBIDDEN"},
+		{name: "trips missing employee", method: http.MethodGet, path: "/api/employee/99/trips", employee: "1:관리직", status: http.StatusNotFound, code: "EMPLOYEE_NOT_FOUND"},
+	}, newShiftRouter)
+}
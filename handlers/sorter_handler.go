@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+const sorterResource = "sorter"
+
+type SorterHandler struct {
+	service *service.SorterService
+}
+
+func NewSorterHandler(s *service.SorterService) *SorterHandler {
+	return &SorterHandler{service: s}
+}
+
+// Scan godoc
+// @Summary      분류기 스캔
+// @Description  분류기가 인식한 패키지를 보낼 적재함을 결정합니다. divert 면 region_id 적재함으로 보내며 패키지는 투입됨 상태가 되고 지역 적재량이 늘어납니다. 적재함이 가득 찼으면 overflow, 등록되지 않았거나 이미 분류를 지난 패키지는 reject 이며, 결정은 package.scanned 이벤트로 기록됩니다. 처리 한도(sorter.scan_timeout)를 넘기면 아무것도 기록하지 않고 503 을 반환하므로 분류기는 오버플로 라인으로 보내야 합니다.
+// @Tags         sorter
+// @Accept       json
+// @Produce      json
+// @Param        scan  body      dto.SorterScanRequest  true  "인식한 패키지"
+// @Success      200   {object}  dto.SorterScanResponse
+// @Failure      400   {object}  dto.Problem
+// @Failure      401   {object}  dto.Problem "X-API-Key 가 없거나 등록되지 않음 (INVALID_API_KEY)"
+// @Failure      503   {object}  dto.Problem
+// @Security     DeviceKey
+// @Router       /api/sorter/scan [post]
+func (h *SorterHandler) Scan(c *gin.Context) {
+	var req dto.SorterScanRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Abort(c, err, sorterResource)
+		return
+	}
+	res, err := h.service.Scan(c.Request.Context(), req)
+	if err != nil {
+		apperror.Abort(c, err, sorterResource)
+		return
+	}
+	c.JSON(http.StatusOK, res)
+}
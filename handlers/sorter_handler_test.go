@@ -0,0 +1,47 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newSorterRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul", MaxCapacity: 3},
+		&models.Region{RegionID: "R02", RegionName: "Busan", MaxCapacity: 1, IsFull: true},
+		&models.Package{PackageType: "box", RegionID: "R01", PackageStatus: "등록됨"},
+		&models.Package{PackageType: "box", RegionID: "R02", PackageStatus: "등록됨"})
+	h := NewSorterHandler(service.NewSorterService(store, nil, time.Second))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/sorter/scan", h.Scan)
+	})
+}
+
+func sortDecision(decision, regionID string) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		if res := decode[dto.SorterScanResponse](t, body); res.Decision != decision || res.RegionID != regionID {
+			t.Errorf("decision = %+v, want %s to %q", res, decision, regionID)
+		}
+	}
+}
+
+func TestSorterHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "divert", method: http.MethodPost, path: "/api/sorter/scan",
+			body: map[string]any{"package_id": 1, "sorter_id": "S1"}, status: http.StatusOK, check: sortDecision(dto.SortDivert, "R01")},
+		{name: "overflow", method: http.MethodPost, path: "/api/sorter/scan",
+			body: map[string]any{"package_id": 2}, status: http.StatusOK, check: sortDecision(dto.SortOverflow, "R02")},
+		{name: "unknown package", method: http.MethodPost, path: "/api/sorter/scan",
+			body: map[string]any{"package_id": 99}, status: http.StatusOK, check: sortDecision(dto.SortReject, "")},
+		{name: "missing package id", method: http.MethodPost, path: "/api/sorter/scan",
+			body: map[string]any{"sorter_id": "S1"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+	}, newSorterRouter)
+}
@@ -4,12 +4,14 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/baboyiban/go-api-server/apperror"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/service"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
+const tripLogResource = "trip_log"
+
 type TripLogHandler struct {
 	service *service.TripLogService
 }
@@ -26,18 +28,20 @@ func NewTripLogHandler(s *service.TripLogService) *TripLogHandler {
 // @Produce      json
 // @Param        trip_log  body      dto.CreateTripLogRequest  true  "차량 운행 로그 정보"
 // @Success      201         {object}  dto.TripLogResponse
-// @Failure      400         {object}  dto.ErrorResponse
-// @Failure      500         {object}  dto.ErrorResponse
+// @Failure      400         {object}  dto.Problem
+// @Failure      409         {object}  dto.Problem
+// @Failure      422         {object}  dto.Problem
+// @Failure      500         {object}  dto.Problem
 // @Router       /api/trip-log [post]
 func (h *TripLogHandler) CreateTripLog(c *gin.Context) {
 	var req dto.CreateTripLogRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, tripLogResource)
 		return
 	}
 	trip, err := h.service.CreateTripLog(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to create trip_log", Details: err.Error()})
+		apperror.Abort(c, err, tripLogResource)
 		return
 	}
 	c.JSON(http.StatusCreated, trip)
@@ -50,22 +54,18 @@ func (h *TripLogHandler) CreateTripLog(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "차량 운행 로그 trip_id"
 // @Success      200  {object}  dto.TripLogResponse
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/trip-log/{id} [get]
 func (h *TripLogHandler) GetTripLogByID(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid trip_log id"})
+		apperror.Abort(c, apperror.InvalidID(tripLogResource), tripLogResource)
 		return
 	}
 	trip, err := h.service.GetTripLogByID(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "TripLog not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to get trip_log", Details: err.Error()})
+		apperror.Abort(c, err, tripLogResource)
 		return
 	}
 	c.JSON(http.StatusOK, trip)
@@ -78,22 +78,18 @@ func (h *TripLogHandler) GetTripLogByID(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "차량 운행 로그 trip_id"
 // @Success      204  "No Content"
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/trip-log/{id} [delete]
 func (h *TripLogHandler) DeleteTripLog(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid trip_log id"})
+		apperror.Abort(c, apperror.InvalidID(tripLogResource), tripLogResource)
 		return
 	}
 	err = h.service.DeleteTripLog(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "TripLog not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to delete trip_log", Details: err.Error()})
+		apperror.Abort(c, err, tripLogResource)
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -108,28 +104,26 @@ func (h *TripLogHandler) DeleteTripLog(c *gin.Context) {
 // @Param        id          path      int                        true  "차량 운행 로그 trip_id"
 // @Param        trip_log    body      dto.UpdateTripLogRequest   true  "수정할 차량 운행 로그 정보"
 // @Success      200         {object}  dto.TripLogResponse
-// @Failure      400         {object}  dto.ErrorResponse
-// @Failure      404         {object}  dto.ErrorResponse
-// @Failure      500         {object}  dto.ErrorResponse
+// @Failure      400         {object}  dto.Problem
+// @Failure      409         {object}  dto.Problem
+// @Failure      422         {object}  dto.Problem
+// @Failure      404         {object}  dto.Problem
+// @Failure      500         {object}  dto.Problem
 // @Router       /api/trip-log/{id} [put]
 func (h *TripLogHandler) UpdateTripLog(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid trip_log id"})
+		apperror.Abort(c, apperror.InvalidID(tripLogResource), tripLogResource)
 		return
 	}
 	var req dto.UpdateTripLogRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, tripLogResource)
 		return
 	}
 	trip, err := h.service.UpdateTripLog(c.Request.Context(), id, req)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "TripLog not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to update trip_log", Details: err.Error()})
+		apperror.Abort(c, err, tripLogResource)
 		return
 	}
 	c.JSON(http.StatusOK, trip)
@@ -147,7 +141,7 @@ func (h *TripLogHandler) ListTripLogs(c *gin.Context) {
 	sortParam := c.Query("sort")
 	trips, err := h.service.ListTripLogs(c.Request.Context(), sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to list trip_logs", Details: err.Error()})
+		apperror.Abort(c, err, tripLogResource)
 		return
 	}
 	c.JSON(http.StatusOK, trips)
@@ -160,6 +154,7 @@ func (h *TripLogHandler) ListTripLogs(c *gin.Context) {
 // @Produce      json
 // @Param        trip_id        query     int     false  "trip_id"
 // @Param        vehicle_id     query     string  false  "차량 ID"
+// @Param        driver_id      query     int     false  "운전 직원 ID"
 // @Param        start_time     query     string  false  "출발 시각 (YYYY-MM-DD)"
 // @Param        end_time       query     string  false  "도착 시각 (YYYY-MM-DD)"
 // @Param        status         query     string  false  "상태"
@@ -169,7 +164,7 @@ func (h *TripLogHandler) ListTripLogs(c *gin.Context) {
 // @Router       /api/trip-log/search [get]
 func (h *TripLogHandler) SearchTripLogs(c *gin.Context) {
 	params := map[string]string{}
-	for _, key := range []string{"trip_id", "vehicle_id", "status", "destination", "start_time", "end_time"} {
+	for _, key := range []string{"trip_id", "vehicle_id", "driver_id", "status", "destination", "start_time", "end_time"} {
 		if v := c.Query(key); v != "" {
 			params[key] = v
 		}
@@ -177,7 +172,7 @@ func (h *TripLogHandler) SearchTripLogs(c *gin.Context) {
 	sortParam := c.Query("sort")
 	trips, err := h.service.SearchTripLogs(c.Request.Context(), params, sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to search trip_logs", Details: err.Error()})
+		apperror.Abort(c, err, tripLogResource)
 		return
 	}
 	c.JSON(http.StatusOK, trips)
@@ -0,0 +1,86 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newTripLogRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Vehicle{VehicleID: "A01"},
+		&models.Vehicle{VehicleID: "B01"},
+		&models.Employee{Position: "운송직", IsActive: true, Name: "Lee"},
+		&models.Shift{EmployeeID: 1, VehicleID: "A01", StartTime: time.Now().Add(-time.Hour)},
+		&models.TripLog{VehicleID: "B01", Status: "운행중"})
+	h := NewTripLogHandler(service.NewTripLogService(store))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/trip-log", h.CreateTripLog)
+		r.GET("/api/trip-log/:id", h.GetTripLogByID)
+		r.PUT("/api/trip-log/:id", h.UpdateTripLog)
+		r.DELETE("/api/trip-log/:id", h.DeleteTripLog)
+		r.GET("/api/trip-log", h.ListTripLogs)
+		r.GET("/api/trip-log/search", h.SearchTripLogs)
+	})
+}
+
+func tripIDs(want ...int) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		trips := decode[[]dto.TripLogResponse](t, body)
+		if len(trips) != len(want) {
+			t.Fatalf("trips = %+v, want ids %v", trips, want)
+		}
+		for i, trip := range trips {
+			if trip.TripID != want[i] {
+				t.Fatalf("trips = %+v, want ids %v", trips, want)
+			}
+		}
+	}
+}
+
+func TestTripLogHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "create driver from shift", method: http.MethodPost, path: "/api/trip-log",
+			body: map[string]any{"vehicle_id": "A01", "status": "운행중"}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if trip := decode[dto.TripLogResponse](t, body); trip.TripID != 2 || trip.DriverID == nil || *trip.DriverID != 1 {
+					t.Errorf("trip = %+v", trip)
+				}
+			}},
+		{name: "create missing vehicle id", method: http.MethodPost, path: "/api/trip-log",
+			body: map[string]any{"status": "운행중"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create unknown vehicle", method: http.MethodPost, path: "/api/trip-log",
+			body: map[string]any{"vehicle_id": "Z99"}, status: http.StatusUnprocessableEntity, code: "INVALID_TRIP_LOG_REFERENCE"},
+		{name: "get", method: http.MethodGet, path: "/api/trip-log/1", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if trip := decode[dto.TripLogResponse](t, body); trip.VehicleID != "B01" || trip.DriverID != nil {
+					t.Errorf("trip = %+v", trip)
+				}
+			}},
+		{name: "get invalid id", method: http.MethodGet, path: "/api/trip-log/one", status: http.StatusBadRequest, code: "INVALID_TRIP_LOG_ID"},
+		{name: "get missing", method: http.MethodGet, path: "/api/trip-log/99", status: http.StatusNotFound, code: "TRIP_LOG_NOT_FOUND"},
+		{name: "update", method: http.MethodPut, path: "/api/trip-log/1",
+			body: map[string]any{"status": "비운행중", "end_time": "2026-03-02T18:00:00Z"}, status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if trip := decode[dto.TripLogResponse](t, body); trip.Status != "비운행중" || trip.EndTime == nil {
+					t.Errorf("trip = %+v", trip)
+				}
+			}},
+		{name: "update unknown driver", method: http.MethodPut, path: "/api/trip-log/1",
+			body: map[string]any{"driver_id": 99}, status: http.StatusUnprocessableEntity, code: "INVALID_TRIP_LOG_REFERENCE"},
+		{name: "update missing", method: http.MethodPut, path: "/api/trip-log/99",
+			body: map[string]any{"status": "운행중"}, status: http.StatusNotFound, code: "TRIP_LOG_NOT_FOUND"},
+		{name: "delete", method: http.MethodDelete, path: "/api/trip-log/1", status: http.StatusNoContent},
+		{name: "delete missing", method: http.MethodDelete, path: "/api/trip-log/99", status: http.StatusNotFound, code: "TRIP_LOG_NOT_FOUND"},
+		{name: "list", method: http.MethodGet, path: "/api/trip-log", status: http.StatusOK, check: tripIDs(1)},
+		{name: "search vehicle", method: http.MethodGet, path: "/api/trip-log/search?vehicle_id=A01", status: http.StatusOK, check: tripIDs()},
+		{name: "search status", method: http.MethodGet, path: "/api/trip-log/search?status=운행중", status: http.StatusOK, check: tripIDs(1)},
+	}, newTripLogRouter)
+}
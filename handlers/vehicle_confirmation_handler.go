@@ -0,0 +1,114 @@
+package handlers
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/gin-gonic/gin"
+)
+
+const confirmationResource = "confirmation"
+
+// RaiseConfirmation godoc
+// @Summary      차량 확인 요청 등록
+// @Description  차량이 운영자 확인을 요청합니다. 확인 필요 플래그를 설정하고 LED 를 요청 색상(생략 시 사유별 기본 색상)으로 바꿉니다.
+// @Tags         vehicle
+// @Accept       json
+// @Produce      json
+// @Param        id            path      int                            true  "차량 Internal ID"
+// @Param        confirmation  body      dto.CreateConfirmationRequest  true  "확인 요청 정보"
+// @Success      201           {object}  dto.ConfirmationResponse
+// @Failure      400           {object}  dto.Problem
+// @Failure      404           {object}  dto.Problem
+// @Router       /api/vehicle/{id}/confirmations [post]
+func (h *VehicleHandler) RaiseConfirmation(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(vehicleResource), vehicleResource)
+		return
+	}
+	var req dto.CreateConfirmationRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Abort(c, err, confirmationResource)
+		return
+	}
+	conf, err := h.service.RaiseConfirmation(c.Request.Context(), id, req)
+	if err != nil {
+		apperror.Abort(c, err, vehicleResource)
+		return
+	}
+	c.JSON(http.StatusCreated, conf)
+}
+
+// ListConfirmations godoc
+// @Summary      차량 확인 요청 목록 조회
+// @Description  차량의 확인 요청을 최근 순으로 반환합니다.
+// @Tags         vehicle
+// @Produce      json
+// @Param        id      path      int     true   "차량 Internal ID"
+// @Param        status  query     string  false  "상태 (pending, acknowledged)"
+// @Success      200     {array}   dto.ConfirmationResponse
+// @Failure      404     {object}  dto.Problem
+// @Router       /api/vehicle/{id}/confirmations [get]
+func (h *VehicleHandler) ListConfirmations(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(vehicleResource), vehicleResource)
+		return
+	}
+	confs, err := h.service.ListConfirmations(c.Request.Context(), id, c.Query("status"))
+	if err != nil {
+		apperror.Abort(c, err, vehicleResource)
+		return
+	}
+	c.JSON(http.StatusOK, confs)
+}
+
+// AcknowledgeConfirmation godoc
+// @Summary      차량 확인 요청 처리
+// @Description  운영자가 확인 요청을 처리합니다. 대기 중인 요청이 더 없으면 확인 필요 플래그를 내리고 LED 를 요청 전 상태로 복원합니다. 처리한 직원이 기록됩니다.
+// @Tags         vehicle
+// @Accept       json
+// @Produce      json
+// @Param        id    path      int                                 true   "차량 Internal ID"
+// @Param        cid   path      int                                 true   "확인 요청 ID"
+// @Param        ack   body      dto.AcknowledgeConfirmationRequest  false  "처리 메모"
+// @Success      200   {object}  dto.ConfirmationResponse
+// @Failure      404   {object}  dto.Problem
+// @Failure      409   {object}  dto.Problem "이미 처리된 요청"
+// @Security     ApiKeyAuth
+// @Router       /api/vehicle/{id}/confirmations/{cid}/ack [post]
+func (h *VehicleHandler) AcknowledgeConfirmation(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(vehicleResource), vehicleResource)
+		return
+	}
+	cid, err := strconv.Atoi(c.Param("cid"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(confirmationResource), confirmationResource)
+		return
+	}
+	var req dto.AcknowledgeConfirmationRequest
+	// 메모는 선택이므로 빈 본문 허용
+	if err := c.ShouldBindJSON(&req); err != nil && !errors.Is(err, io.EOF) {
+		apperror.Abort(c, err, confirmationResource)
+		return
+	}
+	employeeID, ok := middleware.EmployeeID(c)
+	if !ok {
+		apperror.Abort(c, apperror.ErrInvalidToken.WithDetail("invalid token claims"), authResource)
+		return
+	}
+	conf, err := h.service.AcknowledgeConfirmation(c.Request.Context(), id, cid, employeeID, req)
+	if err != nil {
+		apperror.Abort(c, err, vehicleResource)
+		return
+	}
+	c.JSON(http.StatusOK, conf)
+}
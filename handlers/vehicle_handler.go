@@ -4,12 +4,14 @@ import (
 	"net/http"
 	"strconv"
 
+	"github.com/baboyiban/go-api-server/apperror"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/service"
 	"github.com/gin-gonic/gin"
-	"gorm.io/gorm"
 )
 
+const vehicleResource = "vehicle"
+
 type VehicleHandler struct {
 	service *service.VehicleService
 }
@@ -26,18 +28,20 @@ func NewVehicleHandler(s *service.VehicleService) *VehicleHandler {
 // @Produce      json
 // @Param        vehicle  body      dto.CreateVehicleRequest  true  "차량 정보"
 // @Success      201      {object}  dto.VehicleResponse
-// @Failure      400      {object}  dto.ErrorResponse
-// @Failure      500      {object}  dto.ErrorResponse
+// @Failure      400      {object}  dto.Problem
+// @Failure      409      {object}  dto.Problem
+// @Failure      422      {object}  dto.Problem
+// @Failure      500      {object}  dto.Problem
 // @Router       /api/vehicle [post]
 func (h *VehicleHandler) CreateVehicle(c *gin.Context) {
 	var req dto.CreateVehicleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, vehicleResource)
 		return
 	}
 	vehicle, err := h.service.CreateVehicle(c.Request.Context(), req)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to create vehicle", Details: err.Error()})
+		apperror.Abort(c, err, vehicleResource)
 		return
 	}
 	c.JSON(http.StatusCreated, vehicle)
@@ -50,24 +54,22 @@ func (h *VehicleHandler) CreateVehicle(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "차량 Internal ID"
 // @Success      200  {object}  dto.VehicleResponse
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Header       200  {string}  Cache-Control  "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/vehicle/{id} [get]
 func (h *VehicleHandler) GetVehicleByID(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid vehicle id"})
+		apperror.Abort(c, apperror.InvalidID(vehicleResource), vehicleResource)
 		return
 	}
 	vehicle, err := h.service.GetVehicleByID(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Vehicle not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to get vehicle", Details: err.Error()})
+		apperror.Abort(c, err, vehicleResource)
 		return
 	}
+	setCacheControl(c, h.service.CacheTTL())
 	c.JSON(http.StatusOK, vehicle)
 }
 
@@ -78,22 +80,18 @@ func (h *VehicleHandler) GetVehicleByID(c *gin.Context) {
 // @Produce      json
 // @Param        id   path      int  true  "차량 Internal ID"
 // @Success      204  "No Content"
-// @Failure      404  {object}  dto.ErrorResponse
-// @Failure      500  {object}  dto.ErrorResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      500  {object}  dto.Problem
 // @Router       /api/vehicle/{id} [delete]
 func (h *VehicleHandler) DeleteVehicle(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid vehicle id"})
+		apperror.Abort(c, apperror.InvalidID(vehicleResource), vehicleResource)
 		return
 	}
 	err = h.service.DeleteVehicle(c.Request.Context(), id)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Vehicle not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to delete vehicle", Details: err.Error()})
+		apperror.Abort(c, err, vehicleResource)
 		return
 	}
 	c.Status(http.StatusNoContent)
@@ -108,28 +106,26 @@ func (h *VehicleHandler) DeleteVehicle(c *gin.Context) {
 // @Param        id      path      int                      true  "차량 Internal ID"
 // @Param        vehicle body      dto.UpdateVehicleRequest  true  "수정할 차량 정보"
 // @Success      200     {object}  dto.VehicleResponse
-// @Failure      400     {object}  dto.ErrorResponse
-// @Failure      404     {object}  dto.ErrorResponse
-// @Failure      500     {object}  dto.ErrorResponse
+// @Failure      400     {object}  dto.Problem
+// @Failure      409     {object}  dto.Problem
+// @Failure      422     {object}  dto.Problem
+// @Failure      404     {object}  dto.Problem
+// @Failure      500     {object}  dto.Problem
 // @Router       /api/vehicle/{id} [put]
 func (h *VehicleHandler) UpdateVehicle(c *gin.Context) {
 	id, err := strconv.Atoi(c.Param("id"))
 	if err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid vehicle id"})
+		apperror.Abort(c, apperror.InvalidID(vehicleResource), vehicleResource)
 		return
 	}
 	var req dto.UpdateVehicleRequest
 	if err := c.ShouldBindJSON(&req); err != nil {
-		c.JSON(http.StatusBadRequest, dto.ErrorResponse{Error: "Invalid request", Details: err.Error()})
+		apperror.Abort(c, err, vehicleResource)
 		return
 	}
 	vehicle, err := h.service.UpdateVehicle(c.Request.Context(), id, req)
-	if err == gorm.ErrRecordNotFound {
-		c.JSON(http.StatusNotFound, dto.ErrorResponse{Error: "Vehicle not found"})
-		return
-	}
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to update vehicle", Details: err.Error()})
+		apperror.Abort(c, err, vehicleResource)
 		return
 	}
 	c.JSON(http.StatusOK, vehicle)
@@ -142,14 +138,16 @@ func (h *VehicleHandler) UpdateVehicle(c *gin.Context) {
 // @Produce      json
 // @Param        sort  query     string  false  "정렬 필드 (예: -internal_id, -vehicle_id 등)"
 // @Success      200   {array}   dto.VehicleResponse
+// @Header       200  {string}  Cache-Control  "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
 // @Router       /api/vehicle [get]
 func (h *VehicleHandler) ListVehicles(c *gin.Context) {
 	sortParam := c.Query("sort")
 	vehicles, err := h.service.ListVehicles(c.Request.Context(), sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to list vehicles", Details: err.Error()})
+		apperror.Abort(c, err, vehicleResource)
 		return
 	}
+	setCacheControl(c, h.service.CacheTTL())
 	c.JSON(http.StatusOK, vehicles)
 }
 
@@ -168,7 +166,8 @@ func (h *VehicleHandler) ListVehicles(c *gin.Context) {
 // @Param        coord_y            query     int     false  "Y 좌표"
 // @Param        sort               query     string  false  "정렬 필드 (예: -internal_id, -vehicle_id 등)"
 // @Success      200  {array}   dto.VehicleResponse
-// @Failure      400  {object}  dto.ErrorResponse
+// @Header       200  {string}  Cache-Control  "private, max-age=캐시 유지 시간(초). 캐시를 끄면 생략"
+// @Failure      400  {object}  dto.Problem
 // @Router       /api/vehicle/search [get]
 func (h *VehicleHandler) SearchVehicles(c *gin.Context) {
 	params := map[string]string{}
@@ -180,8 +179,9 @@ func (h *VehicleHandler) SearchVehicles(c *gin.Context) {
 	sortParam := c.Query("sort")
 	vehicles, err := h.service.SearchVehicles(c.Request.Context(), params, sortParam)
 	if err != nil {
-		c.JSON(http.StatusInternalServerError, dto.ErrorResponse{Error: "Failed to search vehicles", Details: err.Error()})
+		apperror.Abort(c, err, vehicleResource)
 		return
 	}
+	setCacheControl(c, h.service.CacheTTL())
 	c.JSON(http.StatusOK, vehicles)
 }
@@ -0,0 +1,131 @@
+package handlers
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+func newVehicleRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Vehicle{VehicleID: "A01", MaxLoad: 5, LedStatus: models.LedOff},
+		&models.Vehicle{VehicleID: "B01", MaxLoad: 3, LedStatus: models.LedGreen})
+	h := NewVehicleHandler(service.NewVehicleService(store, nil))
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/vehicle", h.CreateVehicle)
+		r.GET("/api/vehicle/:id", h.GetVehicleByID)
+		r.PUT("/api/vehicle/:id", h.UpdateVehicle)
+		r.DELETE("/api/vehicle/:id", h.DeleteVehicle)
+		r.GET("/api/vehicle", h.ListVehicles)
+		r.GET("/api/vehicle/search", h.SearchVehicles)
+	})
+}
+
+func vehicleIDs(want ...string) func(t *testing.T, body []byte) {
+	return func(t *testing.T, body []byte) {
+		vehicles := decode[[]models.Vehicle](t, body)
+		if len(vehicles) != len(want) {
+			t.Fatalf("vehicles = %+v, want %v", vehicles, want)
+		}
+		for i, v := range vehicles {
+			if v.VehicleID != want[i] {
+				t.Fatalf("vehicles = %+v, want %v", vehicles, want)
+			}
+		}
+	}
+}
+
+func TestVehicleHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "create", method: http.MethodPost, path: "/api/vehicle",
+			body: map[string]any{"vehicle_id": "C01", "max_load": 4}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if v := decode[models.Vehicle](t, body); v.InternalID != 3 || v.LedStatus != models.LedOff {
+					t.Errorf("vehicle = %+v", v)
+				}
+			}},
+		{name: "create missing id", method: http.MethodPost, path: "/api/vehicle",
+			body: map[string]any{"max_load": 4}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create duplicate", method: http.MethodPost, path: "/api/vehicle",
+			body: map[string]any{"vehicle_id": "A01"}, status: http.StatusConflict, code: "DUPLICATE_VEHICLE"},
+		{name: "get", method: http.MethodGet, path: "/api/vehicle/1", status: http.StatusOK, check: func(t *testing.T, body []byte) {
+			if v := decode[models.Vehicle](t, body); v.VehicleID != "A01" {
+				t.Errorf("vehicle = %+v", v)
+			}
+		}},
+		{name: "get invalid id", method: http.MethodGet, path: "/api/vehicle/A01", status: http.StatusBadRequest, code: "INVALID_VEHICLE_ID"},
+		{name: "get missing", method: http.MethodGet, path: "/api/vehicle/99", status: http.StatusNotFound, code: "VEHICLE_NOT_FOUND"},
+		{name: "update led", method: http.MethodPut, path: "/api/vehicle/1",
+			body: map[string]any{"max_load": 6, "led_status": "red", "coord_x": 1, "coord_y": 2}, status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if v := decode[models.Vehicle](t, body); v.MaxLoad != 6 || v.LedStatus != models.LedRed || v.CoordY != 2 {
+					t.Errorf("vehicle = %+v", v)
+				}
+			}},
+		{name: "update invalid led", method: http.MethodPut, path: "/api/vehicle/1",
+			body: map[string]any{"led_status": "purple"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "update missing", method: http.MethodPut, path: "/api/vehicle/99",
+			body: map[string]any{"max_load": 1}, status: http.StatusNotFound, code: "VEHICLE_NOT_FOUND"},
+		{name: "delete", method: http.MethodDelete, path: "/api/vehicle/2", status: http.StatusNoContent},
+		{name: "delete missing", method: http.MethodDelete, path: "/api/vehicle/99", status: http.StatusNotFound, code: "VEHICLE_NOT_FOUND"},
+		{name: "list sorted", method: http.MethodGet, path: "/api/vehicle?sort=-vehicle_id", status: http.StatusOK, check: vehicleIDs("B01", "A01")},
+		{name: "search led", method: http.MethodGet, path: "/api/vehicle/search?led_status=green", status: http.StatusOK, check: vehicleIDs("B01")},
+	}, newVehicleRouter)
+}
+
+func newConfirmationRouter(t *testing.T) http.Handler {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Vehicle{VehicleID: "A01", LedStatus: models.LedOff},
+		&models.Employee{Position: "관리직", IsActive: true, Name: "Kim"})
+	svc := service.NewVehicleService(store, nil)
+	h := NewVehicleHandler(svc)
+	if _, err := svc.RaiseConfirmation(t.Context(), 1, dto.CreateConfirmationRequest{Reason: "arrival"}); err != nil {
+		t.Fatalf("raise: %v", err)
+	}
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/vehicle/:id/confirmations", h.RaiseConfirmation)
+		r.GET("/api/vehicle/:id/confirmations", h.ListConfirmations)
+		r.POST("/api/vehicle/:id/confirmations/:cid/ack", h.AcknowledgeConfirmation)
+	})
+}
+
+func TestVehicleHandler_Confirmations(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "raise", method: http.MethodPost, path: "/api/vehicle/1/confirmations",
+			body: map[string]any{"reason": "load_mismatch", "detail": "3 != 4"}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if c := decode[dto.ConfirmationResponse](t, body); c.ConfirmationID != 2 || c.Status != "pending" || c.LedColor == "" {
+					t.Errorf("confirmation = %+v", c)
+				}
+			}},
+		{name: "raise invalid reason", method: http.MethodPost, path: "/api/vehicle/1/confirmations",
+			body: map[string]any{"reason": "bored"}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "raise missing vehicle", method: http.MethodPost, path: "/api/vehicle/99/confirmations",
+			body: map[string]any{"reason": "arrival"}, status: http.StatusNotFound, code: "VEHICLE_NOT_FOUND"},
+		{name: "list pending", method: http.MethodGet, path: "/api/vehicle/1/confirmations?status=pending", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if confs := decode[[]dto.ConfirmationResponse](t, body); len(confs) != 1 || confs[0].Reason != "arrival" {
+					t.Errorf("confirmations = %+v", confs)
+				}
+			}},
+		{name: "ack", method: http.MethodPost, path: "/api/vehicle/1/confirmations/1/ack", employee: "1:관리직", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if c := decode[dto.ConfirmationResponse](t, body); c.Status != "acknowledged" || c.AcknowledgedBy == nil || *c.AcknowledgedBy != 1 {
+					t.Errorf("confirmation = %+v", c)
+				}
+			}},
+		{name: "ack with note", method: http.MethodPost, path: "/api/vehicle/1/confirmations/1/ack", employee: "1:관리직",
+			body: map[string]any{"note": "checked"}, status: http.StatusOK},
+		{name: "ack without employee", method: http.MethodPost, path: "/api/vehicle/1/confirmations/1/ack", status: http.StatusUnauthorized},
+		{name: "ack invalid id", method: http.MethodPost, path: "/api/vehicle/1/confirmations/x/ack", employee: "1:관리직",
+			status: http.StatusBadRequest, code: "INVALID_CONFIRMATION_ID"},
+		{name: "ack missing", method: http.MethodPost, path: "/api/vehicle/1/confirmations/99/ack", employee: "1:관리직", status: http.StatusNotFound},
+	}, newConfirmationRouter)
+}
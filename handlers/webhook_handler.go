@@ -0,0 +1,242 @@
+package handlers
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/gin-gonic/gin"
+)
+
+const (
+	webhookResource         = "webhook"
+	webhookDeliveryResource = "webhook_delivery"
+)
+
+type WebhookHandler struct {
+	service *service.WebhookService
+}
+
+func NewWebhookHandler(s *service.WebhookService) *WebhookHandler {
+	return &WebhookHandler{service: s}
+}
+
+// CreateWebhook godoc
+// @Summary      웹훅 구독 생성
+// @Description  이벤트를 받을 URL 과 이벤트 타입을 등록합니다. 루프백, 사설망, 링크 로컬 주소는 등록할 수 없습니다. 요청은 X-Webhook-Signature 헤더에 "<timestamp>.<body>" 의 HMAC-SHA256 서명(sha256=<hex>)을 담아 전송됩니다. 시크릿은 생성 응답에서만 반환됩니다.
+// @Tags         webhook
+// @Accept       json
+// @Produce      json
+// @Param        webhook  body      dto.CreateWebhookRequest  true  "구독 정보"
+// @Success      201      {object}  dto.WebhookResponse
+// @Failure      400      {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/webhook [post]
+func (h *WebhookHandler) CreateWebhook(c *gin.Context) {
+	var req dto.CreateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	createdBy, _ := middleware.EmployeeID(c)
+	sub, err := h.service.CreateSubscription(c.Request.Context(), req, createdBy)
+	if err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	c.JSON(http.StatusCreated, sub)
+}
+
+// GetWebhook godoc
+// @Summary      웹훅 구독 단건 조회
+// @Tags         webhook
+// @Produce      json
+// @Param        id   path      int  true  "구독 ID"
+// @Success      200  {object}  dto.WebhookResponse
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/webhook/{id} [get]
+func (h *WebhookHandler) GetWebhook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(webhookResource), webhookResource)
+		return
+	}
+	sub, err := h.service.GetSubscription(c.Request.Context(), id)
+	if err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	c.JSON(http.StatusOK, sub)
+}
+
+// ListWebhooks godoc
+// @Summary      웹훅 구독 목록 조회
+// @Tags         webhook
+// @Produce      json
+// @Success      200  {array}   dto.WebhookResponse
+// @Security     ApiKeyAuth
+// @Router       /api/webhook [get]
+func (h *WebhookHandler) ListWebhooks(c *gin.Context) {
+	subs, err := h.service.ListSubscriptions(c.Request.Context())
+	if err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	c.JSON(http.StatusOK, subs)
+}
+
+// UpdateWebhook godoc
+// @Summary      웹훅 구독 수정
+// @Description  URL, 이벤트 타입, 활성 여부를 변경합니다. secret 을 지정하면 시크릿을 교체합니다.
+// @Tags         webhook
+// @Accept       json
+// @Produce      json
+// @Param        id       path      int                       true  "구독 ID"
+// @Param        webhook  body      dto.UpdateWebhookRequest  true  "구독 정보"
+// @Success      200      {object}  dto.WebhookResponse
+// @Failure      400      {object}  dto.Problem
+// @Failure      404      {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/webhook/{id} [put]
+func (h *WebhookHandler) UpdateWebhook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(webhookResource), webhookResource)
+		return
+	}
+	var req dto.UpdateWebhookRequest
+	if err := c.ShouldBindJSON(&req); err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	sub, err := h.service.UpdateSubscription(c.Request.Context(), id, req)
+	if err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	c.JSON(http.StatusOK, sub)
+}
+
+// DeleteWebhook godoc
+// @Summary      웹훅 구독 삭제
+// @Description  구독과 전송 기록을 함께 삭제합니다.
+// @Tags         webhook
+// @Param        id   path      int  true  "구독 ID"
+// @Success      204  "No Content"
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/webhook/{id} [delete]
+func (h *WebhookHandler) DeleteWebhook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(webhookResource), webhookResource)
+		return
+	}
+	if err := h.service.DeleteSubscription(c.Request.Context(), id); err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	c.Status(http.StatusNoContent)
+}
+
+// TestWebhook godoc
+// @Summary      웹훅 테스트 전송
+// @Description  webhook.test 이벤트를 즉시 전송하고 결과를 반환합니다. 실패하면 일반 전송처럼 재시도가 예약됩니다.
+// @Tags         webhook
+// @Produce      json
+// @Param        id   path      int  true  "구독 ID"
+// @Success      200  {object}  dto.WebhookDeliveryResponse
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/webhook/{id}/test [post]
+func (h *WebhookHandler) TestWebhook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(webhookResource), webhookResource)
+		return
+	}
+	delivery, err := h.service.TestSubscription(c.Request.Context(), id)
+	if err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	c.JSON(http.StatusOK, delivery)
+}
+
+// ListWebhookDeliveries godoc
+// @Summary      웹훅 전송 목록 조회
+// @Description  구독의 전송을 최근 순으로 반환합니다.
+// @Tags         webhook
+// @Produce      json
+// @Param        id      path      int     true   "구독 ID"
+// @Param        status  query     string  false  "상태 (pending, succeeded, failed)"
+// @Success      200     {array}   dto.WebhookDeliveryResponse
+// @Failure      404     {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/webhook/{id}/deliveries [get]
+func (h *WebhookHandler) ListWebhookDeliveries(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(webhookResource), webhookResource)
+		return
+	}
+	deliveries, err := h.service.ListDeliveries(c.Request.Context(), id, c.Query("status"))
+	if err != nil {
+		apperror.Abort(c, err, webhookResource)
+		return
+	}
+	c.JSON(http.StatusOK, deliveries)
+}
+
+// GetWebhookDelivery godoc
+// @Summary      웹훅 전송 단건 조회
+// @Description  전송한 본문과 시도 기록을 함께 반환합니다.
+// @Tags         webhook
+// @Produce      json
+// @Param        id   path      int  true  "전송 ID"
+// @Success      200  {object}  dto.WebhookDeliveryDetailResponse
+// @Failure      404  {object}  dto.Problem
+// @Security     ApiKeyAuth
+// @Router       /api/webhook/deliveries/{id} [get]
+func (h *WebhookHandler) GetWebhookDelivery(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(webhookDeliveryResource), webhookDeliveryResource)
+		return
+	}
+	delivery, err := h.service.GetDelivery(c.Request.Context(), id)
+	if err != nil {
+		apperror.Abort(c, err, webhookDeliveryResource)
+		return
+	}
+	c.JSON(http.StatusOK, delivery)
+}
+
+// RedeliverWebhook godoc
+// @Summary      웹훅 재전송
+// @Description  성공 또는 실패로 끝난 전송을 같은 본문과 이벤트 ID 로 즉시 다시 전송합니다. 시도 횟수는 초기화됩니다.
+// @Tags         webhook
+// @Produce      json
+// @Param        id   path      int  true  "전송 ID"
+// @Success      200  {object}  dto.WebhookDeliveryResponse
+// @Failure      404  {object}  dto.Problem
+// @Failure      409  {object}  dto.Problem "아직 전송 대기 중"
+// @Security     ApiKeyAuth
+// @Router       /api/webhook/deliveries/{id}/redeliver [post]
+func (h *WebhookHandler) RedeliverWebhook(c *gin.Context) {
+	id, err := strconv.Atoi(c.Param("id"))
+	if err != nil {
+		apperror.Abort(c, apperror.InvalidID(webhookDeliveryResource), webhookDeliveryResource)
+		return
+	}
+	delivery, err := h.service.Redeliver(c.Request.Context(), id)
+	if err != nil {
+		apperror.Abort(c, err, webhookDeliveryResource)
+		return
+	}
+	c.JSON(http.StatusOK, delivery)
+}
@@ -0,0 +1,139 @@
+package handlers
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/webhook"
+	"github.com/gin-gonic/gin"
+)
+
+const testWebhookSecret = "test-webhook-secret-0123456789"
+
+// newWebhookRouter 구독 1개와 실패·대기 중인 전송 1건씩을 준비. 수신 서버는 서명이 맞으면 200
+func newWebhookRouter(t *testing.T) http.Handler {
+	receiver := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if !webhook.Verify(testWebhookSecret, r.Header.Get(webhook.HeaderTimestamp), body, r.Header.Get(webhook.HeaderSignature), time.Minute) {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(receiver.Close)
+
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	dispatcher := webhook.NewDispatcher(store, config.WebhookConfig{Timeout: time.Second, MaxAttempts: 3, BaseBackoff: time.Minute, MaxBackoff: time.Hour, AllowPrivateTargets: true})
+	svc := service.NewWebhookService(store, dispatcher)
+	if _, err := svc.CreateSubscription(ctx, dto.CreateWebhookRequest{
+		URL: receiver.URL, EventTypes: []string{outbox.PackageCreated}, Secret: ptr(testWebhookSecret),
+	}, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := store.Webhooks().CreateDeliveries(ctx, []models.WebhookDelivery{
+		{SubscriptionID: 1, EventID: "ev-failed", EventType: outbox.PackageCreated, Payload: `{"id":"ev-failed"}`,
+			Status: models.WebhookDeliveryFailed, Attempts: 3, NextAttemptAt: time.Now(), CreatedAt: time.Now()},
+		{SubscriptionID: 1, EventID: "ev-pending", EventType: outbox.PackageCreated, Payload: `{"id":"ev-pending"}`,
+			Status: models.WebhookDeliveryPending, Attempts: 1, NextAttemptAt: time.Now().Add(time.Minute), CreatedAt: time.Now()},
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	h := NewWebhookHandler(svc)
+	return newRouter(func(r gin.IRoutes) {
+		r.POST("/api/webhook", h.CreateWebhook)
+		r.GET("/api/webhook/:id", h.GetWebhook)
+		r.PUT("/api/webhook/:id", h.UpdateWebhook)
+		r.DELETE("/api/webhook/:id", h.DeleteWebhook)
+		r.GET("/api/webhook", h.ListWebhooks)
+		r.POST("/api/webhook/:id/test", h.TestWebhook)
+		r.GET("/api/webhook/:id/deliveries", h.ListWebhookDeliveries)
+		r.GET("/api/webhook/deliveries/:id", h.GetWebhookDelivery)
+		r.POST("/api/webhook/deliveries/:id/redeliver", h.RedeliverWebhook)
+	})
+}
+
+func TestWebhookHandler(t *testing.T) {
+	runCases(t, []httpCase{
+		{name: "create", method: http.MethodPost, path: "/api/webhook", employee: "1:관리직",
+			body: map[string]any{"url": "https://example.com/hook", "event_types": []string{outbox.RegionSaturated}}, status: http.StatusCreated,
+			check: func(t *testing.T, body []byte) {
+				if s := decode[dto.WebhookResponse](t, body); s.SubscriptionID != 2 || s.Secret == "" || s.CreatedBy != 1 || !s.IsActive {
+					t.Errorf("subscription = %+v", s)
+				}
+			}},
+		{name: "create invalid url", method: http.MethodPost, path: "/api/webhook", employee: "1:관리직",
+			body: map[string]any{"url": "not a url", "event_types": []string{outbox.RegionSaturated}}, status: http.StatusBadRequest, code: "VALIDATION_FAILED"},
+		{name: "create unknown event type", method: http.MethodPost, path: "/api/webhook", employee: "1:관리직",
+			body: map[string]any{"url": "https://example.com/hook", "event_types": []string{"package.exploded"}}, status: http.StatusBadRequest},
+		{name: "get hides secret", method: http.MethodGet, path: "/api/webhook/1", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if s := decode[dto.WebhookResponse](t, body); s.Secret != "" || len(s.EventTypes) != 1 {
+					t.Errorf("subscription = %+v", s)
+				}
+			}},
+		{name: "get missing", method: http.MethodGet, path: "/api/webhook/99", status: http.StatusNotFound, code: "WEBHOOK_NOT_FOUND"},
+		{name: "get invalid id", method: http.MethodGet, path: "/api/webhook/x", status: http.StatusBadRequest, code: "INVALID_WEBHOOK_ID"},
+		{name: "list", method: http.MethodGet, path: "/api/webhook", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if subs := decode[[]dto.WebhookResponse](t, body); len(subs) != 1 {
+					t.Errorf("subscriptions = %+v", subs)
+				}
+			}},
+		{name: "update deactivates", method: http.MethodPut, path: "/api/webhook/1",
+			body: map[string]any{"url": "https://example.com/hook", "event_types": []string{outbox.PackageCreated}, "is_active": false}, status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if s := decode[dto.WebhookResponse](t, body); s.IsActive || s.URL != "https://example.com/hook" {
+					t.Errorf("subscription = %+v", s)
+				}
+			}},
+		{name: "delete", method: http.MethodDelete, path: "/api/webhook/1", status: http.StatusNoContent},
+		{name: "delete missing", method: http.MethodDelete, path: "/api/webhook/99", status: http.StatusNotFound, code: "WEBHOOK_NOT_FOUND"},
+		{name: "test delivery signed", method: http.MethodPost, path: "/api/webhook/1/test", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if d := decode[dto.WebhookDeliveryResponse](t, body); d.Status != models.WebhookDeliverySucceeded || d.EventType != webhook.EventTest ||
+					d.LastStatusCode == nil || *d.LastStatusCode != http.StatusOK {
+					t.Errorf("delivery = %+v", d)
+				}
+			}},
+		{name: "test missing", method: http.MethodPost, path: "/api/webhook/99/test", status: http.StatusNotFound, code: "WEBHOOK_NOT_FOUND"},
+		{name: "list deliveries", method: http.MethodGet, path: "/api/webhook/1/deliveries", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if ds := decode[[]dto.WebhookDeliveryResponse](t, body); len(ds) != 2 || ds[0].DeliveryID != 2 {
+					t.Errorf("deliveries = %+v", ds)
+				}
+			}},
+		{name: "list deliveries by status", method: http.MethodGet, path: "/api/webhook/1/deliveries?status=failed", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if ds := decode[[]dto.WebhookDeliveryResponse](t, body); len(ds) != 1 || ds[0].EventID != "ev-failed" {
+					t.Errorf("deliveries = %+v", ds)
+				}
+			}},
+		{name: "get delivery", method: http.MethodGet, path: "/api/webhook/deliveries/1", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if d := decode[dto.WebhookDeliveryDetailResponse](t, body); d.Payload != `{"id":"ev-failed"}` || d.NextAttemptAt != nil {
+					t.Errorf("delivery = %+v", d)
+				}
+			}},
+		{name: "get delivery missing", method: http.MethodGet, path: "/api/webhook/deliveries/99", status: http.StatusNotFound, code: "WEBHOOK_DELIVERY_NOT_FOUND"},
+		{name: "redeliver failed", method: http.MethodPost, path: "/api/webhook/deliveries/1/redeliver", status: http.StatusOK,
+			check: func(t *testing.T, body []byte) {
+				if d := decode[dto.WebhookDeliveryResponse](t, body); d.Status != models.WebhookDeliverySucceeded || d.Attempts != 1 || d.DeliveredAt == nil {
+					t.Errorf("delivery = %+v", d)
+				}
+			}},
+		{name: "redeliver pending", method: http.MethodPost, path: "/api/webhook/deliveries/2/redeliver", status: http.StatusConflict, code: "WEBHOOK_DELIVERY_PENDING"},
+		{name: "redeliver missing", method: http.MethodPost, path: "/api/webhook/deliveries/99/redeliver", status: http.StatusNotFound, code: "WEBHOOK_DELIVERY_NOT_FOUND"},
+	}, newWebhookRouter)
+}
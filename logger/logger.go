@@ -0,0 +1,91 @@
+// Package logger 는 log/slog 기반의 구조화 로깅을 설정합니다.
+// 요청 ID 와 트레이스 ID 는 context 에서 읽어 모든 로그 라인에 자동으로 추가되며,
+// 토큰/비밀번호/쿠키 등 민감한 값은 출력 전에 가려집니다.
+package logger
+
+import (
+	"context"
+	"io"
+	"log"
+	"log/slog"
+	"os"
+	"strings"
+
+	"github.com/baboyiban/go-api-server/config"
+	"go.opentelemetry.io/otel/trace"
+)
+
+type ctxKey struct{}
+
+// WithRequestID 요청 ID 를 context 에 저장
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, ctxKey{}, id)
+}
+
+// RequestID context 에 저장된 요청 ID
+func RequestID(ctx context.Context) string {
+	id, _ := ctx.Value(ctxKey{}).(string)
+	return id
+}
+
+// Setup 설정에 맞는 slog 기본 로거를 구성하고 표준 log 패키지 출력도 연결
+func Setup(cfg config.LogConfig) *slog.Logger {
+	l := New(os.Stdout, cfg)
+	slog.SetDefault(l)
+	log.SetFlags(0)
+	return l
+}
+
+// New 지정한 writer 로 출력하는 로거 생성
+func New(w io.Writer, cfg config.LogConfig) *slog.Logger {
+	opts := &slog.HandlerOptions{
+		Level:       ParseLevel(cfg.Level),
+		ReplaceAttr: redactAttr,
+	}
+	var h slog.Handler
+	if cfg.Format == "text" {
+		h = slog.NewTextHandler(w, opts)
+	} else {
+		h = slog.NewJSONHandler(w, opts)
+	}
+	return slog.New(&contextHandler{Handler: h})
+}
+
+// ParseLevel debug, info, warn, error 문자열을 slog 레벨로 변환 (기본 info)
+func ParseLevel(s string) slog.Level {
+	switch strings.ToLower(s) {
+	case "debug":
+		return slog.LevelDebug
+	case "warn", "warning":
+		return slog.LevelWarn
+	case "error":
+		return slog.LevelError
+	default:
+		return slog.LevelInfo
+	}
+}
+
+// contextHandler context 의 요청 ID 와 트레이스 ID 를 레코드에 추가
+type contextHandler struct {
+	slog.Handler
+}
+
+func (h *contextHandler) Handle(ctx context.Context, r slog.Record) error {
+	if ctx != nil {
+		if id := RequestID(ctx); id != "" {
+			r.AddAttrs(slog.String("request_id", id))
+		}
+		if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+			r.AddAttrs(slog.String("trace_id", sc.TraceID().String()))
+		}
+	}
+	return h.Handler.Handle(ctx, r)
+}
+
+func (h *contextHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithAttrs(attrs)}
+}
+
+func (h *contextHandler) WithGroup(name string) slog.Handler {
+	return &contextHandler{Handler: h.Handler.WithGroup(name)}
+}
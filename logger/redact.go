@@ -0,0 +1,38 @@
+package logger
+
+import (
+	"log/slog"
+	"regexp"
+	"strings"
+)
+
+const redacted = "[REDACTED]"
+
+// sensitiveKeys 값이 통째로 가려지는 속성 키 (소문자, 부분 일치)
+var sensitiveKeys = []string{"password", "token", "secret", "authorization", "cookie", "api_key", "apikey"}
+
+// bearerPattern 메시지 안에 섞여 들어간 Bearer 토큰과 JWT
+var bearerPattern = regexp.MustCompile(`(?i)bearer\s+[A-Za-z0-9\-_.]+|eyJ[A-Za-z0-9\-_]+\.[A-Za-z0-9\-_]+\.[A-Za-z0-9\-_]+`)
+
+func redactAttr(_ []string, a slog.Attr) slog.Attr {
+	if IsSensitiveKey(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+	if a.Value.Kind() == slog.KindString {
+		if v := a.Value.String(); bearerPattern.MatchString(v) {
+			return slog.String(a.Key, bearerPattern.ReplaceAllString(v, redacted))
+		}
+	}
+	return a
+}
+
+// IsSensitiveKey 키 이름으로 민감한 값인지 판단
+func IsSensitiveKey(key string) bool {
+	k := strings.ToLower(key)
+	for _, s := range sensitiveKeys {
+		if strings.Contains(k, s) {
+			return true
+		}
+	}
+	return false
+}
@@ -1,20 +1,44 @@
 package main
 
 import (
-	"log"
+	"context"
+	"errors"
+	"log/slog"
+	"net"
+	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
+	"github.com/baboyiban/go-api-server/cache"
+	"github.com/baboyiban/go-api-server/config"
 	_ "github.com/baboyiban/go-api-server/docs"
+	"github.com/baboyiban/go-api-server/graphqlapi"
+	"github.com/baboyiban/go-api-server/grpcapi"
+	"github.com/baboyiban/go-api-server/logger"
+	"github.com/baboyiban/go-api-server/metrics"
 	"github.com/baboyiban/go-api-server/middleware"
+	"github.com/baboyiban/go-api-server/mqtt"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/ratelimit"
+	"github.com/baboyiban/go-api-server/repository"
 	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/shutdown"
+	"github.com/baboyiban/go-api-server/tracing"
+	"github.com/baboyiban/go-api-server/utils"
+	"github.com/baboyiban/go-api-server/webhook"
 	"github.com/gin-gonic/gin"
+	"github.com/redis/go-redis/v9"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"google.golang.org/grpc"
 	"gorm.io/gorm"
 
 	"github.com/baboyiban/go-api-server/database"
 	"github.com/baboyiban/go-api-server/handlers"
 	"github.com/gin-contrib/cors"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
 )
 
 // @title           Go API Server
@@ -22,45 +46,318 @@ import (
 // @description     패키지 운송 시스템 API 문서입니다.
 // @host            localhost:3000
 // @BasePath        /
+
+// @securityDefinitions.apikey  DeviceKey
+// @in                          header
+// @name                        X-API-Key
+// @description                 auth.api_keys 에 등록된 장비 API 키
 func main() {
-	// 환경변수에서 GIN_MODE 읽어서 없으면 default는 debug 모드
-	mode := getEnv("GIN_MODE", gin.DebugMode)
-	gin.SetMode(mode)
+	cfg, err := config.Load(os.Args[1:])
+	if err != nil {
+		fatal("설정 로드 실패", err)
+	}
+	logger.Setup(cfg.Log)
+	slog.Info("적용된 설정", "config", cfg)
 
-	db := database.InitDB()
-	router := gin.Default()
+	gin.SetMode(cfg.Mode)
+	utils.ConfigureJWT(cfg.Auth.JWTSecret, cfg.Auth.TokenTTL)
+	if err := configurePassword(cfg.Auth.Password); err != nil {
+		fatal("비밀번호 정책 설정 실패", err)
+	}
+
+	shutdownTracing, err := tracing.Setup(context.Background(), cfg.Tracing)
+	if err != nil {
+		fatal("트레이싱 설정 실패", err)
+	}
+
+	// DB 연결을 기다리는 동안에도 종료 신호를 받으면 바로 종료
+	startCtx, stopStart := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	db, err := database.InitDB(startCtx, cfg.DB, cfg.Log.SlowQueryThreshold)
+	stopStart()
+	if err != nil {
+		fatal("DB 초기화 실패", err)
+	}
+	if err := metrics.RegisterDB(db); err != nil {
+		fatal("DB 메트릭 등록 실패", err)
+	}
+	if err := db.Use(tracing.GormPlugin{}); err != nil {
+		fatal("DB 트레이싱 등록 실패", err)
+	}
+
+	router := gin.New()
+	router.Use(middleware.RequestID())
+	router.Use(middleware.AccessLog())
+	router.Use(middleware.Recovery())
+	router.Use(otelgin.Middleware(cfg.Tracing.ServiceName, otelgin.WithFilter(isTracedRequest)))
+	router.Use(metrics.HTTPMiddleware())
 
 	// CORS 미들웨어 추가
 	router.Use(cors.New(cors.Config{
-		// AllowOrigins:     []string{"https://choidaruhan.xyz"}, // (배포용)
-		AllowOrigins:     []string{"*"}, // (테스트용)
+		AllowOrigins:     cfg.CORS.AllowOrigins,
 		AllowMethods:     []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type"},
-		ExposeHeaders:    []string{"Content-Length"},
+		AllowHeaders:     []string{"Origin", "Authorization", "Content-Type", middleware.RequestIDHeader, middleware.APIKeyHeader, cfg.Auth.Session.CSRFHeader},
+		ExposeHeaders:    []string{"Content-Length", middleware.RequestIDHeader, "Retry-After"},
 		AllowCredentials: true,
 	}))
 
 	router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-	registerRoutes(router, db)
+	healthHandler := handlers.NewHealthHandler(db)
+	router.GET("/healthz", healthHandler.Healthz)
+	router.GET("/readyz", healthHandler.Readyz)
+	router.GET("/version", healthHandler.Version)
+	router.GET("/metrics", gin.WrapH(metrics.Handler()))
+
+	// 요청 제한 버킷 정리, 아웃박스 처리, 웹훅 전송 등 백그라운드 작업의 수명
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	// 서비스는 저장소 인터페이스로 DB 에 접근
+	store := repository.NewGormStore(db, cfg.DB.DeadlockRetries)
+	employeeStatus := service.NewEmployeeStatusCache(store, cfg.Auth.StatusCacheTTL)
+	session := middleware.NewSession(cfg.Auth.Session)
+	authenticator := middleware.NewAuthenticator(employeeStatus, session)
+	// REST 와 gRPC 가 같은 요청 제한 저장소와 API 키 목록을 공유
+	var rateLimitStore ratelimit.Store
+	if cfg.RateLimit.Enabled {
+		rateLimitStore = ratelimit.NewMemoryStore(backgroundCtx, cfg.RateLimit.IdleTTL)
+	}
+	apiKeys := middleware.NewAPIKeys(cfg.Auth.APIKeys)
+	guards := newRouteGuards(cfg.RateLimit, rateLimitStore, authenticator, apiKeys)
+	// REST, gRPC, GraphQL, MQTT 브리지가 같은 조회 캐시를 공유해 어느 쪽에서 쓰든 무효화됨
+	readCache := newReadCache(cfg.Cache)
+
+	dispatcher := webhook.NewDispatcher(store, cfg.Webhook)
+	if cfg.Webhook.Enabled {
+		go dispatcher.Run(backgroundCtx)
+	}
+
+	// 도메인 이벤트는 아웃박스를 거쳐 프로세스 내 버스, 웹훅 구독, 차량(MQTT)으로 전달
+	bus := outbox.NewBus()
+	sinks := []outbox.Sink{bus, webhook.NewSink(store)}
+	var bridge *mqtt.Bridge
+	if cfg.MQTT.Enabled {
+		bridge = mqtt.NewBridge(cfg.MQTT, service.NewVehicleService(store, readCache), service.NewSorterService(store, readCache, cfg.Sorter.ScanTimeout), service.NewRegionService(store, readCache))
+		if err := bridge.Start(backgroundCtx); err != nil {
+			fatal("MQTT 브리지 시작 실패", err)
+		}
+		sinks = append(sinks, bridge)
+	}
+	outboxDispatcher := outbox.NewDispatcher(store, cfg.Outbox, sinks...)
+	if cfg.Outbox.Enabled {
+		go outboxDispatcher.Run(backgroundCtx)
+	}
+
+	registerRoutes(router.Group("", guards.api...), store, cfg, guards, employeeStatus, session, dispatcher, readCache)
+
+	if cfg.GraphQL.Enabled {
+		graphqlServer, err := graphqlapi.NewServer(cfg.GraphQL, cfg.CORS.AllowOrigins, store, bus, authenticator, employeeStatus, readCache)
+		if err != nil {
+			fatal("GraphQL 스키마 생성 실패", err)
+		}
+		graphqlHandler := handlers.NewGraphQLHandler(graphqlServer)
+		api := router.Group("", guards.api...)
+		api.POST("/graphql", guards.authRequired(graphqlHandler.Query)...)
+		// WebSocket 은 connection_init 에서 인증
+		api.GET("/graphql", graphqlHandler.Subscribe)
+	}
+
+	srv := &http.Server{
+		Addr:              ":" + cfg.HTTP.Port,
+		Handler:           router,
+		ReadTimeout:       cfg.HTTP.ReadTimeout,
+		ReadHeaderTimeout: cfg.HTTP.ReadHeaderTimeout,
+		WriteTimeout:      cfg.HTTP.WriteTimeout,
+		IdleTimeout:       cfg.HTTP.IdleTimeout,
+		MaxHeaderBytes:    cfg.HTTP.MaxHeaderBytes,
+	}
+
+	go func() {
+		slog.Info("서버 실행 중", "port", cfg.HTTP.Port)
+		if err := srv.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			fatal("서버 실행 실패", err)
+		}
+	}()
+
+	var grpcServer *grpc.Server
+	if cfg.GRPC.Enabled {
+		lis, err := net.Listen("tcp", ":"+cfg.GRPC.Port)
+		if err != nil {
+			fatal("gRPC 포트 열기 실패", err)
+		}
+		grpcServer = grpcapi.NewServer(cfg.GRPC, cfg.RateLimit, rateLimitStore, authenticator, apiKeys, store, bus, readCache)
+		go func() {
+			slog.Info("gRPC 서버 실행 중", "port", cfg.GRPC.Port)
+			if err := grpcServer.Serve(lis); err != nil {
+				fatal("gRPC 서버 실행 실패", err)
+			}
+		}()
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+	<-ctx.Done()
+	stop()
+	// 진행 중인 웹훅 전송은 중단되어도 선점 시간이 지나면 다시 전송됨
+	stopBackground()
+	if bridge != nil {
+		bridge.Stop()
+	}
+
+	gracefulShutdown(srv, grpcServer, db, shutdownTracing, cfg.HTTP.DrainDelay, cfg.HTTP.ShutdownTimeout)
+	if err := readCache.Close(); err != nil {
+		slog.Error("캐시 연결 종료 실패", "error", err)
+	}
+}
+
+// isTracedRequest 헬스체크와 메트릭 스크랩 요청은 트레이스에서 제외
+func isTracedRequest(r *http.Request) bool {
+	switch r.URL.Path {
+	case "/healthz", "/readyz", "/metrics":
+		return false
+	}
+	return true
+}
+
+// gracefulShutdown readiness 를 내리고, 진행 중인 요청을 마무리한 뒤 DB 풀을 닫음
+func gracefulShutdown(srv *http.Server, grpcServer *grpc.Server, db *gorm.DB, shutdownTracing tracing.ShutdownFunc, drainDelay, timeout time.Duration) {
+	slog.Info("종료 신호 수신: readiness 비활성화 및 이벤트 스트림 종료")
+	shutdown.Trigger()
+
+	// 로드밸런서가 readiness 실패를 감지하고 트래픽을 끊을 시간을 줌
+	if drainDelay > 0 {
+		slog.Info("신규 트래픽 차단 대기", "drain_delay", drainDelay.String())
+		time.Sleep(drainDelay)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), timeout)
+	defer cancel()
+
+	slog.Info("진행 중인 요청 처리 대기", "timeout", timeout.String())
+	if err := srv.Shutdown(ctx); err != nil {
+		slog.Warn("HTTP 서버 종료 시간 초과, 남은 연결을 강제로 닫습니다", "error", err)
+		_ = srv.Close()
+	} else {
+		slog.Info("HTTP 서버 종료 완료")
+	}
+	if grpcServer != nil {
+		stopGRPC(ctx, grpcServer)
+	}
+
+	if err := database.Close(db); err != nil {
+		slog.Error("DB 연결 종료 실패", "error", err)
+	} else {
+		slog.Info("DB 연결 종료 완료")
+	}
 
-	port := getEnv("BACKEND_PORT", "8080")
-	addr := ":" + port
-	log.Printf("서버가 %s 포트에서 실행 중...", port)
-	if err := router.Run(addr); err != nil {
-		log.Fatalf("서버 실행 실패: %v", err)
+	if err := shutdownTracing(ctx); err != nil {
+		slog.Error("트레이스 내보내기 종료 실패", "error", err)
 	}
 }
 
-func getEnv(key, fallback string) string {
-	if v, ok := os.LookupEnv(key); ok {
-		return v
+// stopGRPC 진행 중인 호출을 기다리되, 시간이 초과되면 남은 호출을 강제로 끊음.
+// Watch 스트림은 종료 신호를 받으면 스스로 끝나므로 GracefulStop 을 막지 않음
+func stopGRPC(ctx context.Context, grpcServer *grpc.Server) {
+	done := make(chan struct{})
+	go func() {
+		grpcServer.GracefulStop()
+		close(done)
+	}()
+	select {
+	case <-done:
+		slog.Info("gRPC 서버 종료 완료")
+	case <-ctx.Done():
+		slog.Warn("gRPC 서버 종료 시간 초과, 남은 호출을 강제로 끊습니다")
+		grpcServer.Stop()
+	}
+}
+
+// configurePassword 비밀번호 정책과 bcrypt cost 적용
+func configurePassword(cfg config.PasswordConfig) error {
+	policy := utils.PasswordPolicy{MinLength: cfg.MinLength, MinClasses: cfg.MinClasses}
+	if cfg.BreachedListFile != "" {
+		if err := policy.LoadBreachedList(cfg.BreachedListFile); err != nil {
+			return err
+		}
+		slog.Info("유출 비밀번호 목록 적재", "count", policy.BreachedCount())
+	}
+	utils.ConfigurePassword(cfg.BcryptCost, policy)
+	return nil
+}
+
+// newReadCache 설정한 저장소로 조회 캐시 생성. 꺼져 있으면 nil.
+// Redis 에 연결하지 못해도 조회는 DB 로 처리되므로 경고만 남기고 시작
+func newReadCache(cfg config.CacheConfig) *cache.Cache {
+	if !cfg.Enabled {
+		return nil
+	}
+	if cfg.Backend != "redis" {
+		return cache.New(cache.NewMemoryStore(cfg.MaxEntries), cfg.TTL)
+	}
+	client := redis.NewClient(&redis.Options{
+		Addr:     cfg.Redis.Addr,
+		Password: cfg.Redis.Password,
+		DB:       cfg.Redis.DB,
+	})
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if err := client.Ping(ctx).Err(); err != nil {
+		slog.Warn("Redis 캐시 연결 실패, 복구될 때까지 DB 에서 조회", "addr", cfg.Redis.Addr, "error", err)
 	}
-	return fallback
+	return cache.New(cache.NewRedisStore(client, cfg.Redis.KeyPrefix), cfg.TTL)
 }
 
-func registerRoutes(router *gin.Engine, db *gorm.DB) {
-	regionService := service.NewRegionService(db)
+// fatal 에러를 기록하고 프로세스를 종료
+func fatal(msg string, err error) {
+	slog.Error(msg, "error", err)
+	os.Exit(1)
+}
+
+// routeGuards 라우트에 적용할 인증 및 요청 제한 미들웨어 묶음
+type routeGuards struct {
+	auth     *middleware.Authenticator
+	apiKeys  *middleware.APIKeys
+	api      []gin.HandlerFunc // 전체 API (IP, 등록된 API 키 기준)
+	login    []gin.HandlerFunc // 로그인 (IP 기준)
+	employee []gin.HandlerFunc // 인증된 API (직원 기준, 인증 뒤에 적용)
+}
+
+func newRouteGuards(cfg config.RateLimitConfig, store ratelimit.Store, auth *middleware.Authenticator, apiKeys *middleware.APIKeys) routeGuards {
+	if !cfg.Enabled || store == nil {
+		return routeGuards{auth: auth, apiKeys: apiKeys}
+	}
+	rate := func(r config.RateRule) ratelimit.Rate {
+		return ratelimit.PerMinute(r.PerMinute, r.Burst)
+	}
+	return routeGuards{
+		auth:    auth,
+		apiKeys: apiKeys,
+		api: []gin.HandlerFunc{
+			middleware.RateLimit(store, "ip", rate(cfg.IP), middleware.KeyByIP),
+			middleware.RateLimit(store, "api_key", rate(cfg.APIKey), middleware.KeyByAPIKey(apiKeys)),
+		},
+		login: []gin.HandlerFunc{
+			middleware.RateLimit(store, "login", rate(cfg.Login), middleware.KeyByIP),
+		},
+		employee: []gin.HandlerFunc{
+			middleware.RateLimit(store, "employee", rate(cfg.Employee), middleware.KeyByEmployee),
+		},
+	}
+}
+
+// authRequired 인증 후 직원 기준 요청 제한을 적용하는 핸들러 체인
+func (g routeGuards) authRequired(handler gin.HandlerFunc, positions ...string) []gin.HandlerFunc {
+	chain := []gin.HandlerFunc{g.auth.Required(positions...)}
+	chain = append(chain, g.employee...)
+	return append(chain, handler)
+}
+
+// deviceRequired 등록된 API 키를 보낸 장비만 허용하는 핸들러 체인
+func (g routeGuards) deviceRequired(handler gin.HandlerFunc) []gin.HandlerFunc {
+	return []gin.HandlerFunc{middleware.RequireAPIKey(g.apiKeys), handler}
+}
+
+func registerRoutes(router gin.IRoutes, store repository.Store, cfg *config.Config, guards routeGuards, employeeStatus *service.EmployeeStatusCache, session *middleware.Session, dispatcher *webhook.Dispatcher, readCache *cache.Cache) {
+	regionService := service.NewRegionService(store, readCache)
 	regionHandler := handlers.NewRegionHandler(regionService)
 	router.POST("/api/region", regionHandler.CreateRegion)
 	router.GET("/api/region/:id", regionHandler.GetRegionByID)
@@ -69,7 +366,7 @@ func registerRoutes(router *gin.Engine, db *gorm.DB) {
 	router.GET("/api/region", regionHandler.ListRegions)
 	router.GET("/api/region/search", regionHandler.SearchRegions)
 
-	packageService := service.NewPackageService(db)
+	packageService := service.NewPackageService(store, readCache)
 	packageHandler := handlers.NewPackageHandler(packageService)
 	router.POST("/api/package", packageHandler.CreatePackage)
 	router.GET("/api/package/:id", packageHandler.GetPackageByID)
@@ -78,7 +375,10 @@ func registerRoutes(router *gin.Engine, db *gorm.DB) {
 	router.GET("/api/package", packageHandler.ListPackages)
 	router.GET("/api/package/search", packageHandler.SearchPackages)
 
-	vehicleService := service.NewVehicleService(db)
+	sorterHandler := handlers.NewSorterHandler(service.NewSorterService(store, readCache, cfg.Sorter.ScanTimeout))
+	router.POST("/api/sorter/scan", guards.deviceRequired(sorterHandler.Scan)...)
+
+	vehicleService := service.NewVehicleService(store, readCache)
 	vehicleHandler := handlers.NewVehicleHandler(vehicleService)
 	router.POST("/api/vehicle", vehicleHandler.CreateVehicle)
 	router.GET("/api/vehicle/:id", vehicleHandler.GetVehicleByID)
@@ -86,8 +386,11 @@ func registerRoutes(router *gin.Engine, db *gorm.DB) {
 	router.DELETE("/api/vehicle/:id", vehicleHandler.DeleteVehicle)
 	router.GET("/api/vehicle", vehicleHandler.ListVehicles)
 	router.GET("/api/vehicle/search", vehicleHandler.SearchVehicles)
+	router.POST("/api/vehicle/:id/confirmations", vehicleHandler.RaiseConfirmation)
+	router.GET("/api/vehicle/:id/confirmations", vehicleHandler.ListConfirmations)
+	router.POST("/api/vehicle/:id/confirmations/:cid/ack", guards.authRequired(vehicleHandler.AcknowledgeConfirmation)...)
 
-	tripLogService := service.NewTripLogService(db)
+	tripLogService := service.NewTripLogService(store)
 	tripLogHandler := handlers.NewTripLogHandler(tripLogService)
 	router.POST("/api/trip-log", tripLogHandler.CreateTripLog)
 	router.GET("/api/trip-log/:id", tripLogHandler.GetTripLogByID)
@@ -96,7 +399,7 @@ func registerRoutes(router *gin.Engine, db *gorm.DB) {
 	router.GET("/api/trip-log", tripLogHandler.ListTripLogs)
 	router.GET("/api/trip-log/search", tripLogHandler.SearchTripLogs)
 
-	deliveryLogService := service.NewDeliveryLogService(db)
+	deliveryLogService := service.NewDeliveryLogService(store)
 	deliveryLogHandler := handlers.NewDeliveryLogHandler(deliveryLogService)
 	router.POST("/api/delivery-log", deliveryLogHandler.CreateDeliveryLog)
 	router.GET("/api/delivery-log/:id", deliveryLogHandler.GetDeliveryLogByID)
@@ -105,18 +408,46 @@ func registerRoutes(router *gin.Engine, db *gorm.DB) {
 	router.GET("/api/delivery-log", deliveryLogHandler.ListDeliveryLogs)
 	router.GET("/api/delivery-log/search", deliveryLogHandler.SearchDeliveryLogs)
 
-	employeeService := service.NewEmployeeService(db)
+	employeeService := service.NewEmployeeService(store, employeeStatus)
 	employeeHandler := handlers.NewEmployeeHandler(employeeService)
-	router.POST("/api/employee", middleware.AuthRequired("관리직"), employeeHandler.CreateEmployee)
-	router.GET("/api/employee/:id", middleware.AuthRequired("관리직"), employeeHandler.GetEmployeeByID)
-	router.PUT("/api/employee/:id", middleware.AuthRequired("관리직"), employeeHandler.UpdateEmployee)
-	router.DELETE("/api/employee/:id", middleware.AuthRequired("관리직"), employeeHandler.DeleteEmployee)
-	router.GET("/api/employee", middleware.AuthRequired("관리직"), employeeHandler.ListEmployees)
-	router.GET("/api/employee/search", middleware.AuthRequired("관리직"), employeeHandler.SearchEmployees)
+	router.POST("/api/employee", guards.authRequired(employeeHandler.CreateEmployee, "관리직")...)
+	router.GET("/api/employee/:id", guards.authRequired(employeeHandler.GetEmployeeByID, "관리직")...)
+	router.PUT("/api/employee/:id", guards.authRequired(employeeHandler.UpdateEmployee, "관리직")...)
+	router.DELETE("/api/employee/:id", guards.authRequired(employeeHandler.DeleteEmployee, "관리직")...)
+	router.GET("/api/employee", guards.authRequired(employeeHandler.ListEmployees, "관리직")...)
+	router.GET("/api/employee/search", guards.authRequired(employeeHandler.SearchEmployees, "관리직")...)
+	router.POST("/api/employee/:id/deactivate", guards.authRequired(employeeHandler.DeactivateEmployee, "관리직")...)
+	router.POST("/api/employee/:id/reactivate", guards.authRequired(employeeHandler.ReactivateEmployee, "관리직")...)
+
+	shiftService := service.NewShiftService(store)
+	shiftHandler := handlers.NewShiftHandler(shiftService)
+	router.POST("/api/shift", guards.authRequired(shiftHandler.CreateShift, "관리직")...)
+	router.GET("/api/shift/:id", guards.authRequired(shiftHandler.GetShiftByID, "관리직")...)
+	router.DELETE("/api/shift/:id", guards.authRequired(shiftHandler.DeleteShift, "관리직")...)
+	router.GET("/api/shift", guards.authRequired(shiftHandler.ListShifts, "관리직")...)
+	router.POST("/api/shift/:id/end", guards.authRequired(shiftHandler.EndShift, "관리직")...)
+	router.GET("/api/employee/:id/trips", guards.authRequired(shiftHandler.EmployeeTrips)...)
+	router.GET("/api/auth/me/assignment", guards.authRequired(shiftHandler.MyAssignment)...)
+
+	webhookService := service.NewWebhookService(store, dispatcher)
+	webhookHandler := handlers.NewWebhookHandler(webhookService)
+	router.POST("/api/webhook", guards.authRequired(webhookHandler.CreateWebhook, "관리직")...)
+	router.GET("/api/webhook/:id", guards.authRequired(webhookHandler.GetWebhook, "관리직")...)
+	router.PUT("/api/webhook/:id", guards.authRequired(webhookHandler.UpdateWebhook, "관리직")...)
+	router.DELETE("/api/webhook/:id", guards.authRequired(webhookHandler.DeleteWebhook, "관리직")...)
+	router.GET("/api/webhook", guards.authRequired(webhookHandler.ListWebhooks, "관리직")...)
+	router.POST("/api/webhook/:id/test", guards.authRequired(webhookHandler.TestWebhook, "관리직")...)
+	router.GET("/api/webhook/:id/deliveries", guards.authRequired(webhookHandler.ListWebhookDeliveries, "관리직")...)
+	router.GET("/api/webhook/deliveries/:id", guards.authRequired(webhookHandler.GetWebhookDelivery, "관리직")...)
+	router.POST("/api/webhook/deliveries/:id/redeliver", guards.authRequired(webhookHandler.RedeliverWebhook, "관리직")...)
 
 	// auth
-	authService := service.NewAuthService(db)
-	authHandler := handlers.NewAuthHandler(authService)
-	router.POST("/api/auth/login", authHandler.Login)
-	router.GET("/api/auth/me", authHandler.Me)
+	authService := service.NewAuthService(store, employeeStatus, cfg.Auth)
+	authHandler := handlers.NewAuthHandler(authService, session)
+	router.POST("/api/auth/login", append(guards.login, authHandler.Login)...)
+	router.POST("/api/auth/logout", authHandler.Logout)
+	router.GET("/api/auth/me", guards.authRequired(authHandler.Me)...)
+	router.POST("/api/auth/password", guards.authRequired(authHandler.ChangePassword)...)
+	router.POST("/api/auth/password/reset", append(guards.login, authHandler.ResetPassword)...)
+	router.POST("/api/employee/:id/password-reset", guards.authRequired(authHandler.IssueResetToken, "관리직")...)
 }
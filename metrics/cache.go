@@ -0,0 +1,19 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var cacheRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+	Namespace: namespace,
+	Subsystem: "cache",
+	Name:      "requests_total",
+	Help:      "캐시 종류별 조회 결과 (hit, miss, error)",
+}, []string{"cache", "result"})
+
+func init() {
+	Registry.MustRegister(cacheRequestsTotal)
+}
+
+// ObserveCache 캐시 조회 결과 기록
+func ObserveCache(cache, result string) {
+	cacheRequestsTotal.WithLabelValues(cache, result).Inc()
+}
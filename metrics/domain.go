@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"gorm.io/gorm"
+)
+
+// domainScrapeTimeout 스크랩마다 실행되는 집계 쿼리의 최대 시간
+const domainScrapeTimeout = 3 * time.Second
+
+var (
+	packagesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "domain", "packages"),
+		"패키지 상태별 개수", []string{"status"}, nil)
+	regionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "domain", "regions"),
+		"포화 여부별 지역 개수", []string{"is_full"}, nil)
+	vehiclesNeedsConfirmationDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "domain", "vehicles_needs_confirmation"),
+		"확인이 필요한 차량 수", nil, nil)
+	tripsInProgressDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "domain", "trips_in_progress"),
+		"운행중인 운행 로그 수", []string{"fleet"}, nil)
+)
+
+// RegisterDB GORM 쿼리 메트릭, 커넥션 풀 메트릭, 도메인 집계 메트릭을 등록
+func RegisterDB(db *gorm.DB) error {
+	if err := db.Use(GormPlugin{}); err != nil {
+		return err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return err
+	}
+	if err := Registry.Register(collectors.NewDBStatsCollector(sqlDB, "mysql")); err != nil {
+		return err
+	}
+	return Registry.Register(&domainCollector{db: db})
+}
+
+// domainCollector 스크랩 시점에 DB 를 조회하여 도메인 상태를 게이지로 노출
+type domainCollector struct {
+	db *gorm.DB
+}
+
+func (c *domainCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- packagesDesc
+	ch <- regionsDesc
+	ch <- vehiclesNeedsConfirmationDesc
+	ch <- tripsInProgressDesc
+}
+
+func (c *domainCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), domainScrapeTimeout)
+	defer cancel()
+	db := c.db.WithContext(ctx)
+
+	var byStatus []struct {
+		PackageStatus string
+		Count         int64
+	}
+	if err := db.Model(&models.Package{}).
+		Select("package_status, COUNT(*) AS count").
+		Group("package_status").
+		Scan(&byStatus).Error; err != nil {
+		ch <- prometheus.NewInvalidMetric(packagesDesc, err)
+	} else {
+		for _, row := range byStatus {
+			ch <- prometheus.MustNewConstMetric(packagesDesc, prometheus.GaugeValue, float64(row.Count), row.PackageStatus)
+		}
+	}
+
+	var byFull []struct {
+		IsFull bool
+		Count  int64
+	}
+	if err := db.Model(&models.Region{}).
+		Select("is_full, COUNT(*) AS count").
+		Group("is_full").
+		Scan(&byFull).Error; err != nil {
+		ch <- prometheus.NewInvalidMetric(regionsDesc, err)
+	} else {
+		counts := map[bool]int64{}
+		for _, row := range byFull {
+			counts[row.IsFull] = row.Count
+		}
+		ch <- prometheus.MustNewConstMetric(regionsDesc, prometheus.GaugeValue, float64(counts[true]), "true")
+		ch <- prometheus.MustNewConstMetric(regionsDesc, prometheus.GaugeValue, float64(counts[false]), "false")
+	}
+
+	var needsConfirmation int64
+	if err := db.Model(&models.Vehicle{}).Where("needs_confirmation = ?", true).Count(&needsConfirmation).Error; err != nil {
+		ch <- prometheus.NewInvalidMetric(vehiclesNeedsConfirmationDesc, err)
+	} else {
+		ch <- prometheus.MustNewConstMetric(vehiclesNeedsConfirmationDesc, prometheus.GaugeValue, float64(needsConfirmation))
+	}
+
+	for fleet, model := range map[string]any{"A": &models.TripLog{}, "B": &models.TripLogB{}} {
+		var inProgress int64
+		if err := db.Model(model).Where("status = ?", "운행중").Count(&inProgress).Error; err != nil {
+			ch <- prometheus.NewInvalidMetric(tripsInProgressDesc, err)
+			continue
+		}
+		ch <- prometheus.MustNewConstMetric(tripsInProgressDesc, prometheus.GaugeValue, float64(inProgress), fleet)
+	}
+}
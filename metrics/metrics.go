@@ -0,0 +1,31 @@
+// Package metrics 는 Prometheus 메트릭 수집과 /metrics 엔드포인트를 제공합니다.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const namespace = "api"
+
+// Registry 서버 전용 메트릭 레지스트리
+var Registry = prometheus.NewRegistry()
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+	)
+}
+
+// Handler /metrics 엔드포인트 핸들러
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{
+		Registry: Registry,
+		// 도메인 집계 쿼리 하나가 실패해도 나머지 메트릭은 노출
+		ErrorHandling: promhttp.ContinueOnError,
+	})
+}
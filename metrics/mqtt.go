@@ -0,0 +1,49 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	mqttMessagesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mqtt",
+		Name:      "messages_total",
+		Help:      "수신한 MQTT 메시지 처리 결과 (ok, invalid, not_found, error)",
+	}, []string{"kind", "result"})
+
+	mqttCommandsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "mqtt",
+		Name:      "commands_total",
+		Help:      "차량으로 발행한 명령 결과 (ok, error)",
+	}, []string{"command", "result"})
+
+	mqttConnected = prometheus.NewGauge(prometheus.GaugeOpts{
+		Namespace: namespace,
+		Subsystem: "mqtt",
+		Name:      "connected",
+		Help:      "브로커 연결 여부 (1 이면 연결됨)",
+	})
+)
+
+func init() {
+	Registry.MustRegister(mqttMessagesTotal, mqttCommandsTotal, mqttConnected)
+}
+
+// ObserveMQTTMessage 수신 메시지 처리 결과 기록
+func ObserveMQTTMessage(kind, result string) {
+	mqttMessagesTotal.WithLabelValues(kind, result).Inc()
+}
+
+// ObserveMQTTCommand 명령 발행 결과 기록
+func ObserveMQTTCommand(command, result string) {
+	mqttCommandsTotal.WithLabelValues(command, result).Inc()
+}
+
+// SetMQTTConnected 브로커 연결 상태 갱신
+func SetMQTTConnected(connected bool) {
+	v := 0.0
+	if connected {
+		v = 1
+	}
+	mqttConnected.Set(v)
+}
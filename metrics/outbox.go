@@ -0,0 +1,33 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	outboxDispatchTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "outbox",
+		Name:      "dispatch_total",
+		Help:      "Sink 별 아웃박스 이벤트 전달 결과 (ok, error)",
+	}, []string{"sink", "result"})
+
+	outboxBusDroppedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "outbox",
+		Name:      "bus_dropped_total",
+		Help:      "구독자 버퍼가 가득 차 버린 프로세스 내 이벤트 수",
+	}, []string{"event"})
+)
+
+func init() {
+	Registry.MustRegister(outboxDispatchTotal, outboxBusDroppedTotal)
+}
+
+// ObserveOutboxDispatch Sink 하나에 대한 전달 결과 기록
+func ObserveOutboxDispatch(sink, result string) {
+	outboxDispatchTotal.WithLabelValues(sink, result).Inc()
+}
+
+// ObserveBusDrop 느린 구독자 때문에 버린 이벤트 기록
+func ObserveBusDrop(event string) {
+	outboxBusDroppedTotal.WithLabelValues(event).Inc()
+}
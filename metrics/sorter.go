@@ -0,0 +1,24 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var sorterScanDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+	Namespace: namespace,
+	Subsystem: "sorter",
+	Name:      "scan_duration_seconds",
+	Help:      "분류기 스캔 처리 시간과 결정 (divert, overflow, reject, error)",
+	Buckets:   []float64{.005, .01, .025, .05, .1, .2, .3, .5, 1},
+}, []string{"decision"})
+
+func init() {
+	Registry.MustRegister(sorterScanDuration)
+}
+
+// ObserveSorterScan 스캔 하나의 결정과 처리 시간 기록
+func ObserveSorterScan(decision string, d time.Duration) {
+	sorterScanDuration.WithLabelValues(decision).Observe(d.Seconds())
+}
@@ -0,0 +1,34 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	webhookDeliveriesTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "webhook",
+		Name:      "attempts_total",
+		Help:      "웹훅 전송 시도 수 (succeeded, retry, failed)",
+	}, []string{"event", "result"})
+
+	webhookDeliveryDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "webhook",
+		Name:      "attempt_duration_seconds",
+		Help:      "웹훅 전송 요청 시간",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"event"})
+)
+
+func init() {
+	Registry.MustRegister(webhookDeliveriesTotal, webhookDeliveryDuration)
+}
+
+// ObserveWebhookAttempt 웹훅 전송 시도 결과와 소요 시간 기록
+func ObserveWebhookAttempt(event, result string, d time.Duration) {
+	webhookDeliveriesTotal.WithLabelValues(event, result).Inc()
+	webhookDeliveryDuration.WithLabelValues(event).Observe(d.Seconds())
+}
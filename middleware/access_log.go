@@ -0,0 +1,53 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/gin-gonic/gin"
+)
+
+// AccessLog 요청마다 한 줄의 구조화된 접근 로그를 남김
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		attrs := []any{
+			"method", c.Request.Method,
+			"route", c.FullPath(),
+			"path", c.Request.URL.Path,
+			"status", status,
+			"latency_ms", float64(time.Since(start).Microseconds()) / 1000,
+			"bytes", c.Writer.Size(),
+			"client_ip", c.ClientIP(),
+			"user_agent", c.Request.UserAgent(),
+		}
+		if id, ok := c.Get("employee_id"); ok {
+			attrs = append(attrs, "employee_id", id)
+		}
+		if len(c.Errors) > 0 {
+			attrs = append(attrs, "errors", c.Errors.String())
+		}
+
+		level := slog.LevelInfo
+		switch {
+		case status >= 500:
+			level = slog.LevelError
+		case status >= 400:
+			level = slog.LevelWarn
+		}
+		slog.Log(c.Request.Context(), level, "http request", attrs...)
+	}
+}
+
+// Recovery 패닉을 500 으로 변환하고 요청 ID 와 함께 기록
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(nil, func(c *gin.Context, err any) {
+		slog.ErrorContext(c.Request.Context(), "panic recovered", "panic", err, "path", c.Request.URL.Path)
+		apperror.Abort(c, apperror.Internal(fmt.Errorf("panic: %v", err)), "")
+	})
+}
@@ -0,0 +1,54 @@
+package middleware
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeys 설정에 등록된 API 키 집합. REST 와 gRPC 가 같은 인스턴스로 키를 확인함
+type APIKeys struct {
+	ids map[[sha256.Size]byte]string // 키 해시 → 요청 제한 등에 쓰는 키 식별자
+}
+
+// NewAPIKeys 등록된 키로 검증기 생성. 키 원문은 보관하지 않음
+func NewAPIKeys(keys []string) *APIKeys {
+	k := &APIKeys{ids: make(map[[sha256.Size]byte]string, len(keys))}
+	for _, key := range keys {
+		if key != "" {
+			k.ids[sha256.Sum256([]byte(key))] = HashAPIKey(key)
+		}
+	}
+	return k
+}
+
+// Lookup 등록된 키면 키 식별자와 true. 키가 비었거나 등록되지 않았으면 false
+func (k *APIKeys) Lookup(key string) (string, bool) {
+	if k == nil || key == "" {
+		return "", false
+	}
+	id, ok := k.ids[sha256.Sum256([]byte(key))]
+	return id, ok
+}
+
+// RequireAPIKey 등록된 X-API-Key 를 보낸 장비, 연동 시스템만 허용
+func RequireAPIKey(keys *APIKeys) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := keys.Lookup(c.GetHeader(APIKeyHeader)); !ok {
+			apperror.Abort(c, apperror.ErrInvalidAPIKey, "auth")
+			return
+		}
+		c.Next()
+	}
+}
+
+// HashAPIKey 요청 제한 키로 쓰는 API 키 해시. 키가 비어 있으면 빈 문자열
+func HashAPIKey(key string) string {
+	if key == "" {
+		return ""
+	}
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:8])
+}
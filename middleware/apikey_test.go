@@ -0,0 +1,75 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestAPIKeys_Lookup(t *testing.T) {
+	keys := NewAPIKeys([]string{"device-1", "", "device-2"})
+	tests := []struct {
+		name string
+		keys *APIKeys
+		key  string
+		ok   bool
+	}{
+		{"registered", keys, "device-1", true},
+		{"other registered", keys, "device-2", true},
+		{"unregistered", keys, "device-3", false},
+		{"empty", keys, "", false},
+		{"no keys configured", nil, "device-1", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			id, ok := tt.keys.Lookup(tt.key)
+			if ok != tt.ok {
+				t.Fatalf("ok = %v, want %v", ok, tt.ok)
+			}
+			// 식별자는 키 원문이 아닌 해시
+			if ok && (id != HashAPIKey(tt.key) || id == tt.key) {
+				t.Errorf("id = %q", id)
+			}
+		})
+	}
+	if a, _ := keys.Lookup("device-1"); a == HashAPIKey("device-2") {
+		t.Error("different keys share an id")
+	}
+}
+
+func TestRequireAPIKey(t *testing.T) {
+	tests := []struct {
+		name   string
+		keys   *APIKeys
+		header string // X-API-Key
+		status int
+		code   string
+	}{
+		{"registered", registeredKeys, "key-1", http.StatusOK, ""},
+		{"missing", registeredKeys, "", http.StatusUnauthorized, "INVALID_API_KEY"},
+		{"unregistered", registeredKeys, "key-3", http.StatusUnauthorized, "INVALID_API_KEY"},
+		{"no keys configured", NewAPIKeys(nil), "key-1", http.StatusUnauthorized, "INVALID_API_KEY"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(APIKeyHeader, tt.header)
+			}
+			rec := serve(req, RequireAPIKey(tt.keys))
+			if rec.Code != tt.status || problemCode(rec) != tt.code {
+				t.Errorf("status = %d, code = %q; want %d, %q", rec.Code, problemCode(rec), tt.status, tt.code)
+			}
+		})
+	}
+}
+
+func TestHashAPIKey(t *testing.T) {
+	if HashAPIKey("") != "" {
+		t.Error("empty key hashed")
+	}
+	h := HashAPIKey("secret")
+	if len(h) != 16 || h == "secret" || h != HashAPIKey("secret") || h == HashAPIKey("other") {
+		t.Errorf("HashAPIKey(secret) = %q", h)
+	}
+}
@@ -1,46 +1,93 @@
 package middleware
 
 import (
-	"net/http"
+	"context"
 	"slices"
 	"strings"
 
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/service"
 	"github.com/baboyiban/go-api-server/utils"
 	"github.com/gin-gonic/gin"
-	"github.com/golang-jwt/jwt/v5"
 )
 
-var jwtSecret = utils.JwtSecret
+// Authenticator JWT 를 검증하고, 토큰의 클레임 대신 직원의 현재 상태(활성 여부, 직급)로 권한을 판단
+type Authenticator struct {
+	status  *service.EmployeeStatusCache
+	session *Session
+}
+
+func NewAuthenticator(status *service.EmployeeStatusCache, session *Session) *Authenticator {
+	return &Authenticator{status: status, session: session}
+}
 
-func AuthRequired(allowedPositions ...string) gin.HandlerFunc {
+// Required 인증된 활성 직원만 허용. allowedPositions 가 있으면 현재 직급이 그중 하나여야 함
+func (a *Authenticator) Required(allowedPositions ...string) gin.HandlerFunc {
 	return func(c *gin.Context) {
-		authHeader := c.GetHeader("Authorization")
-		if !strings.HasPrefix(authHeader, "Bearer ") {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Missing or invalid token"})
-			return
-		}
-		tokenStr := strings.TrimPrefix(authHeader, "Bearer ")
-		token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (any, error) {
-			return jwtSecret, nil
-		})
-		if err != nil || !token.Valid {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid token"})
+		// Bearer 헤더가 우선이며, 없으면 세션 쿠키를 사용. 쿠키는 브라우저가 자동으로 보내므로 CSRF 검증 필요
+		var tokenStr string
+		if authHeader := c.GetHeader("Authorization"); strings.HasPrefix(authHeader, "Bearer ") {
+			tokenStr = strings.TrimPrefix(authHeader, "Bearer ")
+		} else if tokenStr = a.session.token(c); tokenStr != "" {
+			if !a.session.validCSRF(c) {
+				apperror.Abort(c, apperror.ErrCSRF, "auth")
+				return
+			}
+		} else {
+			apperror.Abort(c, apperror.ErrUnauthorized, "auth")
 			return
 		}
-		claims, ok := token.Claims.(jwt.MapClaims)
-		if !ok {
-			c.AbortWithStatusJSON(http.StatusUnauthorized, gin.H{"error": "Invalid claims"})
-			return
-		}
-		// 권한 체크
-		userPosition := claims["position"].(string)
-		if len(allowedPositions) > 0 && !slices.Contains(allowedPositions, userPosition) {
-			c.AbortWithStatusJSON(http.StatusForbidden, gin.H{"error": "Forbidden"})
+		employeeID, position, err := a.Authenticate(c.Request.Context(), tokenStr, allowedPositions...)
+		if err != nil {
+			apperror.Abort(c, err, "auth")
 			return
 		}
 		// 필요시 context에 정보 저장
-		c.Set("employee_id", claims["employee_id"])
-		c.Set("position", userPosition)
+		c.Set("employee_id", float64(employeeID))
+		c.Set("position", position)
 		c.Next()
 	}
 }
+
+// Authenticate JWT 를 검증하고 직원의 현재 상태로 권한을 확인. gRPC 등 gin 밖의 전송 계층에서도 사용
+func (a *Authenticator) Authenticate(ctx context.Context, tokenStr string, allowedPositions ...string) (int, string, error) {
+	claims, err := utils.ParseJWT(tokenStr)
+	if err != nil {
+		return 0, "", apperror.ErrInvalidToken
+	}
+	employeeID, ok := claims["employee_id"].(float64)
+	if !ok {
+		return 0, "", apperror.ErrInvalidToken.WithDetail("invalid token claims")
+	}
+
+	// 토큰 발급 이후의 비활성화나 직급 변경을 반영
+	status, err := a.status.Status(ctx, int(employeeID))
+	if err != nil {
+		return 0, "", err
+	}
+	if !status.Exists {
+		return 0, "", apperror.ErrInvalidToken.WithDetail("employee no longer exists")
+	}
+	// 버전 클레임이 없는 토큰은 0 으로 간주
+	if version, _ := claims["ver"].(float64); int(version) < status.TokenVersion {
+		return 0, "", apperror.ErrInvalidToken.WithDetail("token revoked by password change")
+	}
+	if !status.IsActive {
+		return 0, "", apperror.ErrAccountDisabled
+	}
+	// 권한 체크
+	if len(allowedPositions) > 0 && !slices.Contains(allowedPositions, status.Position) {
+		return 0, "", apperror.ErrForbidden
+	}
+	return int(employeeID), status.Position, nil
+}
+
+// EmployeeID Authenticator 가 저장한 로그인 직원 ID
+func EmployeeID(c *gin.Context) (int, bool) {
+	v, ok := c.Get("employee_id")
+	if !ok {
+		return 0, false
+	}
+	id, ok := v.(float64)
+	return int(id), ok
+}
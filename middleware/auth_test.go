@@ -0,0 +1,104 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	"github.com/baboyiban/go-api-server/utils"
+	"github.com/gin-gonic/gin"
+)
+
+func TestAuthenticator_Required(t *testing.T) {
+	store := repository.NewMemoryStore()
+	for _, emp := range []*models.Employee{
+		{Name: "manager", Password: "x", Position: "관리직", IsActive: true},
+		{Name: "inactive", Password: "x", Position: "관리직"},
+		{Name: "changed password", Password: "x", Position: "관리직", IsActive: true, TokenVersion: 2},
+		{Name: "driver", Password: "x", Position: "운송직", IsActive: true},
+	} {
+		if err := store.Employees().Create(context.Background(), emp); err != nil {
+			t.Fatal(err)
+		}
+	}
+	token := func(id, version int) string {
+		s, err := utils.GenerateJWT(id, "관리직", version)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+	sessionCfg := config.Default().Auth.Session
+	sessionCfg.Enabled = true
+	enabled := NewSession(sessionCfg)
+	disabled := NewSession(config.Default().Auth.Session)
+
+	tests := []struct {
+		name    string
+		session *Session
+		method  string
+		bearer  string
+		cookie  string
+		csrf    string // CSRF 쿠키와 헤더에 같은 값을 보냄
+		status  int
+		code    string
+		want    string // 통과했을 때 context 에 저장된 "ID:직급"
+	}{
+		{name: "no credentials", status: http.StatusUnauthorized, code: "UNAUTHORIZED"},
+		{name: "malformed token", bearer: "not-a-jwt", status: http.StatusUnauthorized, code: "INVALID_TOKEN"},
+		{name: "bearer", bearer: token(1, 0), status: http.StatusOK, want: "1:관리직"},
+		{name: "deleted employee", bearer: token(99, 0), status: http.StatusUnauthorized, code: "INVALID_TOKEN"},
+		{name: "inactive", bearer: token(2, 0), status: http.StatusForbidden, code: "ACCOUNT_DISABLED"},
+		{name: "issued before password change", bearer: token(3, 1), status: http.StatusUnauthorized, code: "INVALID_TOKEN"},
+		{name: "issued after password change", bearer: token(3, 2), status: http.StatusOK, want: "3:관리직"},
+		// 토큰의 직급이 아니라 현재 직급으로 판단
+		{name: "position from store", bearer: token(4, 0), status: http.StatusForbidden, code: "FORBIDDEN"},
+		{name: "cookie read", session: enabled, method: http.MethodGet, cookie: token(1, 0), status: http.StatusOK, want: "1:관리직"},
+		{name: "cookie write without csrf", session: enabled, method: http.MethodPost, cookie: token(1, 0), status: http.StatusForbidden, code: "CSRF_TOKEN_INVALID"},
+		{name: "cookie write with csrf", session: enabled, method: http.MethodPost, cookie: token(1, 0), csrf: "abc", status: http.StatusOK, want: "1:관리직"},
+		{name: "cookie ignored when sessions disabled", session: disabled, method: http.MethodGet, cookie: token(1, 0), status: http.StatusUnauthorized, code: "UNAUTHORIZED"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			session := tt.session
+			if session == nil {
+				session = disabled
+			}
+			auth := NewAuthenticator(service.NewEmployeeStatusCache(store, 0), session)
+
+			method := tt.method
+			if method == "" {
+				method = http.MethodGet
+			}
+			req := httptest.NewRequest(method, "/", nil)
+			if tt.bearer != "" {
+				req.Header.Set("Authorization", "Bearer "+tt.bearer)
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: sessionCfg.CookieName, Value: tt.cookie})
+			}
+			if tt.csrf != "" {
+				req.AddCookie(&http.Cookie{Name: sessionCfg.CSRFCookieName, Value: tt.csrf})
+				req.Header.Set(sessionCfg.CSRFHeader, tt.csrf)
+			}
+			var got string
+			rec := serve(req, auth.Required("관리직"), func(c *gin.Context) {
+				id, _ := EmployeeID(c)
+				got = fmt.Sprintf("%d:%s", id, c.GetString("position"))
+			})
+
+			if rec.Code != tt.status || problemCode(rec) != tt.code {
+				t.Fatalf("status = %d, code = %q; want %d, %q", rec.Code, problemCode(rec), tt.status, tt.code)
+			}
+			if got != tt.want {
+				t.Errorf("context employee = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/utils"
+	"github.com/gin-gonic/gin"
+)
+
+func TestMain(m *testing.M) {
+	gin.SetMode(gin.TestMode)
+	utils.ConfigureJWT("test-secret-test-secret-test-secret", time.Hour)
+	os.Exit(m.Run())
+}
+
+// serve 미들웨어 뒤에 200 을 반환하는 핸들러를 두고 요청을 보냄
+func serve(req *http.Request, handlers ...gin.HandlerFunc) *httptest.ResponseRecorder {
+	r := gin.New()
+	r.Any("/", append(handlers, func(c *gin.Context) { c.Status(http.StatusOK) })...)
+	rec := httptest.NewRecorder()
+	r.ServeHTTP(rec, req)
+	return rec
+}
+
+// problemCode 에러 응답의 code. 성공 응답이면 빈 문자열
+func problemCode(rec *httptest.ResponseRecorder) string {
+	var problem dto.Problem
+	_ = json.Unmarshal(rec.Body.Bytes(), &problem)
+	return problem.Code
+}
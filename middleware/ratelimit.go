@@ -0,0 +1,66 @@
+package middleware
+
+import (
+	"fmt"
+	"log/slog"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/ratelimit"
+	"github.com/gin-gonic/gin"
+)
+
+// APIKeyHeader 장비, 연동 시스템이 API 키를 보내는 헤더
+const APIKeyHeader = "X-API-Key"
+
+// KeyFunc 요청에서 제한 단위를 추출. 빈 문자열이면 해당 요청은 제한하지 않음
+type KeyFunc func(c *gin.Context) string
+
+// KeyByIP 클라이언트 IP 기준
+func KeyByIP(c *gin.Context) string {
+	return c.ClientIP()
+}
+
+// KeyByEmployee 인증된 직원 기준. Authenticator.Required 뒤에 위치해야 함
+func KeyByEmployee(c *gin.Context) string {
+	id, ok := c.Get("employee_id")
+	if !ok || id == nil {
+		return ""
+	}
+	return fmt.Sprint(id)
+}
+
+// KeyByAPIKey 등록된 X-API-Key 기준. 키 원문이 저장소에 남지 않도록 해시를 사용.
+// 등록되지 않은 키는 제한 단위가 되지 않으므로 헤더 값을 바꿔도 IP 기준 제한을 피할 수 없음
+func KeyByAPIKey(keys *APIKeys) KeyFunc {
+	return func(c *gin.Context) string {
+		id, _ := keys.Lookup(c.GetHeader(APIKeyHeader))
+		return id
+	}
+}
+
+// RateLimit name 별로 분리된 토큰 버킷으로 요청을 제한하고, 초과 시 Retry-After 와 함께 429 응답.
+// 저장소 오류 시에는 서비스를 막지 않도록 요청을 통과시킴
+func RateLimit(store ratelimit.Store, name string, rate ratelimit.Rate, key KeyFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if !rate.Enabled() {
+			c.Next()
+			return
+		}
+		k := key(c)
+		if k == "" {
+			c.Next()
+			return
+		}
+		allowed, retryAfter, err := store.Allow(c.Request.Context(), name+":"+k, rate)
+		if err != nil {
+			slog.WarnContext(c.Request.Context(), "rate limit store error", "limiter", name, "error", err)
+			c.Next()
+			return
+		}
+		if !allowed {
+			apperror.Abort(c, apperror.ErrRateLimited.WithRetryAfter(retryAfter), "")
+			return
+		}
+		c.Next()
+	}
+}
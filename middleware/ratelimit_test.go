@@ -0,0 +1,81 @@
+package middleware
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/ratelimit"
+)
+
+// failingStore 항상 에러를 반환하는 저장소
+type failingStore struct{}
+
+func (failingStore) Allow(context.Context, string, ratelimit.Rate) (bool, time.Duration, error) {
+	return false, 0, errors.New("redis down")
+}
+
+// registeredKeys 테스트에서 등록된 것으로 취급하는 API 키
+var registeredKeys = NewAPIKeys([]string{"key-1", "key-2"})
+
+func TestRateLimit(t *testing.T) {
+	rate := ratelimit.PerMinute(1, 1)
+	byAPIKey := KeyByAPIKey(registeredKeys)
+	tests := []struct {
+		name   string
+		store  ratelimit.Store
+		rate   ratelimit.Rate
+		key    KeyFunc
+		header string // X-API-Key
+		// 같은 키로 두 번 요청했을 때 두 번째 응답
+		status     int
+		retryAfter string
+	}{
+		{"limited", nil, rate, byAPIKey, "key-1", http.StatusTooManyRequests, "60"},
+		{"disabled rate", nil, ratelimit.Rate{}, byAPIKey, "key-1", http.StatusOK, ""},
+		{"no key", nil, rate, byAPIKey, "", http.StatusOK, ""},
+		// 등록되지 않은 키는 별도 버킷을 만들지 않고 IP 기준 제한만 받음
+		{"unregistered key", nil, rate, byAPIKey, "random", http.StatusOK, ""},
+		{"unauthenticated employee", nil, rate, KeyByEmployee, "key-1", http.StatusOK, ""},
+		// 저장소 장애로 서비스를 막지 않음
+		{"store error", failingStore{}, rate, byAPIKey, "key-1", http.StatusOK, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := tt.store
+			if store == nil {
+				ctx, cancel := context.WithCancel(context.Background())
+				t.Cleanup(cancel)
+				store = ratelimit.NewMemoryStore(ctx, time.Minute)
+			}
+			mw := RateLimit(store, "test", tt.rate, tt.key)
+			var rec *httptest.ResponseRecorder
+			for range 2 {
+				req := httptest.NewRequest(http.MethodGet, "/", nil)
+				if tt.header != "" {
+					req.Header.Set(APIKeyHeader, tt.header)
+				}
+				rec = serve(req, mw)
+			}
+			if rec.Code != tt.status || rec.Header().Get("Retry-After") != tt.retryAfter {
+				t.Errorf("status = %d, Retry-After = %q; want %d, %q", rec.Code, rec.Header().Get("Retry-After"), tt.status, tt.retryAfter)
+			}
+		})
+	}
+}
+
+func TestRateLimit_SeparateKeys(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	mw := RateLimit(ratelimit.NewMemoryStore(ctx, time.Minute), "test", ratelimit.PerMinute(1, 1), KeyByAPIKey(registeredKeys))
+	for _, key := range []string{"key-1", "key-2"} {
+		req := httptest.NewRequest(http.MethodGet, "/", nil)
+		req.Header.Set(APIKeyHeader, key)
+		if rec := serve(req, mw); rec.Code != http.StatusOK {
+			t.Errorf("%s: status = %d", key, rec.Code)
+		}
+	}
+}
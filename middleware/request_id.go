@@ -0,0 +1,36 @@
+package middleware
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"regexp"
+
+	"github.com/baboyiban/go-api-server/logger"
+	"github.com/gin-gonic/gin"
+)
+
+// RequestIDHeader 요청 ID 를 주고받는 헤더
+const RequestIDHeader = "X-Request-ID"
+
+// validRequestID 외부에서 받은 요청 ID 는 로그 인젝션을 막기 위해 형식을 제한
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9\-_.:]{1,128}$`)
+
+// RequestID X-Request-ID 를 받아들이거나 새로 생성하여 context, 응답 헤더, 로그에 연결
+func RequestID() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		id := c.GetHeader(RequestIDHeader)
+		if !validRequestID.MatchString(id) {
+			id = newRequestID()
+		}
+		c.Set("request_id", id)
+		c.Request = c.Request.WithContext(logger.WithRequestID(c.Request.Context(), id))
+		c.Header(RequestIDHeader, id)
+		c.Next()
+	}
+}
+
+func newRequestID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
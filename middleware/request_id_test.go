@@ -0,0 +1,40 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+)
+
+func TestRequestID(t *testing.T) {
+	tests := []struct {
+		name   string
+		header string
+		keep   bool
+	}{
+		{"accepted", "abc-123_x.y:z", true},
+		{"generated when missing", "", false},
+		// 로그 인젝션을 막기 위해 허용하지 않는 문자가 있으면 새로 생성
+		{"replaced when invalid", "abc\nlevel=error", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/", nil)
+			if tt.header != "" {
+				req.Header.Set(RequestIDHeader, tt.header)
+			}
+			var inContext string
+			rec := serve(req, RequestID(), func(c *gin.Context) { inContext = c.GetString("request_id") })
+
+			got := rec.Header().Get(RequestIDHeader)
+			if got != inContext || !validRequestID.MatchString(got) {
+				t.Fatalf("response id %q, context id %q", got, inContext)
+			}
+			if (got == tt.header) != tt.keep {
+				t.Errorf("request id = %q for header %q", got, tt.header)
+			}
+		})
+	}
+}
@@ -0,0 +1,45 @@
+package middleware
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/gin-gonic/gin"
+)
+
+func TestSession_StartEnd(t *testing.T) {
+	cfg := config.Default().Auth.Session
+	cfg.Enabled = true
+	cfg.SameSite = "strict"
+	s := NewSession(cfg)
+
+	rec := serve(httptest.NewRequest(http.MethodPost, "/", nil), func(c *gin.Context) {
+		if err := s.Start(c, "jwt", 0); err != nil {
+			t.Fatal(err)
+		}
+	})
+	cookies := map[string]*http.Cookie{}
+	for _, c := range rec.Result().Cookies() {
+		cookies[c.Name] = c
+	}
+	token, csrf := cookies[cfg.CookieName], cookies[cfg.CSRFCookieName]
+	if token == nil || token.Value != "jwt" || !token.HttpOnly || !token.Secure || token.SameSite != http.SameSiteStrictMode {
+		t.Errorf("token cookie = %+v", token)
+	}
+	// 스크립트가 읽어 헤더로 보내야 하므로 HttpOnly 가 아님
+	if csrf == nil || len(csrf.Value) != 64 || csrf.HttpOnly {
+		t.Errorf("csrf cookie = %+v", csrf)
+	}
+
+	rec = serve(httptest.NewRequest(http.MethodPost, "/", nil), func(c *gin.Context) { s.End(c) })
+	if n := len(rec.Result().Cookies()); n != 2 {
+		t.Fatalf("End set %d cookies, want 2", n)
+	}
+	for _, c := range rec.Result().Cookies() {
+		if c.MaxAge >= 0 {
+			t.Errorf("cookie %s not expired: %+v", c.Name, c)
+		}
+	}
+}
@@ -1,10 +1,29 @@
 package models
 
+import "time"
+
 type Employee struct {
 	EmployeeID int    `json:"employee_id" gorm:"column:employee_id;type:int;primaryKey;autoIncrement"`
-	Password   string `json:"password" gorm:"column:password;type:varchar(60);not null"`
-	Position   string `json:"position" gorm:"column:position;type:enum('관리직','운송직');not null"`
-	IsActive   bool   `json:"is_active" gorm:"column:is_active;type:boolean;not null;default:true"`
+	Password   string `json:"-" gorm:"column:password;type:varchar(60);not null"` // bcrypt 해시. 응답에 포함되지 않도록 직렬화하지 않음
+	Position   string `json:"position" gorm:"column:position;type:varchar(10);not null;check:chk_employee_position,position IN ('관리직','운송직')"`
+	IsActive   bool   `json:"is_active" gorm:"column:is_active;type:boolean;not null;default:true"` // GORM 은 false 를 기본값으로 바꿔 저장하므로 비활성 생성은 생성 후 갱신
+	// 프로필. 기존 직원은 이름이 빈 값, 나머지는 NULL 로 마이그레이션됨
+	Name              string     `json:"name" gorm:"column:name;type:varchar(50);not null;default:''"`
+	Username          *string    `json:"username" gorm:"column:username;type:varchar(50);uniqueIndex"`
+	Phone             *string    `json:"phone" gorm:"column:phone;type:varchar(20)"`
+	Email             *string    `json:"email" gorm:"column:email;type:varchar(100)"`
+	HireDate          *time.Time `json:"hire_date" gorm:"column:hire_date;type:date"`
+	AssignedVehicleID *string    `json:"assigned_vehicle_id" gorm:"column:assigned_vehicle_id;type:varchar(15);uniqueIndex"` // 차량 한 대에는 직원 한 명만 배정
+	AssignedVehicle   *Vehicle   `json:"-" gorm:"foreignKey:AssignedVehicleID;references:VehicleID;constraint:OnDelete:SET NULL"`
+	// 로그인 무차별 대입 방지용 연속 실패 횟수와 잠금 만료 시각
+	FailedLoginCount int        `json:"failed_login_count" gorm:"column:failed_login_count;type:int;not null;default:0"`
+	LockedUntil      *time.Time `json:"locked_until" gorm:"column:locked_until"`
+	// 비밀번호 변경·재설정마다 증가. 이보다 낮은 버전으로 발급된 토큰은 거부됨
+	TokenVersion int `json:"token_version" gorm:"column:token_version;type:int;not null;default:0"`
+
+	// 연관 관계는 참조되는 쪽에 has many 로 선언해야 GORM 이 외래 키 방향을 올바르게 추론함
+	Shifts      []Shift              `json:"-" gorm:"foreignKey:EmployeeID"`
+	ResetTokens []PasswordResetToken `json:"-" gorm:"foreignKey:EmployeeID;constraint:OnDelete:CASCADE"`
 }
 
 func (Employee) TableName() string {
@@ -0,0 +1,23 @@
+package models
+
+import "time"
+
+// OutboxEvent 도메인 변경과 같은 트랜잭션에서 기록하는 이벤트. ProcessedAt 이 비어 있으면 아직 전달되지 않음
+type OutboxEvent struct {
+	OutboxID      int64      `json:"outbox_id" gorm:"column:outbox_id;primaryKey;autoIncrement"`
+	EventID       string     `json:"event_id" gorm:"column:event_id;type:varchar(36);not null;uniqueIndex"`
+	EventType     string     `json:"event_type" gorm:"column:event_type;type:varchar(50);not null"`
+	AggregateType string     `json:"aggregate_type" gorm:"column:aggregate_type;type:varchar(30);not null;index:idx_outbox_aggregate"`
+	AggregateID   string     `json:"aggregate_id" gorm:"column:aggregate_id;type:varchar(36);not null;index:idx_outbox_aggregate"`
+	Payload       string     `json:"payload" gorm:"column:payload;type:text;not null"`
+	OccurredAt    time.Time  `json:"occurred_at" gorm:"column:occurred_at;not null"`
+	ProcessedAt   *time.Time `json:"processed_at" gorm:"column:processed_at;index"`
+	Attempts      int        `json:"attempts" gorm:"column:attempts;type:int;not null;default:0"`
+	LastError     *string    `json:"last_error" gorm:"column:last_error;type:varchar(500)"`
+	// DeliveredSinks 전달에 성공한 Sink 이름(쉼표 구분). 재시도할 때 이미 받은 Sink 는 건너뜀
+	DeliveredSinks string `json:"delivered_sinks" gorm:"column:delivered_sinks;type:varchar(200);not null;default:''"`
+}
+
+func (OutboxEvent) TableName() string {
+	return "outbox_event"
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// PasswordResetToken 관리자가 발급하는 일회용 비밀번호 재설정 토큰. 토큰 원문 대신 SHA-256 해시만 저장
+type PasswordResetToken struct {
+	TokenID    int        `json:"token_id" gorm:"column:token_id;type:int;primaryKey;autoIncrement"`
+	EmployeeID int        `json:"employee_id" gorm:"column:employee_id;type:int;not null;index"`
+	TokenHash  string     `json:"-" gorm:"column:token_hash;type:char(64);not null;uniqueIndex"`
+	IssuedBy   int        `json:"issued_by" gorm:"column:issued_by;type:int;not null"`
+	ExpiresAt  time.Time  `json:"expires_at" gorm:"column:expires_at;not null"`
+	UsedAt     *time.Time `json:"used_at" gorm:"column:used_at"`
+	CreatedAt  time.Time  `json:"created_at" gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+}
+
+func (PasswordResetToken) TableName() string {
+	return "password_reset_token"
+}
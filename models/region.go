@@ -12,7 +12,10 @@ type Region struct {
 	MaxCapacity     int        `json:"max_capacity" gorm:"column:max_capacity;type:int;not null;default:0"`
 	CurrentCapacity int        `json:"current_capacity" gorm:"column:current_capacity;type:int;not null;default:0"`
 	IsFull          bool       `json:"is_full" gorm:"column:is_full;type:boolean;not null;default:false"`
-	SaturatedAt     *time.Time `json:"saturated_at" gorm:"column:saturated_at;type:datetime"`
+	SaturatedAt     *time.Time `json:"saturated_at" gorm:"column:saturated_at"`
+
+	Packages     []Package     `json:"-" gorm:"foreignKey:RegionID;references:RegionID"`
+	DeliveryLogs []DeliveryLog `json:"-" gorm:"foreignKey:RegionID;references:RegionID"`
 }
 
 func (Region) TableName() string {
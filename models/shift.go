@@ -0,0 +1,17 @@
+package models
+
+import "time"
+
+// Shift 운송직 직원이 특정 차량을 운전하는 근무 시간. EndTime 이 비어 있으면 진행 중
+type Shift struct {
+	ShiftID    int        `json:"shift_id" gorm:"column:shift_id;type:int;primaryKey;autoIncrement"`
+	EmployeeID int        `json:"employee_id" gorm:"column:employee_id;type:int;not null;index"`
+	VehicleID  string     `json:"vehicle_id" gorm:"column:vehicle_id;type:varchar(15);not null;index"`
+	StartTime  time.Time  `json:"start_time" gorm:"column:start_time;not null"`
+	EndTime    *time.Time `json:"end_time" gorm:"column:end_time"`
+	CreatedBy  int        `json:"created_by" gorm:"column:created_by;type:int;not null"`
+}
+
+func (Shift) TableName() string {
+	return "shift"
+}
@@ -5,10 +5,11 @@ import "time"
 type TripLog struct {
 	TripID      int        `json:"trip_id" gorm:"column:trip_id;type:int;primaryKey;autoIncrement"`
 	VehicleID   string     `json:"vehicle_id" gorm:"column:vehicle_id;type:varchar(15);not null"`
-	Vehicle     Vehicle    `json:"-" gorm:"foreignKey:VehicleID;references:VehicleID"`
-	StartTime   *time.Time `json:"start_time" gorm:"column:start_time;type:datetime"`
-	EndTime     *time.Time `json:"end_time" gorm:"column:end_time;type:datetime"`
-	Status      string     `json:"status" gorm:"column:status;type:enum('운행중','비운행중');not null;default:'비운행중'"`
+	DriverID    *int       `json:"driver_id" gorm:"column:driver_id;type:int;index"`
+	Driver      *Employee  `json:"-" gorm:"foreignKey:DriverID;references:EmployeeID;constraint:OnDelete:SET NULL"`
+	StartTime   *time.Time `json:"start_time" gorm:"column:start_time"`
+	EndTime     *time.Time `json:"end_time" gorm:"column:end_time"`
+	Status      string     `json:"status" gorm:"column:status;type:varchar(10);not null;default:'비운행중';check:chk_trip_log_status,status IN ('운행중','비운행중')"`
 	Destination *string    `json:"destination" gorm:"column:destination;type:char(3)"`
 }
 
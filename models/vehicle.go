@@ -1,5 +1,14 @@
 package models
 
+// LED 색상
+const (
+	LedOff    = "off"
+	LedGreen  = "green"
+	LedYellow = "yellow"
+	LedRed    = "red"
+	LedBlue   = "blue"
+)
+
 type Vehicle struct {
 	InternalID        int    `json:"internal_id" gorm:"column:internal_id;type:int;primaryKey;autoIncrement"`
 	VehicleID         string `json:"vehicle_id" gorm:"column:vehicle_id;type:varchar(15);unique"`
@@ -9,6 +18,10 @@ type Vehicle struct {
 	NeedsConfirmation bool   `json:"needs_confirmation" gorm:"column:needs_confirmation;type:boolean;not null;default:false"`
 	CoordX            int    `json:"coord_x" gorm:"column:coord_x;type:int"`
 	CoordY            int    `json:"coord_y" gorm:"column:coord_y;type:int"`
+
+	TripLogs      []TripLog             `json:"-" gorm:"foreignKey:VehicleID;references:VehicleID"`
+	Shifts        []Shift               `json:"-" gorm:"foreignKey:VehicleID;references:VehicleID"`
+	Confirmations []VehicleConfirmation `json:"-" gorm:"foreignKey:VehicleID;references:VehicleID;constraint:OnDelete:CASCADE"`
 }
 
 func (Vehicle) TableName() string {
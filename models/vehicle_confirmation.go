@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// 확인 요청 사유
+const (
+	ConfirmationReasonArrival      = "arrival"       // 지역 도착
+	ConfirmationReasonLoadMismatch = "load_mismatch" // 적재량 불일치
+	ConfirmationReasonOther        = "other"
+)
+
+// 확인 요청 상태
+const (
+	ConfirmationPending      = "pending"
+	ConfirmationAcknowledged = "acknowledged"
+)
+
+// VehicleConfirmation 차량이 요청한 운영자 확인. 처리되면 확인한 직원과 시각을 기록
+type VehicleConfirmation struct {
+	ConfirmationID int     `json:"confirmation_id" gorm:"column:confirmation_id;type:int;primaryKey;autoIncrement"`
+	VehicleID      string  `json:"vehicle_id" gorm:"column:vehicle_id;type:varchar(15);not null;index"`
+	Reason         string  `json:"reason" gorm:"column:reason;type:varchar(20);not null"`
+	Detail         *string `json:"detail" gorm:"column:detail;type:varchar(255)"`
+	LedColor       string  `json:"led_color" gorm:"column:led_color;type:varchar(10);not null"`
+	// PreviousLedStatus 확인 요청 전의 LED. 대기 중인 요청이 모두 처리되면 이 값으로 복원
+	PreviousLedStatus string     `json:"previous_led_status" gorm:"column:previous_led_status;type:varchar(10)"`
+	Status            string     `json:"status" gorm:"column:status;type:varchar(15);not null;default:'pending';index"`
+	RequestedAt       time.Time  `json:"requested_at" gorm:"column:requested_at;not null"`
+	AcknowledgedBy    *int       `json:"acknowledged_by" gorm:"column:acknowledged_by;type:int"`
+	AcknowledgedAt    *time.Time `json:"acknowledged_at" gorm:"column:acknowledged_at"`
+	Note              *string    `json:"note" gorm:"column:note;type:varchar(255)"`
+}
+
+func (VehicleConfirmation) TableName() string {
+	return "vehicle_confirmation"
+}
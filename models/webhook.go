@@ -0,0 +1,63 @@
+package models
+
+import "time"
+
+// 웹훅 전송 상태
+const (
+	WebhookDeliveryPending   = "pending"   // 전송 대기 또는 재시도 예정
+	WebhookDeliverySucceeded = "succeeded" // 2xx 응답 수신
+	WebhookDeliveryFailed    = "failed"    // 최대 시도 횟수 초과
+)
+
+// WebhookSubscription 이벤트를 받을 외부 시스템. EventTypes 는 쉼표로 구분한 이벤트 타입 목록
+type WebhookSubscription struct {
+	SubscriptionID int       `json:"subscription_id" gorm:"column:subscription_id;type:int;primaryKey;autoIncrement"`
+	URL            string    `json:"url" gorm:"column:url;type:varchar(500);not null"`
+	EventTypes     string    `json:"event_types" gorm:"column:event_types;type:varchar(500);not null"`
+	Secret         string    `json:"-" gorm:"column:secret;type:varchar(128);not null"`
+	IsActive       bool      `json:"is_active" gorm:"column:is_active;type:boolean;not null"`
+	CreatedBy      int       `json:"created_by" gorm:"column:created_by;type:int;not null"`
+	CreatedAt      time.Time `json:"created_at" gorm:"column:created_at;not null;default:CURRENT_TIMESTAMP"`
+
+	Deliveries []WebhookDelivery `json:"-" gorm:"foreignKey:SubscriptionID;constraint:OnDelete:CASCADE"`
+}
+
+func (WebhookSubscription) TableName() string {
+	return "webhook_subscription"
+}
+
+// WebhookDelivery 구독 하나에 대한 이벤트 전송. 대기 중인 행이 곧 재시도 큐
+type WebhookDelivery struct {
+	DeliveryID     int        `json:"delivery_id" gorm:"column:delivery_id;type:int;primaryKey;autoIncrement"`
+	SubscriptionID int        `json:"subscription_id" gorm:"column:subscription_id;type:int;not null;index"`
+	EventID        string     `json:"event_id" gorm:"column:event_id;type:varchar(36);not null;index"`
+	EventType      string     `json:"event_type" gorm:"column:event_type;type:varchar(50);not null"`
+	Payload        string     `json:"payload" gorm:"column:payload;type:text;not null"`
+	Status         string     `json:"status" gorm:"column:status;type:varchar(15);not null;default:'pending';index:idx_webhook_delivery_queue,priority:1"`
+	Attempts       int        `json:"attempts" gorm:"column:attempts;type:int;not null;default:0"`
+	NextAttemptAt  time.Time  `json:"next_attempt_at" gorm:"column:next_attempt_at;not null;index:idx_webhook_delivery_queue,priority:2"`
+	LastStatusCode *int       `json:"last_status_code" gorm:"column:last_status_code;type:int"`
+	LastError      *string    `json:"last_error" gorm:"column:last_error;type:varchar(500)"`
+	CreatedAt      time.Time  `json:"created_at" gorm:"column:created_at;not null"`
+	DeliveredAt    *time.Time `json:"delivered_at" gorm:"column:delivered_at"`
+
+	AttemptLogs []WebhookAttempt `json:"-" gorm:"foreignKey:DeliveryID;constraint:OnDelete:CASCADE"`
+}
+
+func (WebhookDelivery) TableName() string {
+	return "webhook_delivery"
+}
+
+// WebhookAttempt 전송 시도 한 번의 기록
+type WebhookAttempt struct {
+	AttemptID   int       `json:"attempt_id" gorm:"column:attempt_id;type:int;primaryKey;autoIncrement"`
+	DeliveryID  int       `json:"delivery_id" gorm:"column:delivery_id;type:int;not null;index"`
+	AttemptedAt time.Time `json:"attempted_at" gorm:"column:attempted_at;not null"`
+	StatusCode  *int      `json:"status_code" gorm:"column:status_code;type:int"`
+	Error       *string   `json:"error" gorm:"column:error;type:varchar(500)"`
+	DurationMs  int64     `json:"duration_ms" gorm:"column:duration_ms;type:bigint;not null"`
+}
+
+func (WebhookAttempt) TableName() string {
+	return "webhook_attempt"
+}
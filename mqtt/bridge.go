@@ -0,0 +1,215 @@
+// Package mqtt 는 차량과 분류기가 사용하는 MQTT 브로커를 서비스 계층과 연결합니다.
+//
+// 차량 텔레메트리, 택배 인식, 적재함 포화 메시지를 구독해 VehicleService, SorterService, RegionService 에 반영하고,
+// 아웃박스의 vehicle.led_changed 이벤트를 받아 차량 명령 토픽으로 LED 변경을 발행합니다 (Bridge 는 outbox.Sink).
+package mqtt
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"net/http"
+	"strings"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/metrics"
+	"github.com/baboyiban/go-api-server/service"
+	paho "github.com/eclipse/paho.mqtt.golang"
+	"github.com/gin-gonic/gin/binding"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/baboyiban/go-api-server/mqtt")
+
+// 수신 메시지 종류 (메트릭 라벨, 로그에 사용)
+const (
+	kindTelemetry = "telemetry"
+	kindScanned   = "scanned"
+	kindBinFull   = "bin_full"
+)
+
+// Bridge 브로커 연결과 토픽 구독을 관리
+type Bridge struct {
+	cfg      config.MQTTConfig
+	client   paho.Client
+	vehicles *service.VehicleService
+	sorter   *service.SorterService
+	regions  *service.RegionService
+	ctx      context.Context // 메시지 처리의 수명. Start 에서 설정
+}
+
+func NewBridge(cfg config.MQTTConfig, vehicles *service.VehicleService, sorter *service.SorterService, regions *service.RegionService) *Bridge {
+	b := &Bridge{cfg: cfg, vehicles: vehicles, sorter: sorter, regions: regions, ctx: context.Background()}
+	clientID := cfg.ClientID
+	if clientID == "" {
+		clientID = "go-api-server-" + randomSuffix()
+	}
+	opts := paho.NewClientOptions().
+		AddBroker(cfg.BrokerURL).
+		SetClientID(clientID).
+		SetUsername(cfg.Username).
+		SetPassword(cfg.Password).
+		SetConnectTimeout(cfg.ConnectTimeout).
+		SetAutoReconnect(true).
+		SetConnectRetry(true).
+		// 재연결 때마다 OnConnect 에서 다시 구독
+		SetCleanSession(true).
+		SetOnConnectHandler(b.onConnect).
+		SetConnectionLostHandler(func(_ paho.Client, err error) {
+			metrics.SetMQTTConnected(false)
+			slog.Warn("MQTT 브로커 연결 끊김, 재연결 시도", "broker", cfg.BrokerURL, "error", err)
+		})
+	b.client = paho.NewClient(opts)
+	return b
+}
+
+// Start 브로커에 연결. 브로커가 아직 없으면 백그라운드에서 계속 재시도하므로 서버 시작을 막지 않음
+func (b *Bridge) Start(ctx context.Context) error {
+	b.ctx = ctx
+	token := b.client.Connect()
+	if !token.WaitTimeout(b.cfg.ConnectTimeout) {
+		slog.Warn("MQTT 브로커에 아직 연결되지 않음, 백그라운드에서 재시도", "broker", b.cfg.BrokerURL)
+		return nil
+	}
+	return token.Error()
+}
+
+// Stop 처리 중인 메시지를 잠시 기다린 뒤 연결 종료
+func (b *Bridge) Stop() {
+	b.client.Disconnect(250)
+	metrics.SetMQTTConnected(false)
+}
+
+func (b *Bridge) onConnect(client paho.Client) {
+	metrics.SetMQTTConnected(true)
+	slog.Info("MQTT 브로커 연결됨", "broker", b.cfg.BrokerURL)
+	subs := []struct {
+		topic   string
+		kind    string
+		handler func(ctx context.Context, topic string, payload []byte) error
+	}{
+		{b.cfg.TelemetryTopic, kindTelemetry, b.handleTelemetry},
+		{b.cfg.ScanTopic, kindScanned, b.handleScanned},
+		{b.cfg.BinFullTopic, kindBinFull, b.handleBinFull},
+	}
+	for _, s := range subs {
+		topic := b.subscriptionTopic(s.topic)
+		token := client.Subscribe(topic, byte(b.cfg.QoS), b.wrap(s.kind, s.topic, s.handler))
+		if !token.WaitTimeout(b.cfg.ConnectTimeout) || token.Error() != nil {
+			slog.Error("MQTT 토픽 구독 실패", "topic", topic, "error", token.Error())
+			continue
+		}
+		slog.Info("MQTT 토픽 구독", "topic", topic)
+	}
+}
+
+// subscriptionTopic 공유 그룹이 설정되면 $share 구독으로 바꿔 인스턴스 중 하나만 메시지를 받게 함
+func (b *Bridge) subscriptionTopic(topic string) string {
+	if b.cfg.SharedGroup == "" {
+		return topic
+	}
+	return "$share/" + b.cfg.SharedGroup + "/" + topic
+}
+
+// wrap 메시지마다 트레이스, 타임아웃, 결과 메트릭을 붙임. 처리 실패는 기록만 하고 메시지는 버림
+func (b *Bridge) wrap(kind, pattern string, handle func(ctx context.Context, topic string, payload []byte) error) paho.MessageHandler {
+	return func(_ paho.Client, msg paho.Message) {
+		ctx, cancel := context.WithTimeout(b.ctx, b.cfg.HandlerTimeout)
+		defer cancel()
+		ctx, span := tracer.Start(ctx, "mqtt."+kind)
+		defer span.End()
+
+		err := handle(ctx, msg.Topic(), msg.Payload())
+		result := resultOf(err, kind)
+		metrics.ObserveMQTTMessage(kind, result)
+		if err != nil {
+			level := slog.LevelWarn
+			if result == "error" {
+				level = slog.LevelError
+			}
+			slog.Log(ctx, level, "MQTT 메시지 처리 실패",
+				"kind", kind, "topic", msg.Topic(), "device_id", topicWildcard(pattern, msg.Topic()), "result", result, "error", err)
+		}
+	}
+}
+
+func (b *Bridge) handleTelemetry(ctx context.Context, topic string, payload []byte) error {
+	var msg dto.VehicleTelemetryMessage
+	if err := decode(payload, &msg); err != nil {
+		return err
+	}
+	vehicleID := topicWildcard(b.cfg.TelemetryTopic, topic)
+	if vehicleID == "" {
+		return apperror.New(http.StatusBadRequest, apperror.CodeMalformedRequest, "Vehicle ID missing in topic")
+	}
+	_, err := b.vehicles.ReportTelemetry(ctx, vehicleID, msg)
+	return err
+}
+
+// handleScanned REST 스캔과 같이 적재함을 결정하고 기록. 응답할 곳이 없으므로 결정은 package.scanned 이벤트로만 전달됨
+func (b *Bridge) handleScanned(ctx context.Context, topic string, payload []byte) error {
+	var msg dto.PackageScannedMessage
+	if err := decode(payload, &msg); err != nil {
+		return err
+	}
+	_, err := b.sorter.Scan(ctx, dto.SorterScanRequest{PackageID: msg.PackageID, SorterID: topicWildcard(b.cfg.ScanTopic, topic)})
+	return err
+}
+
+func (b *Bridge) handleBinFull(ctx context.Context, _ string, payload []byte) error {
+	var msg dto.BinFullMessage
+	if err := decode(payload, &msg); err != nil {
+		return err
+	}
+	_, err := b.regions.MarkFull(ctx, msg.RegionID)
+	return err
+}
+
+// decode REST 요청과 같은 binding 태그로 메시지 본문을 검증
+func decode(payload []byte, v any) error {
+	if err := json.Unmarshal(payload, v); err != nil {
+		return err
+	}
+	return binding.Validator.ValidateStruct(v)
+}
+
+// resultOf 에러를 메트릭 결과 라벨로. 잘못된 메시지와 없는 대상은 재전송해도 실패하므로 서버 에러와 구분
+func resultOf(err error, resource string) string {
+	if err == nil {
+		return "ok"
+	}
+	if errors.Is(err, context.Canceled) {
+		return "error"
+	}
+	switch status := apperror.Translate(err, resource).Status; {
+	case status == http.StatusNotFound:
+		return "not_found"
+	case status < 500:
+		return "invalid"
+	default:
+		return "error"
+	}
+}
+
+// topicWildcard 구독 패턴의 첫 번째 + 자리에 해당하는 토픽 단계 (예: vehicles/+/telemetry, vehicles/V01/telemetry → V01)
+func topicWildcard(pattern, topic string) string {
+	ps := strings.Split(pattern, "/")
+	ts := strings.Split(topic, "/")
+	for i, p := range ps {
+		if p == "+" && i < len(ts) {
+			return ts[i]
+		}
+	}
+	return ""
+}
+
+func randomSuffix() string {
+	b := make([]byte, 4)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
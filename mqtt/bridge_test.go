@@ -0,0 +1,167 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/service"
+	paho "github.com/eclipse/paho.mqtt.golang"
+)
+
+func testConfig(brokerURL string) config.MQTTConfig {
+	cfg := config.Default().MQTT
+	cfg.BrokerURL = brokerURL
+	cfg.ClientID = "go-api-server-test"
+	cfg.ConnectTimeout = 2 * time.Second
+	cfg.HandlerTimeout = 2 * time.Second
+	cfg.PublishTimeout = 2 * time.Second
+	return cfg
+}
+
+// startBridge 브리지를 연결하고 세 토픽 구독이 끝날 때까지 기다림
+func startBridge(t *testing.T, broker *testBroker, store repository.Store) *Bridge {
+	t.Helper()
+	b := NewBridge(testConfig(broker.url()), service.NewVehicleService(store, nil),
+		service.NewSorterService(store, nil, time.Second), service.NewRegionService(store, nil))
+	if err := b.Start(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(b.Stop)
+	waitFor(t, "bridge subscriptions", func() bool {
+		broker.mu.Lock()
+		defer broker.mu.Unlock()
+		for c := range broker.conns {
+			if len(c.filters) == 3 {
+				return true
+			}
+		}
+		return false
+	})
+	return b
+}
+
+// connectDevice 차량이나 분류기 역할을 하는 클라이언트
+func connectDevice(t *testing.T, broker *testBroker) paho.Client {
+	t.Helper()
+	client := paho.NewClient(paho.NewClientOptions().AddBroker(broker.url()).SetClientID("device"))
+	if token := client.Connect(); !token.WaitTimeout(2*time.Second) || token.Error() != nil {
+		t.Fatalf("device connect: %v", token.Error())
+	}
+	t.Cleanup(func() { client.Disconnect(0) })
+	return client
+}
+
+func waitFor(t *testing.T, what string, cond func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(3 * time.Second)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("timed out waiting for %s", what)
+		}
+		time.Sleep(10 * time.Millisecond)
+	}
+}
+
+func TestBridge_InboundMessages(t *testing.T) {
+	ctx := context.Background()
+	tests := []struct {
+		name    string
+		topic   string
+		payload string
+		done    func(store repository.Store) bool
+	}{
+		{"telemetry updates vehicle", "vehicles/A01/telemetry", `{"coord_x":3,"coord_y":4,"current_load":2}`,
+			func(store repository.Store) bool {
+				v, _ := store.Vehicles().GetByVehicleID(ctx, "A01")
+				return v.CoordX == 3 && v.CoordY == 4 && v.CurrentLoad == 2
+			}},
+		{"package scanned inducts into bin", "sorter/S1/scanned", `{"package_id":1}`,
+			func(store repository.Store) bool {
+				pkg, _ := store.Packages().Get(ctx, 1)
+				region, _ := store.Regions().Get(ctx, "R01")
+				return pkg.PackageStatus == "투입됨" && region.CurrentCapacity == 1
+			}},
+		{"bin full saturates region", "sorter/S1/bin_full", `{"region_id":"R01"}`,
+			func(store repository.Store) bool {
+				region, _ := store.Regions().Get(ctx, "R01")
+				return region.IsFull && region.SaturatedAt != nil
+			}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := repository.NewMemoryStore()
+			for _, err := range []error{
+				store.Vehicles().Create(ctx, &models.Vehicle{VehicleID: "A01", MaxLoad: 10}),
+				store.Regions().Create(ctx, &models.Region{RegionID: "R01", RegionName: "서울", MaxCapacity: 10}),
+				store.Packages().Create(ctx, &models.Package{PackageType: "일반", RegionID: "R01", PackageStatus: "등록됨"}),
+			} {
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			broker := newTestBroker(t)
+			startBridge(t, broker, store)
+			device := connectDevice(t, broker)
+
+			// 잘못된 메시지는 버리고 다음 메시지를 계속 처리
+			device.Publish(tt.topic, 1, false, `{"coord_x":`).Wait()
+			if token := device.Publish(tt.topic, 1, false, tt.payload); !token.WaitTimeout(2*time.Second) || token.Error() != nil {
+				t.Fatalf("publish: %v", token.Error())
+			}
+			waitFor(t, tt.name, func() bool { return tt.done(store) })
+		})
+	}
+}
+
+func TestBridge_PublishLedCommand(t *testing.T) {
+	ctx := context.Background()
+	broker := newTestBroker(t)
+	bridge := startBridge(t, broker, repository.NewMemoryStore())
+	device := connectDevice(t, broker)
+	commands := make(chan paho.Message, 4)
+	if token := device.Subscribe("vehicles/+/commands", 1, func(_ paho.Client, msg paho.Message) { commands <- msg }); !token.WaitTimeout(2*time.Second) || token.Error() != nil {
+		t.Fatalf("subscribe: %v", token.Error())
+	}
+
+	// LED 변경이 아닌 이벤트는 발행하지 않음
+	if err := bridge.Handle(ctx, outbox.Event{ID: "ev-0", Type: outbox.VehicleUpdated, Data: json.RawMessage(`{}`)}); err != nil {
+		t.Fatal(err)
+	}
+	data, _ := json.Marshal(dto.VehicleLedEvent{VehicleID: "A01", LedStatus: models.LedRed})
+	occurred := time.Date(2026, 1, 2, 3, 4, 5, 0, time.UTC)
+	if err := bridge.Handle(ctx, outbox.Event{ID: "ev-1", Type: outbox.VehicleLedChanged, OccurredAt: occurred, Data: data}); err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case msg := <-commands:
+		var cmd dto.VehicleCommand
+		if err := json.Unmarshal(msg.Payload(), &cmd); err != nil {
+			t.Fatal(err)
+		}
+		want := dto.VehicleCommand{Command: CommandSetLed, LedStatus: models.LedRed, EventID: "ev-1", IssuedAt: "2026-01-02T03:04:05Z"}
+		if msg.Topic() != "vehicles/A01/commands" || cmd != want {
+			t.Errorf("command on %s = %+v, want %+v", msg.Topic(), cmd, want)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("no command published")
+	}
+	select {
+	case msg := <-commands:
+		t.Errorf("unexpected command %s", msg.Payload())
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	// 연결이 끊기면 아웃박스가 다시 전달하도록 에러
+	bridge.Stop()
+	if err := bridge.Handle(ctx, outbox.Event{ID: "ev-2", Type: outbox.VehicleLedChanged, Data: data}); err != errNotConnected {
+		t.Errorf("after stop: err = %v, want %v", err, errNotConnected)
+	}
+}
@@ -0,0 +1,154 @@
+package mqtt
+
+import (
+	"net"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/eclipse/paho.mqtt.golang/packets"
+)
+
+// testBroker 테스트용 최소 MQTT 3.1.1 브로커. 연결, 구독, 발행(QoS 0/1), ping 만 지원하며
+// 구독자에게는 QoS 0 으로 전달. $share/<group>/ 구독은 일반 구독처럼 취급
+type testBroker struct {
+	ln net.Listener
+
+	mu    sync.Mutex
+	conns map[*brokerConn]struct{}
+}
+
+type brokerConn struct {
+	conn    net.Conn
+	writeMu sync.Mutex
+	filters []string // 접근은 testBroker.mu 로 보호
+}
+
+func newTestBroker(t *testing.T) *testBroker {
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := &testBroker{ln: ln, conns: map[*brokerConn]struct{}{}}
+	go b.serve()
+	t.Cleanup(b.close)
+	return b
+}
+
+func (b *testBroker) url() string {
+	return "tcp://" + b.ln.Addr().String()
+}
+
+func (b *testBroker) close() {
+	_ = b.ln.Close()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	for c := range b.conns {
+		_ = c.conn.Close()
+	}
+}
+
+func (b *testBroker) serve() {
+	for {
+		conn, err := b.ln.Accept()
+		if err != nil {
+			return
+		}
+		c := &brokerConn{conn: conn}
+		b.mu.Lock()
+		b.conns[c] = struct{}{}
+		b.mu.Unlock()
+		go b.handle(c)
+	}
+}
+
+func (b *testBroker) handle(c *brokerConn) {
+	defer func() {
+		b.mu.Lock()
+		delete(b.conns, c)
+		b.mu.Unlock()
+		_ = c.conn.Close()
+	}()
+	for {
+		cp, err := packets.ReadPacket(c.conn)
+		if err != nil {
+			return
+		}
+		switch p := cp.(type) {
+		case *packets.ConnectPacket:
+			c.write(packets.NewControlPacket(packets.Connack))
+		case *packets.SubscribePacket:
+			b.mu.Lock()
+			for _, topic := range p.Topics {
+				c.filters = append(c.filters, shareTopic(topic))
+			}
+			b.mu.Unlock()
+			ack := packets.NewControlPacket(packets.Suback).(*packets.SubackPacket)
+			ack.MessageID = p.MessageID
+			ack.ReturnCodes = make([]byte, len(p.Topics)) // 모두 QoS 0 으로 허용
+			c.write(ack)
+		case *packets.PublishPacket:
+			if p.Qos > 0 {
+				ack := packets.NewControlPacket(packets.Puback).(*packets.PubackPacket)
+				ack.MessageID = p.MessageID
+				c.write(ack)
+			}
+			b.route(p.TopicName, p.Payload)
+		case *packets.PingreqPacket:
+			c.write(packets.NewControlPacket(packets.Pingresp))
+		case *packets.DisconnectPacket:
+			return
+		}
+	}
+}
+
+// route 토픽과 맞는 구독이 있는 연결마다 한 번씩 전달
+func (b *testBroker) route(topic string, payload []byte) {
+	b.mu.Lock()
+	var targets []*brokerConn
+	for c := range b.conns {
+		for _, f := range c.filters {
+			if topicMatches(f, topic) {
+				targets = append(targets, c)
+				break
+			}
+		}
+	}
+	b.mu.Unlock()
+	for _, c := range targets {
+		p := packets.NewControlPacket(packets.Publish).(*packets.PublishPacket)
+		p.TopicName = topic
+		p.Payload = payload
+		c.write(p)
+	}
+}
+
+func (c *brokerConn) write(p packets.ControlPacket) {
+	c.writeMu.Lock()
+	defer c.writeMu.Unlock()
+	_ = p.Write(c.conn)
+}
+
+// shareTopic $share/<group>/<filter> 에서 filter 만 남김
+func shareTopic(topic string) string {
+	if rest, ok := strings.CutPrefix(topic, "$share/"); ok {
+		if _, filter, ok := strings.Cut(rest, "/"); ok {
+			return filter
+		}
+	}
+	return topic
+}
+
+func topicMatches(filter, topic string) bool {
+	fs := strings.Split(filter, "/")
+	ts := strings.Split(topic, "/")
+	for i, f := range fs {
+		if f == "#" {
+			return true
+		}
+		if i >= len(ts) || (f != "+" && f != ts[i]) {
+			return false
+		}
+	}
+	return len(fs) == len(ts)
+}
@@ -0,0 +1,68 @@
+package mqtt
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/metrics"
+	"github.com/baboyiban/go-api-server/outbox"
+)
+
+// CommandSetLed 차량 LED 변경 명령
+const CommandSetLed = "set_led"
+
+var errNotConnected = errors.New("브로커에 연결되어 있지 않음")
+
+func (b *Bridge) Name() string {
+	return "mqtt"
+}
+
+// Handle LED 변경 이벤트를 차량 명령 토픽으로 발행. 발행에 실패하면 아웃박스가 나중에 다시 전달
+func (b *Bridge) Handle(ctx context.Context, ev outbox.Event) error {
+	if ev.Type != outbox.VehicleLedChanged {
+		return nil
+	}
+	ctx, span := tracer.Start(ctx, "mqtt.PublishCommand")
+	defer span.End()
+	var led dto.VehicleLedEvent
+	if err := json.Unmarshal(ev.Data, &led); err != nil {
+		return err
+	}
+	err := b.publish(ctx, led.VehicleID, dto.VehicleCommand{
+		Command:   CommandSetLed,
+		LedStatus: led.LedStatus,
+		EventID:   ev.ID,
+		IssuedAt:  ev.OccurredAt.Format(time.RFC3339),
+	})
+	result := "ok"
+	if err != nil {
+		result = "error"
+	}
+	metrics.ObserveMQTTCommand(CommandSetLed, result)
+	return err
+}
+
+// publish 차량 명령 토픽으로 발행하고 브로커 응답을 기다림
+func (b *Bridge) publish(ctx context.Context, vehicleID string, cmd dto.VehicleCommand) error {
+	if !b.client.IsConnectionOpen() {
+		return errNotConnected
+	}
+	payload, err := json.Marshal(cmd)
+	if err != nil {
+		return err
+	}
+	topic := strings.ReplaceAll(b.cfg.CommandTopic, "{vehicle_id}", vehicleID)
+	token := b.client.Publish(topic, byte(b.cfg.QoS), false, payload)
+	select {
+	case <-token.Done():
+		return token.Error()
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-time.After(b.cfg.PublishTimeout):
+		return errors.New("발행 응답 시간 초과: " + topic)
+	}
+}
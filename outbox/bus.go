@@ -0,0 +1,67 @@
+package outbox
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	"github.com/baboyiban/go-api-server/metrics"
+)
+
+// Bus 프로세스 내 구독자에게 이벤트를 전달하는 Sink.
+// 느린 구독자 때문에 전달이 막히지 않도록 버퍼가 가득 찬 구독자에게는 이벤트를 버림.
+// 이 인스턴스의 Dispatcher 가 처리한 이벤트만 받으므로, 여러 인스턴스로 실행할 때 구독자는
+// 다른 인스턴스가 처리한 이벤트를 주기적인 재조회(gRPC Watch, GraphQL 구독의 WatchResync)로 보완해야 함
+type Bus struct {
+	mu     sync.RWMutex
+	nextID int
+	subs   map[int]*subscription
+}
+
+type subscription struct {
+	ch    chan Event
+	types []string // 비어 있으면 모든 이벤트
+}
+
+func NewBus() *Bus {
+	return &Bus{subs: map[int]*subscription{}}
+}
+
+func (b *Bus) Name() string {
+	return "bus"
+}
+
+// Subscribe types 에 해당하는 이벤트를 받는 채널과 구독 해제 함수를 반환. types 가 비어 있으면 모든 이벤트
+func (b *Bus) Subscribe(buffer int, types ...string) (<-chan Event, func()) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	id := b.nextID
+	b.nextID++
+	sub := &subscription{ch: make(chan Event, buffer), types: types}
+	b.subs[id] = sub
+	var once sync.Once
+	return sub.ch, func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs, id)
+			b.mu.Unlock()
+			close(sub.ch)
+		})
+	}
+}
+
+func (b *Bus) Handle(_ context.Context, ev Event) error {
+	b.mu.RLock()
+	defer b.mu.RUnlock()
+	for _, sub := range b.subs {
+		if len(sub.types) > 0 && !slices.Contains(sub.types, ev.Type) {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+			metrics.ObserveBusDrop(ev.Type)
+		}
+	}
+	return nil
+}
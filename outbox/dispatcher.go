@@ -0,0 +1,159 @@
+package outbox
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/metrics"
+	"github.com/baboyiban/go-api-server/models"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/baboyiban/go-api-server/outbox")
+
+// maxErrorLen last_error 컬럼 크기
+const maxErrorLen = 500
+
+// Dispatcher 처리되지 않은 아웃박스 행을 순서대로 읽어 모든 Sink 에 전달하고 처리 완료로 표시.
+// 행은 SELECT ... FOR UPDATE SKIP LOCKED 로 잠그므로 여러 인스턴스가 동시에 실행해도 같은 행을 중복 처리하지 않고,
+// 같은 집계의 앞선 행이 처리되지 않았으면 뒤의 행을 건너뛰어 인스턴스가 여러 개여도 집계별 순서를 지킴.
+// Sink 별로 전달 성공 여부를 기록하므로 한 Sink 가 실패해도 다른 Sink 에는 다시 보내지 않음
+type Dispatcher[S Store[S]] struct {
+	store S
+	cfg   config.OutboxConfig
+	sinks []Sink
+}
+
+func NewDispatcher[S Store[S]](store S, cfg config.OutboxConfig, sinks ...Sink) *Dispatcher[S] {
+	return &Dispatcher[S]{store: store, cfg: cfg, sinks: sinks}
+}
+
+// Run ctx 가 취소될 때까지 PollInterval 마다 아웃박스를 처리하고, 보관 기간이 지난 처리 완료 행을 정리
+func (d *Dispatcher[S]) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	lastCleanup := time.Time{}
+	for {
+		for {
+			n, err := d.ProcessBatch(ctx)
+			if err != nil && ctx.Err() == nil {
+				slog.ErrorContext(ctx, "아웃박스 처리 실패", "error", err)
+			}
+			if err != nil || n < d.cfg.BatchSize {
+				break
+			}
+		}
+		if d.cfg.Retention > 0 && time.Since(lastCleanup) > time.Hour {
+			lastCleanup = time.Now()
+			if err := d.Cleanup(ctx); err != nil && ctx.Err() == nil {
+				slog.ErrorContext(ctx, "아웃박스 정리 실패", "error", err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessBatch 처리되지 않은 행을 최대 BatchSize 개 전달하고 처리 완료한 수를 반환.
+// 전달에 실패하면 같은 집계의 뒤 행만 다음 주기로 미루고 다른 집계의 행은 계속 처리
+func (d *Dispatcher[S]) ProcessBatch(ctx context.Context) (int, error) {
+	ctx, span := tracer.Start(ctx, "outbox.ProcessBatch")
+	defer span.End()
+	processed := 0
+	err := d.store.Transaction(ctx, func(tx S) error {
+		rows, err := tx.Outbox().Pending(ctx, d.cfg.BatchSize)
+		if err != nil {
+			return err
+		}
+		for i := range rows {
+			row := &rows[i]
+			// 다른 인스턴스가 잠근 행이나 이번 배치에서 실패한 행이 앞에 있으면 다음 주기에
+			oldest, err := tx.Outbox().OldestPending(ctx, row.AggregateType, row.AggregateID)
+			if err != nil {
+				return err
+			}
+			if oldest != 0 && oldest < row.OutboxID {
+				continue
+			}
+			delivered, err := d.dispatch(ctx, tx, row)
+			if err != nil {
+				if err := d.recordFailure(ctx, tx, row, delivered, err); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := tx.Outbox().Update(ctx, row.OutboxID, map[string]any{
+				"processed_at":    time.Now(),
+				"attempts":        row.Attempts + 1,
+				"last_error":      nil,
+				"delivered_sinks": delivered,
+			}); err != nil {
+				return err
+			}
+			processed++
+		}
+		return nil
+	})
+	return processed, err
+}
+
+// dispatch 아직 받지 못한 Sink 에 전달하고 전달에 성공한 Sink 목록을 반환. 하나라도 실패하면 에러.
+// TxSink 는 세이브포인트 안에서 실행하여 실패한 쓰기가 아웃박스 트랜잭션에 남지 않게 함
+func (d *Dispatcher[S]) dispatch(ctx context.Context, tx S, row *models.OutboxEvent) (string, error) {
+	ev := FromRow(row)
+	delivered := strings.FieldsFunc(row.DeliveredSinks, func(r rune) bool { return r == ',' })
+	var errs []error
+	for _, sink := range d.sinks {
+		if slices.Contains(delivered, sink.Name()) {
+			continue
+		}
+		var err error
+		if ts, ok := sink.(TxSink[S]); ok {
+			err = tx.Transaction(ctx, func(stx S) error {
+				return ts.HandleTx(ctx, stx, ev)
+			})
+		} else {
+			err = sink.Handle(ctx, ev)
+		}
+		if err != nil {
+			metrics.ObserveOutboxDispatch(sink.Name(), "error")
+			errs = append(errs, errors.New(sink.Name()+": "+err.Error()))
+			continue
+		}
+		metrics.ObserveOutboxDispatch(sink.Name(), "ok")
+		delivered = append(delivered, sink.Name())
+	}
+	return strings.Join(delivered, ","), errors.Join(errs...)
+}
+
+// recordFailure 실패를 기록. MaxAttempts 를 넘으면 더 이상 재시도하지 않도록 처리 완료로 표시하여 뒤의 이벤트가 막히지 않게 함
+func (d *Dispatcher[S]) recordFailure(ctx context.Context, tx S, row *models.OutboxEvent, delivered string, cause error) error {
+	attempts := row.Attempts + 1
+	msg := cause.Error()
+	if len(msg) > maxErrorLen {
+		msg = msg[:maxErrorLen]
+	}
+	updates := map[string]any{"attempts": attempts, "last_error": msg, "delivered_sinks": delivered}
+	if attempts >= d.cfg.MaxAttempts {
+		updates["processed_at"] = time.Now()
+		slog.ErrorContext(ctx, "아웃박스 이벤트 전달 포기",
+			"outbox_id", row.OutboxID, "event_id", row.EventID, "event_type", row.EventType, "attempts", attempts, "error", cause)
+	} else {
+		slog.WarnContext(ctx, "아웃박스 이벤트 전달 실패",
+			"outbox_id", row.OutboxID, "event_id", row.EventID, "event_type", row.EventType, "attempts", attempts, "error", cause)
+	}
+	return tx.Outbox().Update(ctx, row.OutboxID, updates)
+}
+
+// Cleanup 보관 기간이 지난 처리 완료 행 삭제
+func (d *Dispatcher[S]) Cleanup(ctx context.Context) error {
+	return d.store.Outbox().DeleteProcessed(ctx, time.Now().Add(-d.cfg.Retention))
+}
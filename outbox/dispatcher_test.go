@@ -0,0 +1,157 @@
+package outbox_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+// recordingSink 받은 이벤트 ID 를 기록. fail 이 true 를 반환하면 실패
+type recordingSink struct {
+	name string
+	fail func(ev outbox.Event) bool
+
+	mu  sync.Mutex
+	got []string
+}
+
+func (s *recordingSink) Name() string {
+	return s.name
+}
+
+func (s *recordingSink) Handle(_ context.Context, ev outbox.Event) error {
+	if s.fail != nil && s.fail(ev) {
+		return errors.New("unavailable")
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.got = append(s.got, ev.AggregateID+":"+ev.Type)
+	return nil
+}
+
+func (s *recordingSink) received() []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.got)
+}
+
+func record(t *testing.T, store repository.Store, events ...[3]string) {
+	t.Helper()
+	for _, e := range events {
+		if err := store.Events().Record(context.Background(), e[0], e[1], e[2], map[string]string{"id": e[2]}); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+func pendingIDs(t *testing.T, store repository.Store) []string {
+	t.Helper()
+	rows, err := store.Outbox().Pending(context.Background(), 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, r := range rows {
+		ids = append(ids, r.AggregateID+":"+r.EventType+"["+r.DeliveredSinks+"]")
+	}
+	return ids
+}
+
+func newDispatcher(store repository.Store, sinks ...outbox.Sink) *outbox.Dispatcher[repository.Store] {
+	return outbox.NewDispatcher(store, config.OutboxConfig{BatchSize: 10, MaxAttempts: 3, PollInterval: time.Second}, sinks...)
+}
+
+func TestDispatcher_PerAggregateOrder(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	record(t, store,
+		[3]string{outbox.RegionCreated, outbox.AggregateRegion, "1"},
+		[3]string{outbox.RegionUpdated, outbox.AggregateRegion, "1"},
+		[3]string{outbox.RegionCreated, outbox.AggregateRegion, "2"},
+	)
+	broken := true
+	sink := &recordingSink{name: "bus", fail: func(ev outbox.Event) bool {
+		return broken && ev.AggregateID == "1"
+	}}
+	d := newDispatcher(store, sink)
+
+	// 지역 1 의 첫 이벤트가 실패하면 지역 1 의 뒤 이벤트는 미루고 지역 2 는 처리
+	if n, err := d.ProcessBatch(ctx); err != nil || n != 1 {
+		t.Fatalf("first batch = %d, %v", n, err)
+	}
+	if got := sink.received(); !slices.Equal(got, []string{"2:region.created"}) {
+		t.Errorf("received = %v", got)
+	}
+	if got := pendingIDs(t, store); len(got) != 2 {
+		t.Errorf("pending = %v", got)
+	}
+
+	broken = false
+	if n, err := d.ProcessBatch(ctx); err != nil || n != 2 {
+		t.Fatalf("second batch = %d, %v", n, err)
+	}
+	want := []string{"2:region.created", "1:region.created", "1:region.updated"}
+	if got := sink.received(); !slices.Equal(got, want) {
+		t.Errorf("received = %v, want %v", got, want)
+	}
+}
+
+func TestDispatcher_GivesUpAfterMaxAttempts(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	record(t, store,
+		[3]string{outbox.PackageCreated, outbox.AggregatePackage, "7"},
+		[3]string{outbox.PackageUpdated, outbox.AggregatePackage, "7"},
+	)
+	sink := &recordingSink{name: "bus", fail: func(ev outbox.Event) bool { return ev.Type == outbox.PackageCreated }}
+	d := newDispatcher(store, sink)
+
+	for i, want := range []int{0, 0, 1} {
+		if n, err := d.ProcessBatch(ctx); err != nil || n != want {
+			t.Fatalf("batch %d = %d, %v; want %d", i+1, n, err, want)
+		}
+	}
+	// 세 번째 실패에서 포기하면 같은 배치에서 뒤의 이벤트가 전달됨
+	if got := sink.received(); !slices.Equal(got, []string{"7:package.updated"}) {
+		t.Errorf("received = %v", got)
+	}
+	if got := pendingIDs(t, store); len(got) != 0 {
+		t.Errorf("pending = %v", got)
+	}
+}
+
+func TestDispatcher_RetriesOnlyFailedSinks(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	record(t, store, [3]string{outbox.VehicleLedChanged, outbox.AggregateVehicle, "A01"})
+	bus := &recordingSink{name: "bus"}
+	brokerDown := true
+	mqtt := &recordingSink{name: "mqtt", fail: func(outbox.Event) bool { return brokerDown }}
+	d := newDispatcher(store, bus, mqtt)
+
+	if n, err := d.ProcessBatch(ctx); err != nil || n != 0 {
+		t.Fatalf("first batch = %d, %v", n, err)
+	}
+	if got := pendingIDs(t, store); !slices.Equal(got, []string{"A01:vehicle.led_changed[bus]"}) {
+		t.Errorf("pending = %v", got)
+	}
+
+	brokerDown = false
+	if n, err := d.ProcessBatch(ctx); err != nil || n != 1 {
+		t.Fatalf("second batch = %d, %v", n, err)
+	}
+	// 이미 받은 Sink 에는 다시 보내지 않음
+	if got := bus.received(); len(got) != 1 {
+		t.Errorf("bus received = %v", got)
+	}
+	if got := mqtt.received(); len(got) != 1 {
+		t.Errorf("mqtt received = %v", got)
+	}
+}
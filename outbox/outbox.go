@@ -0,0 +1,136 @@
+// Package outbox 는 트랜잭셔널 아웃박스로 도메인 이벤트를 유실 없이 전달합니다.
+//
+// 서비스는 도메인 변경과 같은 트랜잭션에서 repository.Store 의 Events 로 outbox_event 행을 기록하고,
+// Dispatcher 가 처리되지 않은 행을 순서대로 읽어 등록된 Sink(프로세스 내 버스, 웹훅, 메시지 브로커)에 전달합니다.
+// 커밋되지 않은 변경의 이벤트는 전달되지 않고, 커밋된 변경의 이벤트는 프로세스가 죽더라도 재시작 후 전달됩니다.
+// 전달은 최소 한 번(at-least-once)이므로 Sink 는 Event.ID 로 중복을 걸러야 합니다.
+package outbox
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+// 집계(aggregate) 종류
+const (
+	AggregatePackage = "package"
+	AggregateRegion  = "region"
+	AggregateVehicle = "vehicle"
+	AggregateTripLog = "trip_log"
+)
+
+// 이벤트 타입
+const (
+	PackageCreated   = "package.created"
+	PackageUpdated   = "package.updated"
+	PackageDeleted   = "package.deleted"
+	PackageCompleted = "package.completed" // 완료됨 상태로 변경
+	PackageScanned   = "package.scanned"   // 분류기가 인식하고 적재함을 결정함 (투입, 오버플로, 반송 모두)
+
+	RegionCreated   = "region.created"
+	RegionUpdated   = "region.updated"
+	RegionDeleted   = "region.deleted"
+	RegionSaturated = "region.saturated" // 포화 상태로 변경
+
+	VehicleCreated               = "vehicle.created"
+	VehicleUpdated               = "vehicle.updated"
+	VehicleDeleted               = "vehicle.deleted"
+	VehicleLedChanged            = "vehicle.led_changed" // 차량에 LED 변경 명령을 보내야 함
+	VehicleConfirmationRequested = "vehicle.confirmation_requested"
+	VehicleConfirmationAcked     = "vehicle.confirmation_acknowledged"
+
+	TripLogCreated = "trip_log.created"
+	TripLogUpdated = "trip_log.updated"
+	TripLogDeleted = "trip_log.deleted"
+)
+
+// EventTypes 기록될 수 있는 모든 이벤트 타입
+var EventTypes = []string{
+	PackageCreated, PackageUpdated, PackageDeleted, PackageCompleted, PackageScanned,
+	RegionCreated, RegionUpdated, RegionDeleted, RegionSaturated,
+	VehicleCreated, VehicleUpdated, VehicleDeleted, VehicleLedChanged, VehicleConfirmationRequested, VehicleConfirmationAcked,
+	TripLogCreated, TripLogUpdated, TripLogDeleted,
+}
+
+// Event Sink 에 전달되는 도메인 이벤트
+type Event struct {
+	ID            string          `json:"id"`
+	Type          string          `json:"type"`
+	AggregateType string          `json:"aggregate_type"`
+	AggregateID   string          `json:"aggregate_id"`
+	OccurredAt    time.Time       `json:"occurred_at"`
+	Data          json.RawMessage `json:"data"`
+}
+
+// Sink 이벤트를 받아 외부로 전달하는 대상. 에러를 반환하면 같은 이벤트를 나중에 다시 전달
+type Sink interface {
+	Name() string
+	Handle(ctx context.Context, ev Event) error
+}
+
+// TxSink 같은 DB 에 쓰는 Sink. Dispatcher 가 아웃박스 트랜잭션 안에서 HandleTx 를 호출하므로
+// 처리 완료 표시와 함께 커밋되어 중복 없이 한 번만 반영됨. S 는 트랜잭션 안의 저장소 타입
+type TxSink[S any] interface {
+	Sink
+	HandleTx(ctx context.Context, tx S, ev Event) error
+}
+
+// Repository outbox_event 행의 전달 상태
+type Repository interface {
+	// Pending 처리되지 않은 행을 outbox_id 순으로 최대 limit 개 잠금. 다른 트랜잭션이 잠근 행은 건너뜀
+	Pending(ctx context.Context, limit int) ([]models.OutboxEvent, error)
+	// OldestPending 집계의 처리되지 않은 행 중 가장 앞선 outbox_id. 다른 트랜잭션이 잠근 행도 포함하며, 없으면 0
+	OldestPending(ctx context.Context, aggregateType, aggregateID string) (int64, error)
+	// Update 지정한 컬럼만 변경
+	Update(ctx context.Context, outboxID int64, columns map[string]any) error
+	// DeleteProcessed before 이전에 처리 완료된 행 삭제
+	DeleteProcessed(ctx context.Context, before time.Time) error
+}
+
+// Store Dispatcher 가 사용하는 저장소. repository.Store 가 구현하며, S 는 Transaction 이 넘겨주는 트랜잭션 안의 저장소
+type Store[S any] interface {
+	Outbox() Repository
+	Transaction(ctx context.Context, fn func(tx S) error) error
+}
+
+// NewRow 기록할 outbox_event 행. 저장소의 EventRecorder 가 도메인 변경과 같은 트랜잭션에서 저장
+func NewRow(eventType, aggregateType, aggregateID string, data any) (*models.OutboxEvent, error) {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, fmt.Errorf("outbox: %s 이벤트 직렬화 실패: %w", eventType, err)
+	}
+	return &models.OutboxEvent{
+		EventID:       NewEventID(),
+		EventType:     eventType,
+		AggregateType: aggregateType,
+		AggregateID:   aggregateID,
+		Payload:       string(payload),
+		OccurredAt:    time.Now().UTC(),
+	}, nil
+}
+
+// NewEventID UUID v4 형식의 이벤트 ID
+func NewEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("%x-%x-%x-%x-%x", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+// FromRow 저장된 행을 Sink 에 전달할 이벤트로
+func FromRow(m *models.OutboxEvent) Event {
+	return Event{
+		ID:            m.EventID,
+		Type:          m.EventType,
+		AggregateType: m.AggregateType,
+		AggregateID:   m.AggregateID,
+		OccurredAt:    m.OccurredAt,
+		Data:          json.RawMessage(m.Payload),
+	}
+}
@@ -0,0 +1,794 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/delivery_log.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// 시각은 모두 RFC3339
+type DeliveryLog struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TripId              int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	PackageId           int32                  `protobuf:"varint,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	RegionId            string                 `protobuf:"bytes,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	LoadOrder           int32                  `protobuf:"varint,4,opt,name=load_order,json=loadOrder,proto3" json:"load_order,omitempty"`
+	RegisteredAt        *string                `protobuf:"bytes,5,opt,name=registered_at,json=registeredAt,proto3,oneof" json:"registered_at,omitempty"`
+	FirstTransportTime  *string                `protobuf:"bytes,6,opt,name=first_transport_time,json=firstTransportTime,proto3,oneof" json:"first_transport_time,omitempty"`
+	InputTime           *string                `protobuf:"bytes,7,opt,name=input_time,json=inputTime,proto3,oneof" json:"input_time,omitempty"`
+	SecondTransportTime *string                `protobuf:"bytes,8,opt,name=second_transport_time,json=secondTransportTime,proto3,oneof" json:"second_transport_time,omitempty"`
+	CompletedAt         *string                `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *DeliveryLog) Reset() {
+	*x = DeliveryLog{}
+	mi := &file_api_v1_delivery_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeliveryLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryLog) ProtoMessage() {}
+
+func (x *DeliveryLog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_delivery_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryLog.ProtoReflect.Descriptor instead.
+func (*DeliveryLog) Descriptor() ([]byte, []int) {
+	return file_api_v1_delivery_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *DeliveryLog) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *DeliveryLog) GetPackageId() int32 {
+	if x != nil {
+		return x.PackageId
+	}
+	return 0
+}
+
+func (x *DeliveryLog) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *DeliveryLog) GetLoadOrder() int32 {
+	if x != nil {
+		return x.LoadOrder
+	}
+	return 0
+}
+
+func (x *DeliveryLog) GetRegisteredAt() string {
+	if x != nil && x.RegisteredAt != nil {
+		return *x.RegisteredAt
+	}
+	return ""
+}
+
+func (x *DeliveryLog) GetFirstTransportTime() string {
+	if x != nil && x.FirstTransportTime != nil {
+		return *x.FirstTransportTime
+	}
+	return ""
+}
+
+func (x *DeliveryLog) GetInputTime() string {
+	if x != nil && x.InputTime != nil {
+		return *x.InputTime
+	}
+	return ""
+}
+
+func (x *DeliveryLog) GetSecondTransportTime() string {
+	if x != nil && x.SecondTransportTime != nil {
+		return *x.SecondTransportTime
+	}
+	return ""
+}
+
+func (x *DeliveryLog) GetCompletedAt() string {
+	if x != nil && x.CompletedAt != nil {
+		return *x.CompletedAt
+	}
+	return ""
+}
+
+type CreateDeliveryLogRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TripId              int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	PackageId           int32                  `protobuf:"varint,2,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	RegionId            string                 `protobuf:"bytes,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	LoadOrder           int32                  `protobuf:"varint,4,opt,name=load_order,json=loadOrder,proto3" json:"load_order,omitempty"`
+	RegisteredAt        *string                `protobuf:"bytes,5,opt,name=registered_at,json=registeredAt,proto3,oneof" json:"registered_at,omitempty"`
+	FirstTransportTime  *string                `protobuf:"bytes,6,opt,name=first_transport_time,json=firstTransportTime,proto3,oneof" json:"first_transport_time,omitempty"`
+	InputTime           *string                `protobuf:"bytes,7,opt,name=input_time,json=inputTime,proto3,oneof" json:"input_time,omitempty"`
+	SecondTransportTime *string                `protobuf:"bytes,8,opt,name=second_transport_time,json=secondTransportTime,proto3,oneof" json:"second_transport_time,omitempty"`
+	CompletedAt         *string                `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *CreateDeliveryLogRequest) Reset() {
+	*x = CreateDeliveryLogRequest{}
+	mi := &file_api_v1_delivery_log_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDeliveryLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDeliveryLogRequest) ProtoMessage() {}
+
+func (x *CreateDeliveryLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_delivery_log_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDeliveryLogRequest.ProtoReflect.Descriptor instead.
+func (*CreateDeliveryLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_delivery_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateDeliveryLogRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *CreateDeliveryLogRequest) GetPackageId() int32 {
+	if x != nil {
+		return x.PackageId
+	}
+	return 0
+}
+
+func (x *CreateDeliveryLogRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *CreateDeliveryLogRequest) GetLoadOrder() int32 {
+	if x != nil {
+		return x.LoadOrder
+	}
+	return 0
+}
+
+func (x *CreateDeliveryLogRequest) GetRegisteredAt() string {
+	if x != nil && x.RegisteredAt != nil {
+		return *x.RegisteredAt
+	}
+	return ""
+}
+
+func (x *CreateDeliveryLogRequest) GetFirstTransportTime() string {
+	if x != nil && x.FirstTransportTime != nil {
+		return *x.FirstTransportTime
+	}
+	return ""
+}
+
+func (x *CreateDeliveryLogRequest) GetInputTime() string {
+	if x != nil && x.InputTime != nil {
+		return *x.InputTime
+	}
+	return ""
+}
+
+func (x *CreateDeliveryLogRequest) GetSecondTransportTime() string {
+	if x != nil && x.SecondTransportTime != nil {
+		return *x.SecondTransportTime
+	}
+	return ""
+}
+
+func (x *CreateDeliveryLogRequest) GetCompletedAt() string {
+	if x != nil && x.CompletedAt != nil {
+		return *x.CompletedAt
+	}
+	return ""
+}
+
+type GetDeliveryLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveryLogRequest) Reset() {
+	*x = GetDeliveryLogRequest{}
+	mi := &file_api_v1_delivery_log_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveryLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveryLogRequest) ProtoMessage() {}
+
+func (x *GetDeliveryLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_delivery_log_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveryLogRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveryLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_delivery_log_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetDeliveryLogRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type UpdateDeliveryLogRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TripId              int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	LoadOrder           int32                  `protobuf:"varint,2,opt,name=load_order,json=loadOrder,proto3" json:"load_order,omitempty"`
+	RegisteredAt        *string                `protobuf:"bytes,3,opt,name=registered_at,json=registeredAt,proto3,oneof" json:"registered_at,omitempty"`
+	FirstTransportTime  *string                `protobuf:"bytes,4,opt,name=first_transport_time,json=firstTransportTime,proto3,oneof" json:"first_transport_time,omitempty"`
+	InputTime           *string                `protobuf:"bytes,5,opt,name=input_time,json=inputTime,proto3,oneof" json:"input_time,omitempty"`
+	SecondTransportTime *string                `protobuf:"bytes,6,opt,name=second_transport_time,json=secondTransportTime,proto3,oneof" json:"second_transport_time,omitempty"`
+	CompletedAt         *string                `protobuf:"bytes,7,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *UpdateDeliveryLogRequest) Reset() {
+	*x = UpdateDeliveryLogRequest{}
+	mi := &file_api_v1_delivery_log_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDeliveryLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDeliveryLogRequest) ProtoMessage() {}
+
+func (x *UpdateDeliveryLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_delivery_log_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDeliveryLogRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDeliveryLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_delivery_log_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateDeliveryLogRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *UpdateDeliveryLogRequest) GetLoadOrder() int32 {
+	if x != nil {
+		return x.LoadOrder
+	}
+	return 0
+}
+
+func (x *UpdateDeliveryLogRequest) GetRegisteredAt() string {
+	if x != nil && x.RegisteredAt != nil {
+		return *x.RegisteredAt
+	}
+	return ""
+}
+
+func (x *UpdateDeliveryLogRequest) GetFirstTransportTime() string {
+	if x != nil && x.FirstTransportTime != nil {
+		return *x.FirstTransportTime
+	}
+	return ""
+}
+
+func (x *UpdateDeliveryLogRequest) GetInputTime() string {
+	if x != nil && x.InputTime != nil {
+		return *x.InputTime
+	}
+	return ""
+}
+
+func (x *UpdateDeliveryLogRequest) GetSecondTransportTime() string {
+	if x != nil && x.SecondTransportTime != nil {
+		return *x.SecondTransportTime
+	}
+	return ""
+}
+
+func (x *UpdateDeliveryLogRequest) GetCompletedAt() string {
+	if x != nil && x.CompletedAt != nil {
+		return *x.CompletedAt
+	}
+	return ""
+}
+
+type DeleteDeliveryLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteDeliveryLogRequest) Reset() {
+	*x = DeleteDeliveryLogRequest{}
+	mi := &file_api_v1_delivery_log_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteDeliveryLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteDeliveryLogRequest) ProtoMessage() {}
+
+func (x *DeleteDeliveryLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_delivery_log_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteDeliveryLogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteDeliveryLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_delivery_log_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteDeliveryLogRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type ListDeliveryLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sort          string                 `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveryLogsRequest) Reset() {
+	*x = ListDeliveryLogsRequest{}
+	mi := &file_api_v1_delivery_log_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveryLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveryLogsRequest) ProtoMessage() {}
+
+func (x *ListDeliveryLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_delivery_log_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveryLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListDeliveryLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_delivery_log_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListDeliveryLogsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type SearchDeliveryLogsRequest struct {
+	state               protoimpl.MessageState `protogen:"open.v1"`
+	TripId              *string                `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3,oneof" json:"trip_id,omitempty"`
+	PackageId           *string                `protobuf:"bytes,2,opt,name=package_id,json=packageId,proto3,oneof" json:"package_id,omitempty"`
+	RegionId            *string                `protobuf:"bytes,3,opt,name=region_id,json=regionId,proto3,oneof" json:"region_id,omitempty"`
+	LoadOrder           *string                `protobuf:"bytes,4,opt,name=load_order,json=loadOrder,proto3,oneof" json:"load_order,omitempty"`
+	RegisteredAt        *string                `protobuf:"bytes,5,opt,name=registered_at,json=registeredAt,proto3,oneof" json:"registered_at,omitempty"` // YYYY-MM-DD
+	FirstTransportTime  *string                `protobuf:"bytes,6,opt,name=first_transport_time,json=firstTransportTime,proto3,oneof" json:"first_transport_time,omitempty"`
+	InputTime           *string                `protobuf:"bytes,7,opt,name=input_time,json=inputTime,proto3,oneof" json:"input_time,omitempty"`
+	SecondTransportTime *string                `protobuf:"bytes,8,opt,name=second_transport_time,json=secondTransportTime,proto3,oneof" json:"second_transport_time,omitempty"`
+	CompletedAt         *string                `protobuf:"bytes,9,opt,name=completed_at,json=completedAt,proto3,oneof" json:"completed_at,omitempty"`
+	Sort                string                 `protobuf:"bytes,15,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields       protoimpl.UnknownFields
+	sizeCache           protoimpl.SizeCache
+}
+
+func (x *SearchDeliveryLogsRequest) Reset() {
+	*x = SearchDeliveryLogsRequest{}
+	mi := &file_api_v1_delivery_log_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchDeliveryLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchDeliveryLogsRequest) ProtoMessage() {}
+
+func (x *SearchDeliveryLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_delivery_log_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchDeliveryLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchDeliveryLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_delivery_log_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchDeliveryLogsRequest) GetTripId() string {
+	if x != nil && x.TripId != nil {
+		return *x.TripId
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetPackageId() string {
+	if x != nil && x.PackageId != nil {
+		return *x.PackageId
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetRegionId() string {
+	if x != nil && x.RegionId != nil {
+		return *x.RegionId
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetLoadOrder() string {
+	if x != nil && x.LoadOrder != nil {
+		return *x.LoadOrder
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetRegisteredAt() string {
+	if x != nil && x.RegisteredAt != nil {
+		return *x.RegisteredAt
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetFirstTransportTime() string {
+	if x != nil && x.FirstTransportTime != nil {
+		return *x.FirstTransportTime
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetInputTime() string {
+	if x != nil && x.InputTime != nil {
+		return *x.InputTime
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetSecondTransportTime() string {
+	if x != nil && x.SecondTransportTime != nil {
+		return *x.SecondTransportTime
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetCompletedAt() string {
+	if x != nil && x.CompletedAt != nil {
+		return *x.CompletedAt
+	}
+	return ""
+}
+
+func (x *SearchDeliveryLogsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListDeliveryLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DeliveryLogs  []*DeliveryLog         `protobuf:"bytes,1,rep,name=delivery_logs,json=deliveryLogs,proto3" json:"delivery_logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDeliveryLogsResponse) Reset() {
+	*x = ListDeliveryLogsResponse{}
+	mi := &file_api_v1_delivery_log_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDeliveryLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDeliveryLogsResponse) ProtoMessage() {}
+
+func (x *ListDeliveryLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_delivery_log_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDeliveryLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListDeliveryLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_delivery_log_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListDeliveryLogsResponse) GetDeliveryLogs() []*DeliveryLog {
+	if x != nil {
+		return x.DeliveryLogs
+	}
+	return nil
+}
+
+var File_api_v1_delivery_log_proto protoreflect.FileDescriptor
+
+const file_api_v1_delivery_log_proto_rawDesc = "" +
+	"\n" +
+	"\x19api/v1/delivery_log.proto\x12\x06api.v1\x1a\x1bgoogle/protobuf/empty.proto\"\xcc\x03\n" +
+	"\vDeliveryLog\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x02 \x01(\x05R\tpackageId\x12\x1b\n" +
+	"\tregion_id\x18\x03 \x01(\tR\bregionId\x12\x1d\n" +
+	"\n" +
+	"load_order\x18\x04 \x01(\x05R\tloadOrder\x12(\n" +
+	"\rregistered_at\x18\x05 \x01(\tH\x00R\fregisteredAt\x88\x01\x01\x125\n" +
+	"\x14first_transport_time\x18\x06 \x01(\tH\x01R\x12firstTransportTime\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"input_time\x18\a \x01(\tH\x02R\tinputTime\x88\x01\x01\x127\n" +
+	"\x15second_transport_time\x18\b \x01(\tH\x03R\x13secondTransportTime\x88\x01\x01\x12&\n" +
+	"\fcompleted_at\x18\t \x01(\tH\x04R\vcompletedAt\x88\x01\x01B\x10\n" +
+	"\x0e_registered_atB\x17\n" +
+	"\x15_first_transport_timeB\r\n" +
+	"\v_input_timeB\x18\n" +
+	"\x16_second_transport_timeB\x0f\n" +
+	"\r_completed_at\"\xd9\x03\n" +
+	"\x18CreateDeliveryLogRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x02 \x01(\x05R\tpackageId\x12\x1b\n" +
+	"\tregion_id\x18\x03 \x01(\tR\bregionId\x12\x1d\n" +
+	"\n" +
+	"load_order\x18\x04 \x01(\x05R\tloadOrder\x12(\n" +
+	"\rregistered_at\x18\x05 \x01(\tH\x00R\fregisteredAt\x88\x01\x01\x125\n" +
+	"\x14first_transport_time\x18\x06 \x01(\tH\x01R\x12firstTransportTime\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"input_time\x18\a \x01(\tH\x02R\tinputTime\x88\x01\x01\x127\n" +
+	"\x15second_transport_time\x18\b \x01(\tH\x03R\x13secondTransportTime\x88\x01\x01\x12&\n" +
+	"\fcompleted_at\x18\t \x01(\tH\x04R\vcompletedAt\x88\x01\x01B\x10\n" +
+	"\x0e_registered_atB\x17\n" +
+	"\x15_first_transport_timeB\r\n" +
+	"\v_input_timeB\x18\n" +
+	"\x16_second_transport_timeB\x0f\n" +
+	"\r_completed_at\"0\n" +
+	"\x15GetDeliveryLogRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\"\x9d\x03\n" +
+	"\x18UpdateDeliveryLogRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x1d\n" +
+	"\n" +
+	"load_order\x18\x02 \x01(\x05R\tloadOrder\x12(\n" +
+	"\rregistered_at\x18\x03 \x01(\tH\x00R\fregisteredAt\x88\x01\x01\x125\n" +
+	"\x14first_transport_time\x18\x04 \x01(\tH\x01R\x12firstTransportTime\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"input_time\x18\x05 \x01(\tH\x02R\tinputTime\x88\x01\x01\x127\n" +
+	"\x15second_transport_time\x18\x06 \x01(\tH\x03R\x13secondTransportTime\x88\x01\x01\x12&\n" +
+	"\fcompleted_at\x18\a \x01(\tH\x04R\vcompletedAt\x88\x01\x01B\x10\n" +
+	"\x0e_registered_atB\x17\n" +
+	"\x15_first_transport_timeB\r\n" +
+	"\v_input_timeB\x18\n" +
+	"\x16_second_transport_timeB\x0f\n" +
+	"\r_completed_at\"3\n" +
+	"\x18DeleteDeliveryLogRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\"-\n" +
+	"\x17ListDeliveryLogsRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\"\xba\x04\n" +
+	"\x19SearchDeliveryLogsRequest\x12\x1c\n" +
+	"\atrip_id\x18\x01 \x01(\tH\x00R\x06tripId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"package_id\x18\x02 \x01(\tH\x01R\tpackageId\x88\x01\x01\x12 \n" +
+	"\tregion_id\x18\x03 \x01(\tH\x02R\bregionId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"load_order\x18\x04 \x01(\tH\x03R\tloadOrder\x88\x01\x01\x12(\n" +
+	"\rregistered_at\x18\x05 \x01(\tH\x04R\fregisteredAt\x88\x01\x01\x125\n" +
+	"\x14first_transport_time\x18\x06 \x01(\tH\x05R\x12firstTransportTime\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"input_time\x18\a \x01(\tH\x06R\tinputTime\x88\x01\x01\x127\n" +
+	"\x15second_transport_time\x18\b \x01(\tH\aR\x13secondTransportTime\x88\x01\x01\x12&\n" +
+	"\fcompleted_at\x18\t \x01(\tH\bR\vcompletedAt\x88\x01\x01\x12\x12\n" +
+	"\x04sort\x18\x0f \x01(\tR\x04sortB\n" +
+	"\n" +
+	"\b_trip_idB\r\n" +
+	"\v_package_idB\f\n" +
+	"\n" +
+	"_region_idB\r\n" +
+	"\v_load_orderB\x10\n" +
+	"\x0e_registered_atB\x17\n" +
+	"\x15_first_transport_timeB\r\n" +
+	"\v_input_timeB\x18\n" +
+	"\x16_second_transport_timeB\x0f\n" +
+	"\r_completed_at\"T\n" +
+	"\x18ListDeliveryLogsResponse\x128\n" +
+	"\rdelivery_logs\x18\x01 \x03(\v2\x13.api.v1.DeliveryLogR\fdeliveryLogs2\xf3\x03\n" +
+	"\x12DeliveryLogService\x12J\n" +
+	"\x11CreateDeliveryLog\x12 .api.v1.CreateDeliveryLogRequest\x1a\x13.api.v1.DeliveryLog\x12D\n" +
+	"\x0eGetDeliveryLog\x12\x1d.api.v1.GetDeliveryLogRequest\x1a\x13.api.v1.DeliveryLog\x12J\n" +
+	"\x11UpdateDeliveryLog\x12 .api.v1.UpdateDeliveryLogRequest\x1a\x13.api.v1.DeliveryLog\x12M\n" +
+	"\x11DeleteDeliveryLog\x12 .api.v1.DeleteDeliveryLogRequest\x1a\x16.google.protobuf.Empty\x12U\n" +
+	"\x10ListDeliveryLogs\x12\x1f.api.v1.ListDeliveryLogsRequest\x1a .api.v1.ListDeliveryLogsResponse\x12Y\n" +
+	"\x12SearchDeliveryLogs\x12!.api.v1.SearchDeliveryLogsRequest\x1a .api.v1.ListDeliveryLogsResponseB7Z5github.com/baboyiban/go-api-server/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_api_v1_delivery_log_proto_rawDescOnce sync.Once
+	file_api_v1_delivery_log_proto_rawDescData []byte
+)
+
+func file_api_v1_delivery_log_proto_rawDescGZIP() []byte {
+	file_api_v1_delivery_log_proto_rawDescOnce.Do(func() {
+		file_api_v1_delivery_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_delivery_log_proto_rawDesc), len(file_api_v1_delivery_log_proto_rawDesc)))
+	})
+	return file_api_v1_delivery_log_proto_rawDescData
+}
+
+var file_api_v1_delivery_log_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_delivery_log_proto_goTypes = []any{
+	(*DeliveryLog)(nil),               // 0: api.v1.DeliveryLog
+	(*CreateDeliveryLogRequest)(nil),  // 1: api.v1.CreateDeliveryLogRequest
+	(*GetDeliveryLogRequest)(nil),     // 2: api.v1.GetDeliveryLogRequest
+	(*UpdateDeliveryLogRequest)(nil),  // 3: api.v1.UpdateDeliveryLogRequest
+	(*DeleteDeliveryLogRequest)(nil),  // 4: api.v1.DeleteDeliveryLogRequest
+	(*ListDeliveryLogsRequest)(nil),   // 5: api.v1.ListDeliveryLogsRequest
+	(*SearchDeliveryLogsRequest)(nil), // 6: api.v1.SearchDeliveryLogsRequest
+	(*ListDeliveryLogsResponse)(nil),  // 7: api.v1.ListDeliveryLogsResponse
+	(*emptypb.Empty)(nil),             // 8: google.protobuf.Empty
+}
+var file_api_v1_delivery_log_proto_depIdxs = []int32{
+	0, // 0: api.v1.ListDeliveryLogsResponse.delivery_logs:type_name -> api.v1.DeliveryLog
+	1, // 1: api.v1.DeliveryLogService.CreateDeliveryLog:input_type -> api.v1.CreateDeliveryLogRequest
+	2, // 2: api.v1.DeliveryLogService.GetDeliveryLog:input_type -> api.v1.GetDeliveryLogRequest
+	3, // 3: api.v1.DeliveryLogService.UpdateDeliveryLog:input_type -> api.v1.UpdateDeliveryLogRequest
+	4, // 4: api.v1.DeliveryLogService.DeleteDeliveryLog:input_type -> api.v1.DeleteDeliveryLogRequest
+	5, // 5: api.v1.DeliveryLogService.ListDeliveryLogs:input_type -> api.v1.ListDeliveryLogsRequest
+	6, // 6: api.v1.DeliveryLogService.SearchDeliveryLogs:input_type -> api.v1.SearchDeliveryLogsRequest
+	0, // 7: api.v1.DeliveryLogService.CreateDeliveryLog:output_type -> api.v1.DeliveryLog
+	0, // 8: api.v1.DeliveryLogService.GetDeliveryLog:output_type -> api.v1.DeliveryLog
+	0, // 9: api.v1.DeliveryLogService.UpdateDeliveryLog:output_type -> api.v1.DeliveryLog
+	8, // 10: api.v1.DeliveryLogService.DeleteDeliveryLog:output_type -> google.protobuf.Empty
+	7, // 11: api.v1.DeliveryLogService.ListDeliveryLogs:output_type -> api.v1.ListDeliveryLogsResponse
+	7, // 12: api.v1.DeliveryLogService.SearchDeliveryLogs:output_type -> api.v1.ListDeliveryLogsResponse
+	7, // [7:13] is the sub-list for method output_type
+	1, // [1:7] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_delivery_log_proto_init() }
+func file_api_v1_delivery_log_proto_init() {
+	if File_api_v1_delivery_log_proto != nil {
+		return
+	}
+	file_api_v1_delivery_log_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_v1_delivery_log_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_v1_delivery_log_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_v1_delivery_log_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_delivery_log_proto_rawDesc), len(file_api_v1_delivery_log_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_delivery_log_proto_goTypes,
+		DependencyIndexes: file_api_v1_delivery_log_proto_depIdxs,
+		MessageInfos:      file_api_v1_delivery_log_proto_msgTypes,
+	}.Build()
+	File_api_v1_delivery_log_proto = out.File
+	file_api_v1_delivery_log_proto_goTypes = nil
+	file_api_v1_delivery_log_proto_depIdxs = nil
+}
@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/delivery_log.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	DeliveryLogService_CreateDeliveryLog_FullMethodName  = "/api.v1.DeliveryLogService/CreateDeliveryLog"
+	DeliveryLogService_GetDeliveryLog_FullMethodName     = "/api.v1.DeliveryLogService/GetDeliveryLog"
+	DeliveryLogService_UpdateDeliveryLog_FullMethodName  = "/api.v1.DeliveryLogService/UpdateDeliveryLog"
+	DeliveryLogService_DeleteDeliveryLog_FullMethodName  = "/api.v1.DeliveryLogService/DeleteDeliveryLog"
+	DeliveryLogService_ListDeliveryLogs_FullMethodName   = "/api.v1.DeliveryLogService/ListDeliveryLogs"
+	DeliveryLogService_SearchDeliveryLogs_FullMethodName = "/api.v1.DeliveryLogService/SearchDeliveryLogs"
+)
+
+// DeliveryLogServiceClient is the client API for DeliveryLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 배송 기록. REST /api/delivery-log 와 같은 서비스 계층을 사용
+type DeliveryLogServiceClient interface {
+	CreateDeliveryLog(ctx context.Context, in *CreateDeliveryLogRequest, opts ...grpc.CallOption) (*DeliveryLog, error)
+	GetDeliveryLog(ctx context.Context, in *GetDeliveryLogRequest, opts ...grpc.CallOption) (*DeliveryLog, error)
+	UpdateDeliveryLog(ctx context.Context, in *UpdateDeliveryLogRequest, opts ...grpc.CallOption) (*DeliveryLog, error)
+	DeleteDeliveryLog(ctx context.Context, in *DeleteDeliveryLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListDeliveryLogs(ctx context.Context, in *ListDeliveryLogsRequest, opts ...grpc.CallOption) (*ListDeliveryLogsResponse, error)
+	SearchDeliveryLogs(ctx context.Context, in *SearchDeliveryLogsRequest, opts ...grpc.CallOption) (*ListDeliveryLogsResponse, error)
+}
+
+type deliveryLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDeliveryLogServiceClient(cc grpc.ClientConnInterface) DeliveryLogServiceClient {
+	return &deliveryLogServiceClient{cc}
+}
+
+func (c *deliveryLogServiceClient) CreateDeliveryLog(ctx context.Context, in *CreateDeliveryLogRequest, opts ...grpc.CallOption) (*DeliveryLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeliveryLog)
+	err := c.cc.Invoke(ctx, DeliveryLogService_CreateDeliveryLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryLogServiceClient) GetDeliveryLog(ctx context.Context, in *GetDeliveryLogRequest, opts ...grpc.CallOption) (*DeliveryLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeliveryLog)
+	err := c.cc.Invoke(ctx, DeliveryLogService_GetDeliveryLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryLogServiceClient) UpdateDeliveryLog(ctx context.Context, in *UpdateDeliveryLogRequest, opts ...grpc.CallOption) (*DeliveryLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeliveryLog)
+	err := c.cc.Invoke(ctx, DeliveryLogService_UpdateDeliveryLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryLogServiceClient) DeleteDeliveryLog(ctx context.Context, in *DeleteDeliveryLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, DeliveryLogService_DeleteDeliveryLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryLogServiceClient) ListDeliveryLogs(ctx context.Context, in *ListDeliveryLogsRequest, opts ...grpc.CallOption) (*ListDeliveryLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeliveryLogsResponse)
+	err := c.cc.Invoke(ctx, DeliveryLogService_ListDeliveryLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *deliveryLogServiceClient) SearchDeliveryLogs(ctx context.Context, in *SearchDeliveryLogsRequest, opts ...grpc.CallOption) (*ListDeliveryLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDeliveryLogsResponse)
+	err := c.cc.Invoke(ctx, DeliveryLogService_SearchDeliveryLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DeliveryLogServiceServer is the server API for DeliveryLogService service.
+// All implementations must embed UnimplementedDeliveryLogServiceServer
+// for forward compatibility.
+//
+// 배송 기록. REST /api/delivery-log 와 같은 서비스 계층을 사용
+type DeliveryLogServiceServer interface {
+	CreateDeliveryLog(context.Context, *CreateDeliveryLogRequest) (*DeliveryLog, error)
+	GetDeliveryLog(context.Context, *GetDeliveryLogRequest) (*DeliveryLog, error)
+	UpdateDeliveryLog(context.Context, *UpdateDeliveryLogRequest) (*DeliveryLog, error)
+	DeleteDeliveryLog(context.Context, *DeleteDeliveryLogRequest) (*emptypb.Empty, error)
+	ListDeliveryLogs(context.Context, *ListDeliveryLogsRequest) (*ListDeliveryLogsResponse, error)
+	SearchDeliveryLogs(context.Context, *SearchDeliveryLogsRequest) (*ListDeliveryLogsResponse, error)
+	mustEmbedUnimplementedDeliveryLogServiceServer()
+}
+
+// UnimplementedDeliveryLogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedDeliveryLogServiceServer struct{}
+
+func (UnimplementedDeliveryLogServiceServer) CreateDeliveryLog(context.Context, *CreateDeliveryLogRequest) (*DeliveryLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDeliveryLog not implemented")
+}
+func (UnimplementedDeliveryLogServiceServer) GetDeliveryLog(context.Context, *GetDeliveryLogRequest) (*DeliveryLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDeliveryLog not implemented")
+}
+func (UnimplementedDeliveryLogServiceServer) UpdateDeliveryLog(context.Context, *UpdateDeliveryLogRequest) (*DeliveryLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDeliveryLog not implemented")
+}
+func (UnimplementedDeliveryLogServiceServer) DeleteDeliveryLog(context.Context, *DeleteDeliveryLogRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteDeliveryLog not implemented")
+}
+func (UnimplementedDeliveryLogServiceServer) ListDeliveryLogs(context.Context, *ListDeliveryLogsRequest) (*ListDeliveryLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeliveryLogs not implemented")
+}
+func (UnimplementedDeliveryLogServiceServer) SearchDeliveryLogs(context.Context, *SearchDeliveryLogsRequest) (*ListDeliveryLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchDeliveryLogs not implemented")
+}
+func (UnimplementedDeliveryLogServiceServer) mustEmbedUnimplementedDeliveryLogServiceServer() {}
+func (UnimplementedDeliveryLogServiceServer) testEmbeddedByValue()                            {}
+
+// UnsafeDeliveryLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to DeliveryLogServiceServer will
+// result in compilation errors.
+type UnsafeDeliveryLogServiceServer interface {
+	mustEmbedUnimplementedDeliveryLogServiceServer()
+}
+
+func RegisterDeliveryLogServiceServer(s grpc.ServiceRegistrar, srv DeliveryLogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedDeliveryLogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&DeliveryLogService_ServiceDesc, srv)
+}
+
+func _DeliveryLogService_CreateDeliveryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDeliveryLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryLogServiceServer).CreateDeliveryLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryLogService_CreateDeliveryLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryLogServiceServer).CreateDeliveryLog(ctx, req.(*CreateDeliveryLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryLogService_GetDeliveryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDeliveryLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryLogServiceServer).GetDeliveryLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryLogService_GetDeliveryLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryLogServiceServer).GetDeliveryLog(ctx, req.(*GetDeliveryLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryLogService_UpdateDeliveryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDeliveryLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryLogServiceServer).UpdateDeliveryLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryLogService_UpdateDeliveryLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryLogServiceServer).UpdateDeliveryLog(ctx, req.(*UpdateDeliveryLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryLogService_DeleteDeliveryLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDeliveryLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryLogServiceServer).DeleteDeliveryLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryLogService_DeleteDeliveryLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryLogServiceServer).DeleteDeliveryLog(ctx, req.(*DeleteDeliveryLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryLogService_ListDeliveryLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeliveryLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryLogServiceServer).ListDeliveryLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryLogService_ListDeliveryLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryLogServiceServer).ListDeliveryLogs(ctx, req.(*ListDeliveryLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DeliveryLogService_SearchDeliveryLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchDeliveryLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DeliveryLogServiceServer).SearchDeliveryLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DeliveryLogService_SearchDeliveryLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DeliveryLogServiceServer).SearchDeliveryLogs(ctx, req.(*SearchDeliveryLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// DeliveryLogService_ServiceDesc is the grpc.ServiceDesc for DeliveryLogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var DeliveryLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.DeliveryLogService",
+	HandlerType: (*DeliveryLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateDeliveryLog",
+			Handler:    _DeliveryLogService_CreateDeliveryLog_Handler,
+		},
+		{
+			MethodName: "GetDeliveryLog",
+			Handler:    _DeliveryLogService_GetDeliveryLog_Handler,
+		},
+		{
+			MethodName: "UpdateDeliveryLog",
+			Handler:    _DeliveryLogService_UpdateDeliveryLog_Handler,
+		},
+		{
+			MethodName: "DeleteDeliveryLog",
+			Handler:    _DeliveryLogService_DeleteDeliveryLog_Handler,
+		},
+		{
+			MethodName: "ListDeliveryLogs",
+			Handler:    _DeliveryLogService_ListDeliveryLogs_Handler,
+		},
+		{
+			MethodName: "SearchDeliveryLogs",
+			Handler:    _DeliveryLogService_SearchDeliveryLogs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/delivery_log.proto",
+}
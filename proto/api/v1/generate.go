@@ -0,0 +1,5 @@
+// Package apiv1 는 gRPC API 의 protobuf 메시지와 서비스 정의입니다.
+// *.pb.go 는 *.proto 에서 생성된 코드이므로 직접 수정하지 말고 go generate 로 다시 생성합니다.
+package apiv1
+
+//go:generate sh -c "cd ../.. && buf generate"
@@ -0,0 +1,740 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/package.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Package struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     int32                  `protobuf:"varint,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	PackageType   string                 `protobuf:"bytes,2,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	RegionId      string                 `protobuf:"bytes,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	PackageStatus string                 `protobuf:"bytes,4,opt,name=package_status,json=packageStatus,proto3" json:"package_status,omitempty"` // 등록됨, A차운송중, 투입됨, B차운송중, 완료됨
+	RegisteredAt  string                 `protobuf:"bytes,5,opt,name=registered_at,json=registeredAt,proto3" json:"registered_at,omitempty"`    // RFC3339
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Package) Reset() {
+	*x = Package{}
+	mi := &file_api_v1_package_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Package) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Package) ProtoMessage() {}
+
+func (x *Package) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Package.ProtoReflect.Descriptor instead.
+func (*Package) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Package) GetPackageId() int32 {
+	if x != nil {
+		return x.PackageId
+	}
+	return 0
+}
+
+func (x *Package) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *Package) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *Package) GetPackageStatus() string {
+	if x != nil {
+		return x.PackageStatus
+	}
+	return ""
+}
+
+func (x *Package) GetRegisteredAt() string {
+	if x != nil {
+		return x.RegisteredAt
+	}
+	return ""
+}
+
+type CreatePackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageType   string                 `protobuf:"bytes,1,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	RegionId      string                 `protobuf:"bytes,2,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	PackageStatus string                 `protobuf:"bytes,3,opt,name=package_status,json=packageStatus,proto3" json:"package_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePackageRequest) Reset() {
+	*x = CreatePackageRequest{}
+	mi := &file_api_v1_package_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePackageRequest) ProtoMessage() {}
+
+func (x *CreatePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePackageRequest.ProtoReflect.Descriptor instead.
+func (*CreatePackageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreatePackageRequest) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *CreatePackageRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *CreatePackageRequest) GetPackageStatus() string {
+	if x != nil {
+		return x.PackageStatus
+	}
+	return ""
+}
+
+type GetPackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     int32                  `protobuf:"varint,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetPackageRequest) Reset() {
+	*x = GetPackageRequest{}
+	mi := &file_api_v1_package_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetPackageRequest) ProtoMessage() {}
+
+func (x *GetPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetPackageRequest.ProtoReflect.Descriptor instead.
+func (*GetPackageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetPackageRequest) GetPackageId() int32 {
+	if x != nil {
+		return x.PackageId
+	}
+	return 0
+}
+
+// 비어 있는 필드는 변경하지 않음
+type UpdatePackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     int32                  `protobuf:"varint,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	PackageType   string                 `protobuf:"bytes,2,opt,name=package_type,json=packageType,proto3" json:"package_type,omitempty"`
+	RegionId      string                 `protobuf:"bytes,3,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	PackageStatus string                 `protobuf:"bytes,4,opt,name=package_status,json=packageStatus,proto3" json:"package_status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdatePackageRequest) Reset() {
+	*x = UpdatePackageRequest{}
+	mi := &file_api_v1_package_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdatePackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdatePackageRequest) ProtoMessage() {}
+
+func (x *UpdatePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdatePackageRequest.ProtoReflect.Descriptor instead.
+func (*UpdatePackageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdatePackageRequest) GetPackageId() int32 {
+	if x != nil {
+		return x.PackageId
+	}
+	return 0
+}
+
+func (x *UpdatePackageRequest) GetPackageType() string {
+	if x != nil {
+		return x.PackageType
+	}
+	return ""
+}
+
+func (x *UpdatePackageRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *UpdatePackageRequest) GetPackageStatus() string {
+	if x != nil {
+		return x.PackageStatus
+	}
+	return ""
+}
+
+type DeletePackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     int32                  `protobuf:"varint,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeletePackageRequest) Reset() {
+	*x = DeletePackageRequest{}
+	mi := &file_api_v1_package_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeletePackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeletePackageRequest) ProtoMessage() {}
+
+func (x *DeletePackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeletePackageRequest.ProtoReflect.Descriptor instead.
+func (*DeletePackageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeletePackageRequest) GetPackageId() int32 {
+	if x != nil {
+		return x.PackageId
+	}
+	return 0
+}
+
+type ListPackagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sort          string                 `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackagesRequest) Reset() {
+	*x = ListPackagesRequest{}
+	mi := &file_api_v1_package_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackagesRequest) ProtoMessage() {}
+
+func (x *ListPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackagesRequest.ProtoReflect.Descriptor instead.
+func (*ListPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListPackagesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type SearchPackagesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     *string                `protobuf:"bytes,1,opt,name=package_id,json=packageId,proto3,oneof" json:"package_id,omitempty"`
+	PackageType   *string                `protobuf:"bytes,2,opt,name=package_type,json=packageType,proto3,oneof" json:"package_type,omitempty"`
+	RegionId      *string                `protobuf:"bytes,3,opt,name=region_id,json=regionId,proto3,oneof" json:"region_id,omitempty"`
+	PackageStatus *string                `protobuf:"bytes,4,opt,name=package_status,json=packageStatus,proto3,oneof" json:"package_status,omitempty"`
+	RegisteredAt  *string                `protobuf:"bytes,5,opt,name=registered_at,json=registeredAt,proto3,oneof" json:"registered_at,omitempty"` // YYYY-MM-DD
+	Sort          string                 `protobuf:"bytes,15,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchPackagesRequest) Reset() {
+	*x = SearchPackagesRequest{}
+	mi := &file_api_v1_package_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchPackagesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchPackagesRequest) ProtoMessage() {}
+
+func (x *SearchPackagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchPackagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchPackagesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchPackagesRequest) GetPackageId() string {
+	if x != nil && x.PackageId != nil {
+		return *x.PackageId
+	}
+	return ""
+}
+
+func (x *SearchPackagesRequest) GetPackageType() string {
+	if x != nil && x.PackageType != nil {
+		return *x.PackageType
+	}
+	return ""
+}
+
+func (x *SearchPackagesRequest) GetRegionId() string {
+	if x != nil && x.RegionId != nil {
+		return *x.RegionId
+	}
+	return ""
+}
+
+func (x *SearchPackagesRequest) GetPackageStatus() string {
+	if x != nil && x.PackageStatus != nil {
+		return *x.PackageStatus
+	}
+	return ""
+}
+
+func (x *SearchPackagesRequest) GetRegisteredAt() string {
+	if x != nil && x.RegisteredAt != nil {
+		return *x.RegisteredAt
+	}
+	return ""
+}
+
+func (x *SearchPackagesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListPackagesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Packages      []*Package             `protobuf:"bytes,1,rep,name=packages,proto3" json:"packages,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListPackagesResponse) Reset() {
+	*x = ListPackagesResponse{}
+	mi := &file_api_v1_package_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListPackagesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListPackagesResponse) ProtoMessage() {}
+
+func (x *ListPackagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListPackagesResponse.ProtoReflect.Descriptor instead.
+func (*ListPackagesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListPackagesResponse) GetPackages() []*Package {
+	if x != nil {
+		return x.Packages
+	}
+	return nil
+}
+
+type WatchPackageRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PackageId     int32                  `protobuf:"varint,1,opt,name=package_id,json=packageId,proto3" json:"package_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchPackageRequest) Reset() {
+	*x = WatchPackageRequest{}
+	mi := &file_api_v1_package_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchPackageRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchPackageRequest) ProtoMessage() {}
+
+func (x *WatchPackageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchPackageRequest.ProtoReflect.Descriptor instead.
+func (*WatchPackageRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchPackageRequest) GetPackageId() int32 {
+	if x != nil {
+		return x.PackageId
+	}
+	return 0
+}
+
+type PackageEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                               // snapshot 또는 아웃박스 이벤트 타입 (예: package.updated)
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`          // snapshot 이면 비어 있음
+	OccurredAt    string                 `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339
+	Package       *Package               `protobuf:"bytes,4,opt,name=package,proto3" json:"package,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PackageEvent) Reset() {
+	*x = PackageEvent{}
+	mi := &file_api_v1_package_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PackageEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PackageEvent) ProtoMessage() {}
+
+func (x *PackageEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_package_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PackageEvent.ProtoReflect.Descriptor instead.
+func (*PackageEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_package_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *PackageEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *PackageEvent) GetPackage() *Package {
+	if x != nil {
+		return x.Package
+	}
+	return nil
+}
+
+var File_api_v1_package_proto protoreflect.FileDescriptor
+
+const file_api_v1_package_proto_rawDesc = "" +
+	"\n" +
+	"\x14api/v1/package.proto\x12\x06api.v1\x1a\x1bgoogle/protobuf/empty.proto\"\xb4\x01\n" +
+	"\aPackage\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\x05R\tpackageId\x12!\n" +
+	"\fpackage_type\x18\x02 \x01(\tR\vpackageType\x12\x1b\n" +
+	"\tregion_id\x18\x03 \x01(\tR\bregionId\x12%\n" +
+	"\x0epackage_status\x18\x04 \x01(\tR\rpackageStatus\x12#\n" +
+	"\rregistered_at\x18\x05 \x01(\tR\fregisteredAt\"}\n" +
+	"\x14CreatePackageRequest\x12!\n" +
+	"\fpackage_type\x18\x01 \x01(\tR\vpackageType\x12\x1b\n" +
+	"\tregion_id\x18\x02 \x01(\tR\bregionId\x12%\n" +
+	"\x0epackage_status\x18\x03 \x01(\tR\rpackageStatus\"2\n" +
+	"\x11GetPackageRequest\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\x05R\tpackageId\"\x9c\x01\n" +
+	"\x14UpdatePackageRequest\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\x05R\tpackageId\x12!\n" +
+	"\fpackage_type\x18\x02 \x01(\tR\vpackageType\x12\x1b\n" +
+	"\tregion_id\x18\x03 \x01(\tR\bregionId\x12%\n" +
+	"\x0epackage_status\x18\x04 \x01(\tR\rpackageStatus\"5\n" +
+	"\x14DeletePackageRequest\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\x05R\tpackageId\")\n" +
+	"\x13ListPackagesRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\"\xc2\x02\n" +
+	"\x15SearchPackagesRequest\x12\"\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\tH\x00R\tpackageId\x88\x01\x01\x12&\n" +
+	"\fpackage_type\x18\x02 \x01(\tH\x01R\vpackageType\x88\x01\x01\x12 \n" +
+	"\tregion_id\x18\x03 \x01(\tH\x02R\bregionId\x88\x01\x01\x12*\n" +
+	"\x0epackage_status\x18\x04 \x01(\tH\x03R\rpackageStatus\x88\x01\x01\x12(\n" +
+	"\rregistered_at\x18\x05 \x01(\tH\x04R\fregisteredAt\x88\x01\x01\x12\x12\n" +
+	"\x04sort\x18\x0f \x01(\tR\x04sortB\r\n" +
+	"\v_package_idB\x0f\n" +
+	"\r_package_typeB\f\n" +
+	"\n" +
+	"_region_idB\x11\n" +
+	"\x0f_package_statusB\x10\n" +
+	"\x0e_registered_at\"C\n" +
+	"\x14ListPackagesResponse\x12+\n" +
+	"\bpackages\x18\x01 \x03(\v2\x0f.api.v1.PackageR\bpackages\"4\n" +
+	"\x13WatchPackageRequest\x12\x1d\n" +
+	"\n" +
+	"package_id\x18\x01 \x01(\x05R\tpackageId\"\x89\x01\n" +
+	"\fPackageEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\tR\n" +
+	"occurredAt\x12)\n" +
+	"\apackage\x18\x04 \x01(\v2\x0f.api.v1.PackageR\apackage2\xf0\x03\n" +
+	"\x0ePackageService\x12>\n" +
+	"\rCreatePackage\x12\x1c.api.v1.CreatePackageRequest\x1a\x0f.api.v1.Package\x128\n" +
+	"\n" +
+	"GetPackage\x12\x19.api.v1.GetPackageRequest\x1a\x0f.api.v1.Package\x12>\n" +
+	"\rUpdatePackage\x12\x1c.api.v1.UpdatePackageRequest\x1a\x0f.api.v1.Package\x12E\n" +
+	"\rDeletePackage\x12\x1c.api.v1.DeletePackageRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\fListPackages\x12\x1b.api.v1.ListPackagesRequest\x1a\x1c.api.v1.ListPackagesResponse\x12M\n" +
+	"\x0eSearchPackages\x12\x1d.api.v1.SearchPackagesRequest\x1a\x1c.api.v1.ListPackagesResponse\x12C\n" +
+	"\fWatchPackage\x12\x1b.api.v1.WatchPackageRequest\x1a\x14.api.v1.PackageEvent0\x01B7Z5github.com/baboyiban/go-api-server/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_api_v1_package_proto_rawDescOnce sync.Once
+	file_api_v1_package_proto_rawDescData []byte
+)
+
+func file_api_v1_package_proto_rawDescGZIP() []byte {
+	file_api_v1_package_proto_rawDescOnce.Do(func() {
+		file_api_v1_package_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_package_proto_rawDesc), len(file_api_v1_package_proto_rawDesc)))
+	})
+	return file_api_v1_package_proto_rawDescData
+}
+
+var file_api_v1_package_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_package_proto_goTypes = []any{
+	(*Package)(nil),               // 0: api.v1.Package
+	(*CreatePackageRequest)(nil),  // 1: api.v1.CreatePackageRequest
+	(*GetPackageRequest)(nil),     // 2: api.v1.GetPackageRequest
+	(*UpdatePackageRequest)(nil),  // 3: api.v1.UpdatePackageRequest
+	(*DeletePackageRequest)(nil),  // 4: api.v1.DeletePackageRequest
+	(*ListPackagesRequest)(nil),   // 5: api.v1.ListPackagesRequest
+	(*SearchPackagesRequest)(nil), // 6: api.v1.SearchPackagesRequest
+	(*ListPackagesResponse)(nil),  // 7: api.v1.ListPackagesResponse
+	(*WatchPackageRequest)(nil),   // 8: api.v1.WatchPackageRequest
+	(*PackageEvent)(nil),          // 9: api.v1.PackageEvent
+	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+}
+var file_api_v1_package_proto_depIdxs = []int32{
+	0,  // 0: api.v1.ListPackagesResponse.packages:type_name -> api.v1.Package
+	0,  // 1: api.v1.PackageEvent.package:type_name -> api.v1.Package
+	1,  // 2: api.v1.PackageService.CreatePackage:input_type -> api.v1.CreatePackageRequest
+	2,  // 3: api.v1.PackageService.GetPackage:input_type -> api.v1.GetPackageRequest
+	3,  // 4: api.v1.PackageService.UpdatePackage:input_type -> api.v1.UpdatePackageRequest
+	4,  // 5: api.v1.PackageService.DeletePackage:input_type -> api.v1.DeletePackageRequest
+	5,  // 6: api.v1.PackageService.ListPackages:input_type -> api.v1.ListPackagesRequest
+	6,  // 7: api.v1.PackageService.SearchPackages:input_type -> api.v1.SearchPackagesRequest
+	8,  // 8: api.v1.PackageService.WatchPackage:input_type -> api.v1.WatchPackageRequest
+	0,  // 9: api.v1.PackageService.CreatePackage:output_type -> api.v1.Package
+	0,  // 10: api.v1.PackageService.GetPackage:output_type -> api.v1.Package
+	0,  // 11: api.v1.PackageService.UpdatePackage:output_type -> api.v1.Package
+	10, // 12: api.v1.PackageService.DeletePackage:output_type -> google.protobuf.Empty
+	7,  // 13: api.v1.PackageService.ListPackages:output_type -> api.v1.ListPackagesResponse
+	7,  // 14: api.v1.PackageService.SearchPackages:output_type -> api.v1.ListPackagesResponse
+	9,  // 15: api.v1.PackageService.WatchPackage:output_type -> api.v1.PackageEvent
+	9,  // [9:16] is the sub-list for method output_type
+	2,  // [2:9] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_package_proto_init() }
+func file_api_v1_package_proto_init() {
+	if File_api_v1_package_proto != nil {
+		return
+	}
+	file_api_v1_package_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_package_proto_rawDesc), len(file_api_v1_package_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_package_proto_goTypes,
+		DependencyIndexes: file_api_v1_package_proto_depIdxs,
+		MessageInfos:      file_api_v1_package_proto_msgTypes,
+	}.Build()
+	File_api_v1_package_proto = out.File
+	file_api_v1_package_proto_goTypes = nil
+	file_api_v1_package_proto_depIdxs = nil
+}
@@ -0,0 +1,729 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/region.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Region struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RegionId        string                 `protobuf:"bytes,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	RegionName      string                 `protobuf:"bytes,2,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+	CoordX          int32                  `protobuf:"varint,3,opt,name=coord_x,json=coordX,proto3" json:"coord_x,omitempty"`
+	CoordY          int32                  `protobuf:"varint,4,opt,name=coord_y,json=coordY,proto3" json:"coord_y,omitempty"`
+	MaxCapacity     int32                  `protobuf:"varint,5,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	CurrentCapacity int32                  `protobuf:"varint,6,opt,name=current_capacity,json=currentCapacity,proto3" json:"current_capacity,omitempty"`
+	IsFull          bool                   `protobuf:"varint,7,opt,name=is_full,json=isFull,proto3" json:"is_full,omitempty"`
+	SaturatedAt     *string                `protobuf:"bytes,8,opt,name=saturated_at,json=saturatedAt,proto3,oneof" json:"saturated_at,omitempty"` // RFC3339
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Region) Reset() {
+	*x = Region{}
+	mi := &file_api_v1_region_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Region) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Region) ProtoMessage() {}
+
+func (x *Region) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_region_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Region.ProtoReflect.Descriptor instead.
+func (*Region) Descriptor() ([]byte, []int) {
+	return file_api_v1_region_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Region) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *Region) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+func (x *Region) GetCoordX() int32 {
+	if x != nil {
+		return x.CoordX
+	}
+	return 0
+}
+
+func (x *Region) GetCoordY() int32 {
+	if x != nil {
+		return x.CoordY
+	}
+	return 0
+}
+
+func (x *Region) GetMaxCapacity() int32 {
+	if x != nil {
+		return x.MaxCapacity
+	}
+	return 0
+}
+
+func (x *Region) GetCurrentCapacity() int32 {
+	if x != nil {
+		return x.CurrentCapacity
+	}
+	return 0
+}
+
+func (x *Region) GetIsFull() bool {
+	if x != nil {
+		return x.IsFull
+	}
+	return false
+}
+
+func (x *Region) GetSaturatedAt() string {
+	if x != nil && x.SaturatedAt != nil {
+		return *x.SaturatedAt
+	}
+	return ""
+}
+
+type CreateRegionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RegionId      string                 `protobuf:"bytes,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	RegionName    string                 `protobuf:"bytes,2,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+	CoordX        int32                  `protobuf:"varint,3,opt,name=coord_x,json=coordX,proto3" json:"coord_x,omitempty"`
+	CoordY        int32                  `protobuf:"varint,4,opt,name=coord_y,json=coordY,proto3" json:"coord_y,omitempty"`
+	MaxCapacity   int32                  `protobuf:"varint,5,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateRegionRequest) Reset() {
+	*x = CreateRegionRequest{}
+	mi := &file_api_v1_region_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateRegionRequest) ProtoMessage() {}
+
+func (x *CreateRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_region_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateRegionRequest.ProtoReflect.Descriptor instead.
+func (*CreateRegionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_region_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateRegionRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *CreateRegionRequest) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+func (x *CreateRegionRequest) GetCoordX() int32 {
+	if x != nil {
+		return x.CoordX
+	}
+	return 0
+}
+
+func (x *CreateRegionRequest) GetCoordY() int32 {
+	if x != nil {
+		return x.CoordY
+	}
+	return 0
+}
+
+func (x *CreateRegionRequest) GetMaxCapacity() int32 {
+	if x != nil {
+		return x.MaxCapacity
+	}
+	return 0
+}
+
+type GetRegionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RegionId      string                 `protobuf:"bytes,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetRegionRequest) Reset() {
+	*x = GetRegionRequest{}
+	mi := &file_api_v1_region_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetRegionRequest) ProtoMessage() {}
+
+func (x *GetRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_region_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetRegionRequest.ProtoReflect.Descriptor instead.
+func (*GetRegionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_region_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetRegionRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+type UpdateRegionRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RegionId        string                 `protobuf:"bytes,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	RegionName      string                 `protobuf:"bytes,2,opt,name=region_name,json=regionName,proto3" json:"region_name,omitempty"`
+	CoordX          int32                  `protobuf:"varint,3,opt,name=coord_x,json=coordX,proto3" json:"coord_x,omitempty"`
+	CoordY          int32                  `protobuf:"varint,4,opt,name=coord_y,json=coordY,proto3" json:"coord_y,omitempty"`
+	MaxCapacity     int32                  `protobuf:"varint,5,opt,name=max_capacity,json=maxCapacity,proto3" json:"max_capacity,omitempty"`
+	CurrentCapacity int32                  `protobuf:"varint,6,opt,name=current_capacity,json=currentCapacity,proto3" json:"current_capacity,omitempty"`
+	IsFull          bool                   `protobuf:"varint,7,opt,name=is_full,json=isFull,proto3" json:"is_full,omitempty"`
+	SaturatedAt     *string                `protobuf:"bytes,8,opt,name=saturated_at,json=saturatedAt,proto3,oneof" json:"saturated_at,omitempty"` // RFC3339
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateRegionRequest) Reset() {
+	*x = UpdateRegionRequest{}
+	mi := &file_api_v1_region_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateRegionRequest) ProtoMessage() {}
+
+func (x *UpdateRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_region_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateRegionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateRegionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_region_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateRegionRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+func (x *UpdateRegionRequest) GetRegionName() string {
+	if x != nil {
+		return x.RegionName
+	}
+	return ""
+}
+
+func (x *UpdateRegionRequest) GetCoordX() int32 {
+	if x != nil {
+		return x.CoordX
+	}
+	return 0
+}
+
+func (x *UpdateRegionRequest) GetCoordY() int32 {
+	if x != nil {
+		return x.CoordY
+	}
+	return 0
+}
+
+func (x *UpdateRegionRequest) GetMaxCapacity() int32 {
+	if x != nil {
+		return x.MaxCapacity
+	}
+	return 0
+}
+
+func (x *UpdateRegionRequest) GetCurrentCapacity() int32 {
+	if x != nil {
+		return x.CurrentCapacity
+	}
+	return 0
+}
+
+func (x *UpdateRegionRequest) GetIsFull() bool {
+	if x != nil {
+		return x.IsFull
+	}
+	return false
+}
+
+func (x *UpdateRegionRequest) GetSaturatedAt() string {
+	if x != nil && x.SaturatedAt != nil {
+		return *x.SaturatedAt
+	}
+	return ""
+}
+
+type DeleteRegionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RegionId      string                 `protobuf:"bytes,1,opt,name=region_id,json=regionId,proto3" json:"region_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteRegionRequest) Reset() {
+	*x = DeleteRegionRequest{}
+	mi := &file_api_v1_region_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteRegionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteRegionRequest) ProtoMessage() {}
+
+func (x *DeleteRegionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_region_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteRegionRequest.ProtoReflect.Descriptor instead.
+func (*DeleteRegionRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_region_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteRegionRequest) GetRegionId() string {
+	if x != nil {
+		return x.RegionId
+	}
+	return ""
+}
+
+type ListRegionsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sort          string                 `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"` // 정렬 필드, - 접두사는 내림차순 (예: -max_capacity)
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsRequest) Reset() {
+	*x = ListRegionsRequest{}
+	mi := &file_api_v1_region_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsRequest) ProtoMessage() {}
+
+func (x *ListRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_region_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsRequest.ProtoReflect.Descriptor instead.
+func (*ListRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_region_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListRegionsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+// 설정한 필드만 조건으로 사용. 값은 REST 검색 쿼리 파라미터와 같은 형식
+type SearchRegionsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	RegionId        *string                `protobuf:"bytes,1,opt,name=region_id,json=regionId,proto3,oneof" json:"region_id,omitempty"`
+	RegionName      *string                `protobuf:"bytes,2,opt,name=region_name,json=regionName,proto3,oneof" json:"region_name,omitempty"`
+	CoordX          *string                `protobuf:"bytes,3,opt,name=coord_x,json=coordX,proto3,oneof" json:"coord_x,omitempty"`
+	CoordY          *string                `protobuf:"bytes,4,opt,name=coord_y,json=coordY,proto3,oneof" json:"coord_y,omitempty"`
+	MaxCapacity     *string                `protobuf:"bytes,5,opt,name=max_capacity,json=maxCapacity,proto3,oneof" json:"max_capacity,omitempty"`
+	CurrentCapacity *string                `protobuf:"bytes,6,opt,name=current_capacity,json=currentCapacity,proto3,oneof" json:"current_capacity,omitempty"`
+	IsFull          *string                `protobuf:"bytes,7,opt,name=is_full,json=isFull,proto3,oneof" json:"is_full,omitempty"`
+	SaturatedAt     *string                `protobuf:"bytes,8,opt,name=saturated_at,json=saturatedAt,proto3,oneof" json:"saturated_at,omitempty"` // YYYY-MM-DD
+	Sort            string                 `protobuf:"bytes,15,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *SearchRegionsRequest) Reset() {
+	*x = SearchRegionsRequest{}
+	mi := &file_api_v1_region_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchRegionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchRegionsRequest) ProtoMessage() {}
+
+func (x *SearchRegionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_region_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchRegionsRequest.ProtoReflect.Descriptor instead.
+func (*SearchRegionsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_region_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchRegionsRequest) GetRegionId() string {
+	if x != nil && x.RegionId != nil {
+		return *x.RegionId
+	}
+	return ""
+}
+
+func (x *SearchRegionsRequest) GetRegionName() string {
+	if x != nil && x.RegionName != nil {
+		return *x.RegionName
+	}
+	return ""
+}
+
+func (x *SearchRegionsRequest) GetCoordX() string {
+	if x != nil && x.CoordX != nil {
+		return *x.CoordX
+	}
+	return ""
+}
+
+func (x *SearchRegionsRequest) GetCoordY() string {
+	if x != nil && x.CoordY != nil {
+		return *x.CoordY
+	}
+	return ""
+}
+
+func (x *SearchRegionsRequest) GetMaxCapacity() string {
+	if x != nil && x.MaxCapacity != nil {
+		return *x.MaxCapacity
+	}
+	return ""
+}
+
+func (x *SearchRegionsRequest) GetCurrentCapacity() string {
+	if x != nil && x.CurrentCapacity != nil {
+		return *x.CurrentCapacity
+	}
+	return ""
+}
+
+func (x *SearchRegionsRequest) GetIsFull() string {
+	if x != nil && x.IsFull != nil {
+		return *x.IsFull
+	}
+	return ""
+}
+
+func (x *SearchRegionsRequest) GetSaturatedAt() string {
+	if x != nil && x.SaturatedAt != nil {
+		return *x.SaturatedAt
+	}
+	return ""
+}
+
+func (x *SearchRegionsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListRegionsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Regions       []*Region              `protobuf:"bytes,1,rep,name=regions,proto3" json:"regions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListRegionsResponse) Reset() {
+	*x = ListRegionsResponse{}
+	mi := &file_api_v1_region_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListRegionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListRegionsResponse) ProtoMessage() {}
+
+func (x *ListRegionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_region_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListRegionsResponse.ProtoReflect.Descriptor instead.
+func (*ListRegionsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_region_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListRegionsResponse) GetRegions() []*Region {
+	if x != nil {
+		return x.Regions
+	}
+	return nil
+}
+
+var File_api_v1_region_proto protoreflect.FileDescriptor
+
+const file_api_v1_region_proto_rawDesc = "" +
+	"\n" +
+	"\x13api/v1/region.proto\x12\x06api.v1\x1a\x1bgoogle/protobuf/empty.proto\"\x98\x02\n" +
+	"\x06Region\x12\x1b\n" +
+	"\tregion_id\x18\x01 \x01(\tR\bregionId\x12\x1f\n" +
+	"\vregion_name\x18\x02 \x01(\tR\n" +
+	"regionName\x12\x17\n" +
+	"\acoord_x\x18\x03 \x01(\x05R\x06coordX\x12\x17\n" +
+	"\acoord_y\x18\x04 \x01(\x05R\x06coordY\x12!\n" +
+	"\fmax_capacity\x18\x05 \x01(\x05R\vmaxCapacity\x12)\n" +
+	"\x10current_capacity\x18\x06 \x01(\x05R\x0fcurrentCapacity\x12\x17\n" +
+	"\ais_full\x18\a \x01(\bR\x06isFull\x12&\n" +
+	"\fsaturated_at\x18\b \x01(\tH\x00R\vsaturatedAt\x88\x01\x01B\x0f\n" +
+	"\r_saturated_at\"\xa8\x01\n" +
+	"\x13CreateRegionRequest\x12\x1b\n" +
+	"\tregion_id\x18\x01 \x01(\tR\bregionId\x12\x1f\n" +
+	"\vregion_name\x18\x02 \x01(\tR\n" +
+	"regionName\x12\x17\n" +
+	"\acoord_x\x18\x03 \x01(\x05R\x06coordX\x12\x17\n" +
+	"\acoord_y\x18\x04 \x01(\x05R\x06coordY\x12!\n" +
+	"\fmax_capacity\x18\x05 \x01(\x05R\vmaxCapacity\"/\n" +
+	"\x10GetRegionRequest\x12\x1b\n" +
+	"\tregion_id\x18\x01 \x01(\tR\bregionId\"\xa5\x02\n" +
+	"\x13UpdateRegionRequest\x12\x1b\n" +
+	"\tregion_id\x18\x01 \x01(\tR\bregionId\x12\x1f\n" +
+	"\vregion_name\x18\x02 \x01(\tR\n" +
+	"regionName\x12\x17\n" +
+	"\acoord_x\x18\x03 \x01(\x05R\x06coordX\x12\x17\n" +
+	"\acoord_y\x18\x04 \x01(\x05R\x06coordY\x12!\n" +
+	"\fmax_capacity\x18\x05 \x01(\x05R\vmaxCapacity\x12)\n" +
+	"\x10current_capacity\x18\x06 \x01(\x05R\x0fcurrentCapacity\x12\x17\n" +
+	"\ais_full\x18\a \x01(\bR\x06isFull\x12&\n" +
+	"\fsaturated_at\x18\b \x01(\tH\x00R\vsaturatedAt\x88\x01\x01B\x0f\n" +
+	"\r_saturated_at\"2\n" +
+	"\x13DeleteRegionRequest\x12\x1b\n" +
+	"\tregion_id\x18\x01 \x01(\tR\bregionId\"(\n" +
+	"\x12ListRegionsRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\"\xc5\x03\n" +
+	"\x14SearchRegionsRequest\x12 \n" +
+	"\tregion_id\x18\x01 \x01(\tH\x00R\bregionId\x88\x01\x01\x12$\n" +
+	"\vregion_name\x18\x02 \x01(\tH\x01R\n" +
+	"regionName\x88\x01\x01\x12\x1c\n" +
+	"\acoord_x\x18\x03 \x01(\tH\x02R\x06coordX\x88\x01\x01\x12\x1c\n" +
+	"\acoord_y\x18\x04 \x01(\tH\x03R\x06coordY\x88\x01\x01\x12&\n" +
+	"\fmax_capacity\x18\x05 \x01(\tH\x04R\vmaxCapacity\x88\x01\x01\x12.\n" +
+	"\x10current_capacity\x18\x06 \x01(\tH\x05R\x0fcurrentCapacity\x88\x01\x01\x12\x1c\n" +
+	"\ais_full\x18\a \x01(\tH\x06R\x06isFull\x88\x01\x01\x12&\n" +
+	"\fsaturated_at\x18\b \x01(\tH\aR\vsaturatedAt\x88\x01\x01\x12\x12\n" +
+	"\x04sort\x18\x0f \x01(\tR\x04sortB\f\n" +
+	"\n" +
+	"_region_idB\x0e\n" +
+	"\f_region_nameB\n" +
+	"\n" +
+	"\b_coord_xB\n" +
+	"\n" +
+	"\b_coord_yB\x0f\n" +
+	"\r_max_capacityB\x13\n" +
+	"\x11_current_capacityB\n" +
+	"\n" +
+	"\b_is_fullB\x0f\n" +
+	"\r_saturated_at\"?\n" +
+	"\x13ListRegionsResponse\x12(\n" +
+	"\aregions\x18\x01 \x03(\v2\x0e.api.v1.RegionR\aregions2\x99\x03\n" +
+	"\rRegionService\x12;\n" +
+	"\fCreateRegion\x12\x1b.api.v1.CreateRegionRequest\x1a\x0e.api.v1.Region\x125\n" +
+	"\tGetRegion\x12\x18.api.v1.GetRegionRequest\x1a\x0e.api.v1.Region\x12;\n" +
+	"\fUpdateRegion\x12\x1b.api.v1.UpdateRegionRequest\x1a\x0e.api.v1.Region\x12C\n" +
+	"\fDeleteRegion\x12\x1b.api.v1.DeleteRegionRequest\x1a\x16.google.protobuf.Empty\x12F\n" +
+	"\vListRegions\x12\x1a.api.v1.ListRegionsRequest\x1a\x1b.api.v1.ListRegionsResponse\x12J\n" +
+	"\rSearchRegions\x12\x1c.api.v1.SearchRegionsRequest\x1a\x1b.api.v1.ListRegionsResponseB7Z5github.com/baboyiban/go-api-server/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_api_v1_region_proto_rawDescOnce sync.Once
+	file_api_v1_region_proto_rawDescData []byte
+)
+
+func file_api_v1_region_proto_rawDescGZIP() []byte {
+	file_api_v1_region_proto_rawDescOnce.Do(func() {
+		file_api_v1_region_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_region_proto_rawDesc), len(file_api_v1_region_proto_rawDesc)))
+	})
+	return file_api_v1_region_proto_rawDescData
+}
+
+var file_api_v1_region_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_region_proto_goTypes = []any{
+	(*Region)(nil),               // 0: api.v1.Region
+	(*CreateRegionRequest)(nil),  // 1: api.v1.CreateRegionRequest
+	(*GetRegionRequest)(nil),     // 2: api.v1.GetRegionRequest
+	(*UpdateRegionRequest)(nil),  // 3: api.v1.UpdateRegionRequest
+	(*DeleteRegionRequest)(nil),  // 4: api.v1.DeleteRegionRequest
+	(*ListRegionsRequest)(nil),   // 5: api.v1.ListRegionsRequest
+	(*SearchRegionsRequest)(nil), // 6: api.v1.SearchRegionsRequest
+	(*ListRegionsResponse)(nil),  // 7: api.v1.ListRegionsResponse
+	(*emptypb.Empty)(nil),        // 8: google.protobuf.Empty
+}
+var file_api_v1_region_proto_depIdxs = []int32{
+	0, // 0: api.v1.ListRegionsResponse.regions:type_name -> api.v1.Region
+	1, // 1: api.v1.RegionService.CreateRegion:input_type -> api.v1.CreateRegionRequest
+	2, // 2: api.v1.RegionService.GetRegion:input_type -> api.v1.GetRegionRequest
+	3, // 3: api.v1.RegionService.UpdateRegion:input_type -> api.v1.UpdateRegionRequest
+	4, // 4: api.v1.RegionService.DeleteRegion:input_type -> api.v1.DeleteRegionRequest
+	5, // 5: api.v1.RegionService.ListRegions:input_type -> api.v1.ListRegionsRequest
+	6, // 6: api.v1.RegionService.SearchRegions:input_type -> api.v1.SearchRegionsRequest
+	0, // 7: api.v1.RegionService.CreateRegion:output_type -> api.v1.Region
+	0, // 8: api.v1.RegionService.GetRegion:output_type -> api.v1.Region
+	0, // 9: api.v1.RegionService.UpdateRegion:output_type -> api.v1.Region
+	8, // 10: api.v1.RegionService.DeleteRegion:output_type -> google.protobuf.Empty
+	7, // 11: api.v1.RegionService.ListRegions:output_type -> api.v1.ListRegionsResponse
+	7, // 12: api.v1.RegionService.SearchRegions:output_type -> api.v1.ListRegionsResponse
+	7, // [7:13] is the sub-list for method output_type
+	1, // [1:7] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_region_proto_init() }
+func file_api_v1_region_proto_init() {
+	if File_api_v1_region_proto != nil {
+		return
+	}
+	file_api_v1_region_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_v1_region_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_v1_region_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_region_proto_rawDesc), len(file_api_v1_region_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_region_proto_goTypes,
+		DependencyIndexes: file_api_v1_region_proto_depIdxs,
+		MessageInfos:      file_api_v1_region_proto_msgTypes,
+	}.Build()
+	File_api_v1_region_proto = out.File
+	file_api_v1_region_proto_goTypes = nil
+	file_api_v1_region_proto_depIdxs = nil
+}
@@ -0,0 +1,716 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/trip_log.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type TripLog struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	VehicleId     string                 `protobuf:"bytes,2,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	DriverId      *int32                 `protobuf:"varint,3,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+	StartTime     *string                `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // RFC3339
+	EndTime       *string                `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // RFC3339
+	Status        string                 `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`                              // 운행중, 비운행중
+	Destination   *string                `protobuf:"bytes,7,opt,name=destination,proto3,oneof" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TripLog) Reset() {
+	*x = TripLog{}
+	mi := &file_api_v1_trip_log_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TripLog) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TripLog) ProtoMessage() {}
+
+func (x *TripLog) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_trip_log_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TripLog.ProtoReflect.Descriptor instead.
+func (*TripLog) Descriptor() ([]byte, []int) {
+	return file_api_v1_trip_log_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TripLog) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *TripLog) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *TripLog) GetDriverId() int32 {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return 0
+}
+
+func (x *TripLog) GetStartTime() string {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return ""
+}
+
+func (x *TripLog) GetEndTime() string {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return ""
+}
+
+func (x *TripLog) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *TripLog) GetDestination() string {
+	if x != nil && x.Destination != nil {
+		return *x.Destination
+	}
+	return ""
+}
+
+type CreateTripLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId     string                 `protobuf:"bytes,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	DriverId      *int32                 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"` // 생략 시 해당 차량의 진행 중인 근무자
+	StartTime     *string                `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *string                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Destination   *string                `protobuf:"bytes,6,opt,name=destination,proto3,oneof" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTripLogRequest) Reset() {
+	*x = CreateTripLogRequest{}
+	mi := &file_api_v1_trip_log_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTripLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTripLogRequest) ProtoMessage() {}
+
+func (x *CreateTripLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_trip_log_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTripLogRequest.ProtoReflect.Descriptor instead.
+func (*CreateTripLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_trip_log_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateTripLogRequest) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *CreateTripLogRequest) GetDriverId() int32 {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return 0
+}
+
+func (x *CreateTripLogRequest) GetStartTime() string {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return ""
+}
+
+func (x *CreateTripLogRequest) GetEndTime() string {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return ""
+}
+
+func (x *CreateTripLogRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *CreateTripLogRequest) GetDestination() string {
+	if x != nil && x.Destination != nil {
+		return *x.Destination
+	}
+	return ""
+}
+
+type GetTripLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTripLogRequest) Reset() {
+	*x = GetTripLogRequest{}
+	mi := &file_api_v1_trip_log_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTripLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTripLogRequest) ProtoMessage() {}
+
+func (x *GetTripLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_trip_log_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTripLogRequest.ProtoReflect.Descriptor instead.
+func (*GetTripLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_trip_log_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetTripLogRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type UpdateTripLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	DriverId      *int32                 `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+	StartTime     *string                `protobuf:"bytes,3,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"`
+	EndTime       *string                `protobuf:"bytes,4,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Destination   *string                `protobuf:"bytes,6,opt,name=destination,proto3,oneof" json:"destination,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateTripLogRequest) Reset() {
+	*x = UpdateTripLogRequest{}
+	mi := &file_api_v1_trip_log_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateTripLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateTripLogRequest) ProtoMessage() {}
+
+func (x *UpdateTripLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_trip_log_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateTripLogRequest.ProtoReflect.Descriptor instead.
+func (*UpdateTripLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_trip_log_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateTripLogRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+func (x *UpdateTripLogRequest) GetDriverId() int32 {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return 0
+}
+
+func (x *UpdateTripLogRequest) GetStartTime() string {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return ""
+}
+
+func (x *UpdateTripLogRequest) GetEndTime() string {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return ""
+}
+
+func (x *UpdateTripLogRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *UpdateTripLogRequest) GetDestination() string {
+	if x != nil && x.Destination != nil {
+		return *x.Destination
+	}
+	return ""
+}
+
+type DeleteTripLogRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        int32                  `protobuf:"varint,1,opt,name=trip_id,json=tripId,proto3" json:"trip_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteTripLogRequest) Reset() {
+	*x = DeleteTripLogRequest{}
+	mi := &file_api_v1_trip_log_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteTripLogRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteTripLogRequest) ProtoMessage() {}
+
+func (x *DeleteTripLogRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_trip_log_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteTripLogRequest.ProtoReflect.Descriptor instead.
+func (*DeleteTripLogRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_trip_log_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteTripLogRequest) GetTripId() int32 {
+	if x != nil {
+		return x.TripId
+	}
+	return 0
+}
+
+type ListTripLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sort          string                 `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripLogsRequest) Reset() {
+	*x = ListTripLogsRequest{}
+	mi := &file_api_v1_trip_log_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripLogsRequest) ProtoMessage() {}
+
+func (x *ListTripLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_trip_log_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripLogsRequest.ProtoReflect.Descriptor instead.
+func (*ListTripLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_trip_log_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListTripLogsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type SearchTripLogsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripId        *string                `protobuf:"bytes,1,opt,name=trip_id,json=tripId,proto3,oneof" json:"trip_id,omitempty"`
+	VehicleId     *string                `protobuf:"bytes,2,opt,name=vehicle_id,json=vehicleId,proto3,oneof" json:"vehicle_id,omitempty"`
+	DriverId      *string                `protobuf:"bytes,3,opt,name=driver_id,json=driverId,proto3,oneof" json:"driver_id,omitempty"`
+	Status        *string                `protobuf:"bytes,4,opt,name=status,proto3,oneof" json:"status,omitempty"`
+	Destination   *string                `protobuf:"bytes,5,opt,name=destination,proto3,oneof" json:"destination,omitempty"`
+	StartTime     *string                `protobuf:"bytes,6,opt,name=start_time,json=startTime,proto3,oneof" json:"start_time,omitempty"` // YYYY-MM-DD
+	EndTime       *string                `protobuf:"bytes,7,opt,name=end_time,json=endTime,proto3,oneof" json:"end_time,omitempty"`       // YYYY-MM-DD
+	Sort          string                 `protobuf:"bytes,15,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SearchTripLogsRequest) Reset() {
+	*x = SearchTripLogsRequest{}
+	mi := &file_api_v1_trip_log_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchTripLogsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchTripLogsRequest) ProtoMessage() {}
+
+func (x *SearchTripLogsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_trip_log_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchTripLogsRequest.ProtoReflect.Descriptor instead.
+func (*SearchTripLogsRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_trip_log_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchTripLogsRequest) GetTripId() string {
+	if x != nil && x.TripId != nil {
+		return *x.TripId
+	}
+	return ""
+}
+
+func (x *SearchTripLogsRequest) GetVehicleId() string {
+	if x != nil && x.VehicleId != nil {
+		return *x.VehicleId
+	}
+	return ""
+}
+
+func (x *SearchTripLogsRequest) GetDriverId() string {
+	if x != nil && x.DriverId != nil {
+		return *x.DriverId
+	}
+	return ""
+}
+
+func (x *SearchTripLogsRequest) GetStatus() string {
+	if x != nil && x.Status != nil {
+		return *x.Status
+	}
+	return ""
+}
+
+func (x *SearchTripLogsRequest) GetDestination() string {
+	if x != nil && x.Destination != nil {
+		return *x.Destination
+	}
+	return ""
+}
+
+func (x *SearchTripLogsRequest) GetStartTime() string {
+	if x != nil && x.StartTime != nil {
+		return *x.StartTime
+	}
+	return ""
+}
+
+func (x *SearchTripLogsRequest) GetEndTime() string {
+	if x != nil && x.EndTime != nil {
+		return *x.EndTime
+	}
+	return ""
+}
+
+func (x *SearchTripLogsRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListTripLogsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TripLogs      []*TripLog             `protobuf:"bytes,1,rep,name=trip_logs,json=tripLogs,proto3" json:"trip_logs,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTripLogsResponse) Reset() {
+	*x = ListTripLogsResponse{}
+	mi := &file_api_v1_trip_log_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTripLogsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTripLogsResponse) ProtoMessage() {}
+
+func (x *ListTripLogsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_trip_log_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTripLogsResponse.ProtoReflect.Descriptor instead.
+func (*ListTripLogsResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_trip_log_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListTripLogsResponse) GetTripLogs() []*TripLog {
+	if x != nil {
+		return x.TripLogs
+	}
+	return nil
+}
+
+var File_api_v1_trip_log_proto protoreflect.FileDescriptor
+
+const file_api_v1_trip_log_proto_rawDesc = "" +
+	"\n" +
+	"\x15api/v1/trip_log.proto\x12\x06api.v1\x1a\x1bgoogle/protobuf/empty.proto\"\xa0\x02\n" +
+	"\aTripLog\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x02 \x01(\tR\tvehicleId\x12 \n" +
+	"\tdriver_id\x18\x03 \x01(\x05H\x00R\bdriverId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x04 \x01(\tH\x01R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x05 \x01(\tH\x02R\aendTime\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\x12%\n" +
+	"\vdestination\x18\a \x01(\tH\x03R\vdestination\x88\x01\x01B\f\n" +
+	"\n" +
+	"_driver_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\x0e\n" +
+	"\f_destination\"\x94\x02\n" +
+	"\x14CreateTripLogRequest\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x01 \x01(\tR\tvehicleId\x12 \n" +
+	"\tdriver_id\x18\x02 \x01(\x05H\x00R\bdriverId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tH\x01R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x04 \x01(\tH\x02R\aendTime\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12%\n" +
+	"\vdestination\x18\x06 \x01(\tH\x03R\vdestination\x88\x01\x01B\f\n" +
+	"\n" +
+	"_driver_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\x0e\n" +
+	"\f_destination\",\n" +
+	"\x11GetTripLogRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\"\x8e\x02\n" +
+	"\x14UpdateTripLogRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\x12 \n" +
+	"\tdriver_id\x18\x02 \x01(\x05H\x00R\bdriverId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x03 \x01(\tH\x01R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\x04 \x01(\tH\x02R\aendTime\x88\x01\x01\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12%\n" +
+	"\vdestination\x18\x06 \x01(\tH\x03R\vdestination\x88\x01\x01B\f\n" +
+	"\n" +
+	"_driver_idB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_timeB\x0e\n" +
+	"\f_destination\"/\n" +
+	"\x14DeleteTripLogRequest\x12\x17\n" +
+	"\atrip_id\x18\x01 \x01(\x05R\x06tripId\")\n" +
+	"\x13ListTripLogsRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\"\xf7\x02\n" +
+	"\x15SearchTripLogsRequest\x12\x1c\n" +
+	"\atrip_id\x18\x01 \x01(\tH\x00R\x06tripId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"vehicle_id\x18\x02 \x01(\tH\x01R\tvehicleId\x88\x01\x01\x12 \n" +
+	"\tdriver_id\x18\x03 \x01(\tH\x02R\bdriverId\x88\x01\x01\x12\x1b\n" +
+	"\x06status\x18\x04 \x01(\tH\x03R\x06status\x88\x01\x01\x12%\n" +
+	"\vdestination\x18\x05 \x01(\tH\x04R\vdestination\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"start_time\x18\x06 \x01(\tH\x05R\tstartTime\x88\x01\x01\x12\x1e\n" +
+	"\bend_time\x18\a \x01(\tH\x06R\aendTime\x88\x01\x01\x12\x12\n" +
+	"\x04sort\x18\x0f \x01(\tR\x04sortB\n" +
+	"\n" +
+	"\b_trip_idB\r\n" +
+	"\v_vehicle_idB\f\n" +
+	"\n" +
+	"_driver_idB\t\n" +
+	"\a_statusB\x0e\n" +
+	"\f_destinationB\r\n" +
+	"\v_start_timeB\v\n" +
+	"\t_end_time\"D\n" +
+	"\x14ListTripLogsResponse\x12,\n" +
+	"\ttrip_logs\x18\x01 \x03(\v2\x0f.api.v1.TripLogR\btripLogs2\xab\x03\n" +
+	"\x0eTripLogService\x12>\n" +
+	"\rCreateTripLog\x12\x1c.api.v1.CreateTripLogRequest\x1a\x0f.api.v1.TripLog\x128\n" +
+	"\n" +
+	"GetTripLog\x12\x19.api.v1.GetTripLogRequest\x1a\x0f.api.v1.TripLog\x12>\n" +
+	"\rUpdateTripLog\x12\x1c.api.v1.UpdateTripLogRequest\x1a\x0f.api.v1.TripLog\x12E\n" +
+	"\rDeleteTripLog\x12\x1c.api.v1.DeleteTripLogRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\fListTripLogs\x12\x1b.api.v1.ListTripLogsRequest\x1a\x1c.api.v1.ListTripLogsResponse\x12M\n" +
+	"\x0eSearchTripLogs\x12\x1d.api.v1.SearchTripLogsRequest\x1a\x1c.api.v1.ListTripLogsResponseB7Z5github.com/baboyiban/go-api-server/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_api_v1_trip_log_proto_rawDescOnce sync.Once
+	file_api_v1_trip_log_proto_rawDescData []byte
+)
+
+func file_api_v1_trip_log_proto_rawDescGZIP() []byte {
+	file_api_v1_trip_log_proto_rawDescOnce.Do(func() {
+		file_api_v1_trip_log_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_trip_log_proto_rawDesc), len(file_api_v1_trip_log_proto_rawDesc)))
+	})
+	return file_api_v1_trip_log_proto_rawDescData
+}
+
+var file_api_v1_trip_log_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_api_v1_trip_log_proto_goTypes = []any{
+	(*TripLog)(nil),               // 0: api.v1.TripLog
+	(*CreateTripLogRequest)(nil),  // 1: api.v1.CreateTripLogRequest
+	(*GetTripLogRequest)(nil),     // 2: api.v1.GetTripLogRequest
+	(*UpdateTripLogRequest)(nil),  // 3: api.v1.UpdateTripLogRequest
+	(*DeleteTripLogRequest)(nil),  // 4: api.v1.DeleteTripLogRequest
+	(*ListTripLogsRequest)(nil),   // 5: api.v1.ListTripLogsRequest
+	(*SearchTripLogsRequest)(nil), // 6: api.v1.SearchTripLogsRequest
+	(*ListTripLogsResponse)(nil),  // 7: api.v1.ListTripLogsResponse
+	(*emptypb.Empty)(nil),         // 8: google.protobuf.Empty
+}
+var file_api_v1_trip_log_proto_depIdxs = []int32{
+	0, // 0: api.v1.ListTripLogsResponse.trip_logs:type_name -> api.v1.TripLog
+	1, // 1: api.v1.TripLogService.CreateTripLog:input_type -> api.v1.CreateTripLogRequest
+	2, // 2: api.v1.TripLogService.GetTripLog:input_type -> api.v1.GetTripLogRequest
+	3, // 3: api.v1.TripLogService.UpdateTripLog:input_type -> api.v1.UpdateTripLogRequest
+	4, // 4: api.v1.TripLogService.DeleteTripLog:input_type -> api.v1.DeleteTripLogRequest
+	5, // 5: api.v1.TripLogService.ListTripLogs:input_type -> api.v1.ListTripLogsRequest
+	6, // 6: api.v1.TripLogService.SearchTripLogs:input_type -> api.v1.SearchTripLogsRequest
+	0, // 7: api.v1.TripLogService.CreateTripLog:output_type -> api.v1.TripLog
+	0, // 8: api.v1.TripLogService.GetTripLog:output_type -> api.v1.TripLog
+	0, // 9: api.v1.TripLogService.UpdateTripLog:output_type -> api.v1.TripLog
+	8, // 10: api.v1.TripLogService.DeleteTripLog:output_type -> google.protobuf.Empty
+	7, // 11: api.v1.TripLogService.ListTripLogs:output_type -> api.v1.ListTripLogsResponse
+	7, // 12: api.v1.TripLogService.SearchTripLogs:output_type -> api.v1.ListTripLogsResponse
+	7, // [7:13] is the sub-list for method output_type
+	1, // [1:7] is the sub-list for method input_type
+	1, // [1:1] is the sub-list for extension type_name
+	1, // [1:1] is the sub-list for extension extendee
+	0, // [0:1] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_trip_log_proto_init() }
+func file_api_v1_trip_log_proto_init() {
+	if File_api_v1_trip_log_proto != nil {
+		return
+	}
+	file_api_v1_trip_log_proto_msgTypes[0].OneofWrappers = []any{}
+	file_api_v1_trip_log_proto_msgTypes[1].OneofWrappers = []any{}
+	file_api_v1_trip_log_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_v1_trip_log_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_trip_log_proto_rawDesc), len(file_api_v1_trip_log_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   8,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_trip_log_proto_goTypes,
+		DependencyIndexes: file_api_v1_trip_log_proto_depIdxs,
+		MessageInfos:      file_api_v1_trip_log_proto_msgTypes,
+	}.Build()
+	File_api_v1_trip_log_proto = out.File
+	file_api_v1_trip_log_proto_goTypes = nil
+	file_api_v1_trip_log_proto_depIdxs = nil
+}
@@ -0,0 +1,316 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.5.1
+// - protoc             (unknown)
+// source: api/v1/trip_log.proto
+
+package apiv1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.64.0 or later.
+const _ = grpc.SupportPackageIsVersion9
+
+const (
+	TripLogService_CreateTripLog_FullMethodName  = "/api.v1.TripLogService/CreateTripLog"
+	TripLogService_GetTripLog_FullMethodName     = "/api.v1.TripLogService/GetTripLog"
+	TripLogService_UpdateTripLog_FullMethodName  = "/api.v1.TripLogService/UpdateTripLog"
+	TripLogService_DeleteTripLog_FullMethodName  = "/api.v1.TripLogService/DeleteTripLog"
+	TripLogService_ListTripLogs_FullMethodName   = "/api.v1.TripLogService/ListTripLogs"
+	TripLogService_SearchTripLogs_FullMethodName = "/api.v1.TripLogService/SearchTripLogs"
+)
+
+// TripLogServiceClient is the client API for TripLogService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+//
+// 운행 기록. REST /api/trip-log 와 같은 서비스 계층을 사용
+type TripLogServiceClient interface {
+	CreateTripLog(ctx context.Context, in *CreateTripLogRequest, opts ...grpc.CallOption) (*TripLog, error)
+	GetTripLog(ctx context.Context, in *GetTripLogRequest, opts ...grpc.CallOption) (*TripLog, error)
+	UpdateTripLog(ctx context.Context, in *UpdateTripLogRequest, opts ...grpc.CallOption) (*TripLog, error)
+	DeleteTripLog(ctx context.Context, in *DeleteTripLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListTripLogs(ctx context.Context, in *ListTripLogsRequest, opts ...grpc.CallOption) (*ListTripLogsResponse, error)
+	SearchTripLogs(ctx context.Context, in *SearchTripLogsRequest, opts ...grpc.CallOption) (*ListTripLogsResponse, error)
+}
+
+type tripLogServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTripLogServiceClient(cc grpc.ClientConnInterface) TripLogServiceClient {
+	return &tripLogServiceClient{cc}
+}
+
+func (c *tripLogServiceClient) CreateTripLog(ctx context.Context, in *CreateTripLogRequest, opts ...grpc.CallOption) (*TripLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TripLog)
+	err := c.cc.Invoke(ctx, TripLogService_CreateTripLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripLogServiceClient) GetTripLog(ctx context.Context, in *GetTripLogRequest, opts ...grpc.CallOption) (*TripLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TripLog)
+	err := c.cc.Invoke(ctx, TripLogService_GetTripLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripLogServiceClient) UpdateTripLog(ctx context.Context, in *UpdateTripLogRequest, opts ...grpc.CallOption) (*TripLog, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(TripLog)
+	err := c.cc.Invoke(ctx, TripLogService_UpdateTripLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripLogServiceClient) DeleteTripLog(ctx context.Context, in *DeleteTripLogRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, TripLogService_DeleteTripLog_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripLogServiceClient) ListTripLogs(ctx context.Context, in *ListTripLogsRequest, opts ...grpc.CallOption) (*ListTripLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTripLogsResponse)
+	err := c.cc.Invoke(ctx, TripLogService_ListTripLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *tripLogServiceClient) SearchTripLogs(ctx context.Context, in *SearchTripLogsRequest, opts ...grpc.CallOption) (*ListTripLogsResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListTripLogsResponse)
+	err := c.cc.Invoke(ctx, TripLogService_SearchTripLogs_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TripLogServiceServer is the server API for TripLogService service.
+// All implementations must embed UnimplementedTripLogServiceServer
+// for forward compatibility.
+//
+// 운행 기록. REST /api/trip-log 와 같은 서비스 계층을 사용
+type TripLogServiceServer interface {
+	CreateTripLog(context.Context, *CreateTripLogRequest) (*TripLog, error)
+	GetTripLog(context.Context, *GetTripLogRequest) (*TripLog, error)
+	UpdateTripLog(context.Context, *UpdateTripLogRequest) (*TripLog, error)
+	DeleteTripLog(context.Context, *DeleteTripLogRequest) (*emptypb.Empty, error)
+	ListTripLogs(context.Context, *ListTripLogsRequest) (*ListTripLogsResponse, error)
+	SearchTripLogs(context.Context, *SearchTripLogsRequest) (*ListTripLogsResponse, error)
+	mustEmbedUnimplementedTripLogServiceServer()
+}
+
+// UnimplementedTripLogServiceServer must be embedded to have
+// forward compatible implementations.
+//
+// NOTE: this should be embedded by value instead of pointer to avoid a nil
+// pointer dereference when methods are called.
+type UnimplementedTripLogServiceServer struct{}
+
+func (UnimplementedTripLogServiceServer) CreateTripLog(context.Context, *CreateTripLogRequest) (*TripLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTripLog not implemented")
+}
+func (UnimplementedTripLogServiceServer) GetTripLog(context.Context, *GetTripLogRequest) (*TripLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTripLog not implemented")
+}
+func (UnimplementedTripLogServiceServer) UpdateTripLog(context.Context, *UpdateTripLogRequest) (*TripLog, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateTripLog not implemented")
+}
+func (UnimplementedTripLogServiceServer) DeleteTripLog(context.Context, *DeleteTripLogRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteTripLog not implemented")
+}
+func (UnimplementedTripLogServiceServer) ListTripLogs(context.Context, *ListTripLogsRequest) (*ListTripLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListTripLogs not implemented")
+}
+func (UnimplementedTripLogServiceServer) SearchTripLogs(context.Context, *SearchTripLogsRequest) (*ListTripLogsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchTripLogs not implemented")
+}
+func (UnimplementedTripLogServiceServer) mustEmbedUnimplementedTripLogServiceServer() {}
+func (UnimplementedTripLogServiceServer) testEmbeddedByValue()                        {}
+
+// UnsafeTripLogServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to TripLogServiceServer will
+// result in compilation errors.
+type UnsafeTripLogServiceServer interface {
+	mustEmbedUnimplementedTripLogServiceServer()
+}
+
+func RegisterTripLogServiceServer(s grpc.ServiceRegistrar, srv TripLogServiceServer) {
+	// If the following call pancis, it indicates UnimplementedTripLogServiceServer was
+	// embedded by pointer and is nil.  This will cause panics if an
+	// unimplemented method is ever invoked, so we test this at initialization
+	// time to prevent it from happening at runtime later due to I/O.
+	if t, ok := srv.(interface{ testEmbeddedByValue() }); ok {
+		t.testEmbeddedByValue()
+	}
+	s.RegisterService(&TripLogService_ServiceDesc, srv)
+}
+
+func _TripLogService_CreateTripLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTripLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripLogServiceServer).CreateTripLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripLogService_CreateTripLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripLogServiceServer).CreateTripLog(ctx, req.(*CreateTripLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripLogService_GetTripLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTripLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripLogServiceServer).GetTripLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripLogService_GetTripLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripLogServiceServer).GetTripLog(ctx, req.(*GetTripLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripLogService_UpdateTripLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTripLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripLogServiceServer).UpdateTripLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripLogService_UpdateTripLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripLogServiceServer).UpdateTripLog(ctx, req.(*UpdateTripLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripLogService_DeleteTripLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTripLogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripLogServiceServer).DeleteTripLog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripLogService_DeleteTripLog_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripLogServiceServer).DeleteTripLog(ctx, req.(*DeleteTripLogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripLogService_ListTripLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTripLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripLogServiceServer).ListTripLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripLogService_ListTripLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripLogServiceServer).ListTripLogs(ctx, req.(*ListTripLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TripLogService_SearchTripLogs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchTripLogsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TripLogServiceServer).SearchTripLogs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: TripLogService_SearchTripLogs_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TripLogServiceServer).SearchTripLogs(ctx, req.(*SearchTripLogsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// TripLogService_ServiceDesc is the grpc.ServiceDesc for TripLogService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var TripLogService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "api.v1.TripLogService",
+	HandlerType: (*TripLogServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateTripLog",
+			Handler:    _TripLogService_CreateTripLog_Handler,
+		},
+		{
+			MethodName: "GetTripLog",
+			Handler:    _TripLogService_GetTripLog_Handler,
+		},
+		{
+			MethodName: "UpdateTripLog",
+			Handler:    _TripLogService_UpdateTripLog_Handler,
+		},
+		{
+			MethodName: "DeleteTripLog",
+			Handler:    _TripLogService_DeleteTripLog_Handler,
+		},
+		{
+			MethodName: "ListTripLogs",
+			Handler:    _TripLogService_ListTripLogs_Handler,
+		},
+		{
+			MethodName: "SearchTripLogs",
+			Handler:    _TripLogService_SearchTripLogs_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "api/v1/trip_log.proto",
+}
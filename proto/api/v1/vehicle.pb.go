@@ -0,0 +1,805 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: api/v1/vehicle.proto
+
+package apiv1
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Vehicle struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InternalId        int32                  `protobuf:"varint,1,opt,name=internal_id,json=internalId,proto3" json:"internal_id,omitempty"`
+	VehicleId         string                 `protobuf:"bytes,2,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	CurrentLoad       int32                  `protobuf:"varint,3,opt,name=current_load,json=currentLoad,proto3" json:"current_load,omitempty"`
+	MaxLoad           int32                  `protobuf:"varint,4,opt,name=max_load,json=maxLoad,proto3" json:"max_load,omitempty"`
+	LedStatus         string                 `protobuf:"bytes,5,opt,name=led_status,json=ledStatus,proto3" json:"led_status,omitempty"` // off, green, yellow, red, blue
+	NeedsConfirmation bool                   `protobuf:"varint,6,opt,name=needs_confirmation,json=needsConfirmation,proto3" json:"needs_confirmation,omitempty"`
+	CoordX            int32                  `protobuf:"varint,7,opt,name=coord_x,json=coordX,proto3" json:"coord_x,omitempty"`
+	CoordY            int32                  `protobuf:"varint,8,opt,name=coord_y,json=coordY,proto3" json:"coord_y,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Vehicle) Reset() {
+	*x = Vehicle{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vehicle) ProtoMessage() {}
+
+func (x *Vehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vehicle.ProtoReflect.Descriptor instead.
+func (*Vehicle) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Vehicle) GetInternalId() int32 {
+	if x != nil {
+		return x.InternalId
+	}
+	return 0
+}
+
+func (x *Vehicle) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *Vehicle) GetCurrentLoad() int32 {
+	if x != nil {
+		return x.CurrentLoad
+	}
+	return 0
+}
+
+func (x *Vehicle) GetMaxLoad() int32 {
+	if x != nil {
+		return x.MaxLoad
+	}
+	return 0
+}
+
+func (x *Vehicle) GetLedStatus() string {
+	if x != nil {
+		return x.LedStatus
+	}
+	return ""
+}
+
+func (x *Vehicle) GetNeedsConfirmation() bool {
+	if x != nil {
+		return x.NeedsConfirmation
+	}
+	return false
+}
+
+func (x *Vehicle) GetCoordX() int32 {
+	if x != nil {
+		return x.CoordX
+	}
+	return 0
+}
+
+func (x *Vehicle) GetCoordY() int32 {
+	if x != nil {
+		return x.CoordY
+	}
+	return 0
+}
+
+type CreateVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	VehicleId     string                 `protobuf:"bytes,1,opt,name=vehicle_id,json=vehicleId,proto3" json:"vehicle_id,omitempty"`
+	MaxLoad       int32                  `protobuf:"varint,2,opt,name=max_load,json=maxLoad,proto3" json:"max_load,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateVehicleRequest) Reset() {
+	*x = CreateVehicleRequest{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateVehicleRequest) ProtoMessage() {}
+
+func (x *CreateVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateVehicleRequest.ProtoReflect.Descriptor instead.
+func (*CreateVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *CreateVehicleRequest) GetVehicleId() string {
+	if x != nil {
+		return x.VehicleId
+	}
+	return ""
+}
+
+func (x *CreateVehicleRequest) GetMaxLoad() int32 {
+	if x != nil {
+		return x.MaxLoad
+	}
+	return 0
+}
+
+type GetVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InternalId    int32                  `protobuf:"varint,1,opt,name=internal_id,json=internalId,proto3" json:"internal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetVehicleRequest) Reset() {
+	*x = GetVehicleRequest{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetVehicleRequest) ProtoMessage() {}
+
+func (x *GetVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetVehicleRequest.ProtoReflect.Descriptor instead.
+func (*GetVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *GetVehicleRequest) GetInternalId() int32 {
+	if x != nil {
+		return x.InternalId
+	}
+	return 0
+}
+
+type UpdateVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InternalId    int32                  `protobuf:"varint,1,opt,name=internal_id,json=internalId,proto3" json:"internal_id,omitempty"`
+	MaxLoad       int32                  `protobuf:"varint,2,opt,name=max_load,json=maxLoad,proto3" json:"max_load,omitempty"`
+	LedStatus     *string                `protobuf:"bytes,3,opt,name=led_status,json=ledStatus,proto3,oneof" json:"led_status,omitempty"` // 확인 대기 중에는 변경할 수 없음
+	CoordX        int32                  `protobuf:"varint,4,opt,name=coord_x,json=coordX,proto3" json:"coord_x,omitempty"`
+	CoordY        int32                  `protobuf:"varint,5,opt,name=coord_y,json=coordY,proto3" json:"coord_y,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateVehicleRequest) Reset() {
+	*x = UpdateVehicleRequest{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateVehicleRequest) ProtoMessage() {}
+
+func (x *UpdateVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateVehicleRequest.ProtoReflect.Descriptor instead.
+func (*UpdateVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *UpdateVehicleRequest) GetInternalId() int32 {
+	if x != nil {
+		return x.InternalId
+	}
+	return 0
+}
+
+func (x *UpdateVehicleRequest) GetMaxLoad() int32 {
+	if x != nil {
+		return x.MaxLoad
+	}
+	return 0
+}
+
+func (x *UpdateVehicleRequest) GetLedStatus() string {
+	if x != nil && x.LedStatus != nil {
+		return *x.LedStatus
+	}
+	return ""
+}
+
+func (x *UpdateVehicleRequest) GetCoordX() int32 {
+	if x != nil {
+		return x.CoordX
+	}
+	return 0
+}
+
+func (x *UpdateVehicleRequest) GetCoordY() int32 {
+	if x != nil {
+		return x.CoordY
+	}
+	return 0
+}
+
+type DeleteVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InternalId    int32                  `protobuf:"varint,1,opt,name=internal_id,json=internalId,proto3" json:"internal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteVehicleRequest) Reset() {
+	*x = DeleteVehicleRequest{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteVehicleRequest) ProtoMessage() {}
+
+func (x *DeleteVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteVehicleRequest.ProtoReflect.Descriptor instead.
+func (*DeleteVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *DeleteVehicleRequest) GetInternalId() int32 {
+	if x != nil {
+		return x.InternalId
+	}
+	return 0
+}
+
+type ListVehiclesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Sort          string                 `protobuf:"bytes,1,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVehiclesRequest) Reset() {
+	*x = ListVehiclesRequest{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesRequest) ProtoMessage() {}
+
+func (x *ListVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*ListVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ListVehiclesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type SearchVehiclesRequest struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	InternalId        *string                `protobuf:"bytes,1,opt,name=internal_id,json=internalId,proto3,oneof" json:"internal_id,omitempty"`
+	VehicleId         *string                `protobuf:"bytes,2,opt,name=vehicle_id,json=vehicleId,proto3,oneof" json:"vehicle_id,omitempty"`
+	CurrentLoad       *string                `protobuf:"bytes,3,opt,name=current_load,json=currentLoad,proto3,oneof" json:"current_load,omitempty"`
+	MaxLoad           *string                `protobuf:"bytes,4,opt,name=max_load,json=maxLoad,proto3,oneof" json:"max_load,omitempty"`
+	LedStatus         *string                `protobuf:"bytes,5,opt,name=led_status,json=ledStatus,proto3,oneof" json:"led_status,omitempty"`
+	NeedsConfirmation *string                `protobuf:"bytes,6,opt,name=needs_confirmation,json=needsConfirmation,proto3,oneof" json:"needs_confirmation,omitempty"`
+	CoordX            *string                `protobuf:"bytes,7,opt,name=coord_x,json=coordX,proto3,oneof" json:"coord_x,omitempty"`
+	CoordY            *string                `protobuf:"bytes,8,opt,name=coord_y,json=coordY,proto3,oneof" json:"coord_y,omitempty"`
+	Sort              string                 `protobuf:"bytes,15,opt,name=sort,proto3" json:"sort,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *SearchVehiclesRequest) Reset() {
+	*x = SearchVehiclesRequest{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SearchVehiclesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SearchVehiclesRequest) ProtoMessage() {}
+
+func (x *SearchVehiclesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SearchVehiclesRequest.ProtoReflect.Descriptor instead.
+func (*SearchVehiclesRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *SearchVehiclesRequest) GetInternalId() string {
+	if x != nil && x.InternalId != nil {
+		return *x.InternalId
+	}
+	return ""
+}
+
+func (x *SearchVehiclesRequest) GetVehicleId() string {
+	if x != nil && x.VehicleId != nil {
+		return *x.VehicleId
+	}
+	return ""
+}
+
+func (x *SearchVehiclesRequest) GetCurrentLoad() string {
+	if x != nil && x.CurrentLoad != nil {
+		return *x.CurrentLoad
+	}
+	return ""
+}
+
+func (x *SearchVehiclesRequest) GetMaxLoad() string {
+	if x != nil && x.MaxLoad != nil {
+		return *x.MaxLoad
+	}
+	return ""
+}
+
+func (x *SearchVehiclesRequest) GetLedStatus() string {
+	if x != nil && x.LedStatus != nil {
+		return *x.LedStatus
+	}
+	return ""
+}
+
+func (x *SearchVehiclesRequest) GetNeedsConfirmation() string {
+	if x != nil && x.NeedsConfirmation != nil {
+		return *x.NeedsConfirmation
+	}
+	return ""
+}
+
+func (x *SearchVehiclesRequest) GetCoordX() string {
+	if x != nil && x.CoordX != nil {
+		return *x.CoordX
+	}
+	return ""
+}
+
+func (x *SearchVehiclesRequest) GetCoordY() string {
+	if x != nil && x.CoordY != nil {
+		return *x.CoordY
+	}
+	return ""
+}
+
+func (x *SearchVehiclesRequest) GetSort() string {
+	if x != nil {
+		return x.Sort
+	}
+	return ""
+}
+
+type ListVehiclesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Vehicles      []*Vehicle             `protobuf:"bytes,1,rep,name=vehicles,proto3" json:"vehicles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListVehiclesResponse) Reset() {
+	*x = ListVehiclesResponse{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListVehiclesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListVehiclesResponse) ProtoMessage() {}
+
+func (x *ListVehiclesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListVehiclesResponse.ProtoReflect.Descriptor instead.
+func (*ListVehiclesResponse) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ListVehiclesResponse) GetVehicles() []*Vehicle {
+	if x != nil {
+		return x.Vehicles
+	}
+	return nil
+}
+
+type WatchVehicleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	InternalId    int32                  `protobuf:"varint,1,opt,name=internal_id,json=internalId,proto3" json:"internal_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WatchVehicleRequest) Reset() {
+	*x = WatchVehicleRequest{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchVehicleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchVehicleRequest) ProtoMessage() {}
+
+func (x *WatchVehicleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WatchVehicleRequest.ProtoReflect.Descriptor instead.
+func (*WatchVehicleRequest) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *WatchVehicleRequest) GetInternalId() int32 {
+	if x != nil {
+		return x.InternalId
+	}
+	return 0
+}
+
+type VehicleEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Type          string                 `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`                               // snapshot 또는 아웃박스 이벤트 타입 (예: vehicle.led_changed)
+	EventId       string                 `protobuf:"bytes,2,opt,name=event_id,json=eventId,proto3" json:"event_id,omitempty"`          // snapshot 이면 비어 있음
+	OccurredAt    string                 `protobuf:"bytes,3,opt,name=occurred_at,json=occurredAt,proto3" json:"occurred_at,omitempty"` // RFC3339
+	Vehicle       *Vehicle               `protobuf:"bytes,4,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *VehicleEvent) Reset() {
+	*x = VehicleEvent{}
+	mi := &file_api_v1_vehicle_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *VehicleEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VehicleEvent) ProtoMessage() {}
+
+func (x *VehicleEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_api_v1_vehicle_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VehicleEvent.ProtoReflect.Descriptor instead.
+func (*VehicleEvent) Descriptor() ([]byte, []int) {
+	return file_api_v1_vehicle_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *VehicleEvent) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *VehicleEvent) GetEventId() string {
+	if x != nil {
+		return x.EventId
+	}
+	return ""
+}
+
+func (x *VehicleEvent) GetOccurredAt() string {
+	if x != nil {
+		return x.OccurredAt
+	}
+	return ""
+}
+
+func (x *VehicleEvent) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+var File_api_v1_vehicle_proto protoreflect.FileDescriptor
+
+const file_api_v1_vehicle_proto_rawDesc = "" +
+	"\n" +
+	"\x14api/v1/vehicle.proto\x12\x06api.v1\x1a\x1bgoogle/protobuf/empty.proto\"\x87\x02\n" +
+	"\aVehicle\x12\x1f\n" +
+	"\vinternal_id\x18\x01 \x01(\x05R\n" +
+	"internalId\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x02 \x01(\tR\tvehicleId\x12!\n" +
+	"\fcurrent_load\x18\x03 \x01(\x05R\vcurrentLoad\x12\x19\n" +
+	"\bmax_load\x18\x04 \x01(\x05R\amaxLoad\x12\x1d\n" +
+	"\n" +
+	"led_status\x18\x05 \x01(\tR\tledStatus\x12-\n" +
+	"\x12needs_confirmation\x18\x06 \x01(\bR\x11needsConfirmation\x12\x17\n" +
+	"\acoord_x\x18\a \x01(\x05R\x06coordX\x12\x17\n" +
+	"\acoord_y\x18\b \x01(\x05R\x06coordY\"P\n" +
+	"\x14CreateVehicleRequest\x12\x1d\n" +
+	"\n" +
+	"vehicle_id\x18\x01 \x01(\tR\tvehicleId\x12\x19\n" +
+	"\bmax_load\x18\x02 \x01(\x05R\amaxLoad\"4\n" +
+	"\x11GetVehicleRequest\x12\x1f\n" +
+	"\vinternal_id\x18\x01 \x01(\x05R\n" +
+	"internalId\"\xb7\x01\n" +
+	"\x14UpdateVehicleRequest\x12\x1f\n" +
+	"\vinternal_id\x18\x01 \x01(\x05R\n" +
+	"internalId\x12\x19\n" +
+	"\bmax_load\x18\x02 \x01(\x05R\amaxLoad\x12\"\n" +
+	"\n" +
+	"led_status\x18\x03 \x01(\tH\x00R\tledStatus\x88\x01\x01\x12\x17\n" +
+	"\acoord_x\x18\x04 \x01(\x05R\x06coordX\x12\x17\n" +
+	"\acoord_y\x18\x05 \x01(\x05R\x06coordYB\r\n" +
+	"\v_led_status\"7\n" +
+	"\x14DeleteVehicleRequest\x12\x1f\n" +
+	"\vinternal_id\x18\x01 \x01(\x05R\n" +
+	"internalId\")\n" +
+	"\x13ListVehiclesRequest\x12\x12\n" +
+	"\x04sort\x18\x01 \x01(\tR\x04sort\"\xcc\x03\n" +
+	"\x15SearchVehiclesRequest\x12$\n" +
+	"\vinternal_id\x18\x01 \x01(\tH\x00R\n" +
+	"internalId\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"vehicle_id\x18\x02 \x01(\tH\x01R\tvehicleId\x88\x01\x01\x12&\n" +
+	"\fcurrent_load\x18\x03 \x01(\tH\x02R\vcurrentLoad\x88\x01\x01\x12\x1e\n" +
+	"\bmax_load\x18\x04 \x01(\tH\x03R\amaxLoad\x88\x01\x01\x12\"\n" +
+	"\n" +
+	"led_status\x18\x05 \x01(\tH\x04R\tledStatus\x88\x01\x01\x122\n" +
+	"\x12needs_confirmation\x18\x06 \x01(\tH\x05R\x11needsConfirmation\x88\x01\x01\x12\x1c\n" +
+	"\acoord_x\x18\a \x01(\tH\x06R\x06coordX\x88\x01\x01\x12\x1c\n" +
+	"\acoord_y\x18\b \x01(\tH\aR\x06coordY\x88\x01\x01\x12\x12\n" +
+	"\x04sort\x18\x0f \x01(\tR\x04sortB\x0e\n" +
+	"\f_internal_idB\r\n" +
+	"\v_vehicle_idB\x0f\n" +
+	"\r_current_loadB\v\n" +
+	"\t_max_loadB\r\n" +
+	"\v_led_statusB\x15\n" +
+	"\x13_needs_confirmationB\n" +
+	"\n" +
+	"\b_coord_xB\n" +
+	"\n" +
+	"\b_coord_y\"C\n" +
+	"\x14ListVehiclesResponse\x12+\n" +
+	"\bvehicles\x18\x01 \x03(\v2\x0f.api.v1.VehicleR\bvehicles\"6\n" +
+	"\x13WatchVehicleRequest\x12\x1f\n" +
+	"\vinternal_id\x18\x01 \x01(\x05R\n" +
+	"internalId\"\x89\x01\n" +
+	"\fVehicleEvent\x12\x12\n" +
+	"\x04type\x18\x01 \x01(\tR\x04type\x12\x19\n" +
+	"\bevent_id\x18\x02 \x01(\tR\aeventId\x12\x1f\n" +
+	"\voccurred_at\x18\x03 \x01(\tR\n" +
+	"occurredAt\x12)\n" +
+	"\avehicle\x18\x04 \x01(\v2\x0f.api.v1.VehicleR\avehicle2\xf0\x03\n" +
+	"\x0eVehicleService\x12>\n" +
+	"\rCreateVehicle\x12\x1c.api.v1.CreateVehicleRequest\x1a\x0f.api.v1.Vehicle\x128\n" +
+	"\n" +
+	"GetVehicle\x12\x19.api.v1.GetVehicleRequest\x1a\x0f.api.v1.Vehicle\x12>\n" +
+	"\rUpdateVehicle\x12\x1c.api.v1.UpdateVehicleRequest\x1a\x0f.api.v1.Vehicle\x12E\n" +
+	"\rDeleteVehicle\x12\x1c.api.v1.DeleteVehicleRequest\x1a\x16.google.protobuf.Empty\x12I\n" +
+	"\fListVehicles\x12\x1b.api.v1.ListVehiclesRequest\x1a\x1c.api.v1.ListVehiclesResponse\x12M\n" +
+	"\x0eSearchVehicles\x12\x1d.api.v1.SearchVehiclesRequest\x1a\x1c.api.v1.ListVehiclesResponse\x12C\n" +
+	"\fWatchVehicle\x12\x1b.api.v1.WatchVehicleRequest\x1a\x14.api.v1.VehicleEvent0\x01B7Z5github.com/baboyiban/go-api-server/proto/api/v1;apiv1b\x06proto3"
+
+var (
+	file_api_v1_vehicle_proto_rawDescOnce sync.Once
+	file_api_v1_vehicle_proto_rawDescData []byte
+)
+
+func file_api_v1_vehicle_proto_rawDescGZIP() []byte {
+	file_api_v1_vehicle_proto_rawDescOnce.Do(func() {
+		file_api_v1_vehicle_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_api_v1_vehicle_proto_rawDesc), len(file_api_v1_vehicle_proto_rawDesc)))
+	})
+	return file_api_v1_vehicle_proto_rawDescData
+}
+
+var file_api_v1_vehicle_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
+var file_api_v1_vehicle_proto_goTypes = []any{
+	(*Vehicle)(nil),               // 0: api.v1.Vehicle
+	(*CreateVehicleRequest)(nil),  // 1: api.v1.CreateVehicleRequest
+	(*GetVehicleRequest)(nil),     // 2: api.v1.GetVehicleRequest
+	(*UpdateVehicleRequest)(nil),  // 3: api.v1.UpdateVehicleRequest
+	(*DeleteVehicleRequest)(nil),  // 4: api.v1.DeleteVehicleRequest
+	(*ListVehiclesRequest)(nil),   // 5: api.v1.ListVehiclesRequest
+	(*SearchVehiclesRequest)(nil), // 6: api.v1.SearchVehiclesRequest
+	(*ListVehiclesResponse)(nil),  // 7: api.v1.ListVehiclesResponse
+	(*WatchVehicleRequest)(nil),   // 8: api.v1.WatchVehicleRequest
+	(*VehicleEvent)(nil),          // 9: api.v1.VehicleEvent
+	(*emptypb.Empty)(nil),         // 10: google.protobuf.Empty
+}
+var file_api_v1_vehicle_proto_depIdxs = []int32{
+	0,  // 0: api.v1.ListVehiclesResponse.vehicles:type_name -> api.v1.Vehicle
+	0,  // 1: api.v1.VehicleEvent.vehicle:type_name -> api.v1.Vehicle
+	1,  // 2: api.v1.VehicleService.CreateVehicle:input_type -> api.v1.CreateVehicleRequest
+	2,  // 3: api.v1.VehicleService.GetVehicle:input_type -> api.v1.GetVehicleRequest
+	3,  // 4: api.v1.VehicleService.UpdateVehicle:input_type -> api.v1.UpdateVehicleRequest
+	4,  // 5: api.v1.VehicleService.DeleteVehicle:input_type -> api.v1.DeleteVehicleRequest
+	5,  // 6: api.v1.VehicleService.ListVehicles:input_type -> api.v1.ListVehiclesRequest
+	6,  // 7: api.v1.VehicleService.SearchVehicles:input_type -> api.v1.SearchVehiclesRequest
+	8,  // 8: api.v1.VehicleService.WatchVehicle:input_type -> api.v1.WatchVehicleRequest
+	0,  // 9: api.v1.VehicleService.CreateVehicle:output_type -> api.v1.Vehicle
+	0,  // 10: api.v1.VehicleService.GetVehicle:output_type -> api.v1.Vehicle
+	0,  // 11: api.v1.VehicleService.UpdateVehicle:output_type -> api.v1.Vehicle
+	10, // 12: api.v1.VehicleService.DeleteVehicle:output_type -> google.protobuf.Empty
+	7,  // 13: api.v1.VehicleService.ListVehicles:output_type -> api.v1.ListVehiclesResponse
+	7,  // 14: api.v1.VehicleService.SearchVehicles:output_type -> api.v1.ListVehiclesResponse
+	9,  // 15: api.v1.VehicleService.WatchVehicle:output_type -> api.v1.VehicleEvent
+	9,  // [9:16] is the sub-list for method output_type
+	2,  // [2:9] is the sub-list for method input_type
+	2,  // [2:2] is the sub-list for extension type_name
+	2,  // [2:2] is the sub-list for extension extendee
+	0,  // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_api_v1_vehicle_proto_init() }
+func file_api_v1_vehicle_proto_init() {
+	if File_api_v1_vehicle_proto != nil {
+		return
+	}
+	file_api_v1_vehicle_proto_msgTypes[3].OneofWrappers = []any{}
+	file_api_v1_vehicle_proto_msgTypes[6].OneofWrappers = []any{}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_api_v1_vehicle_proto_rawDesc), len(file_api_v1_vehicle_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   10,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_api_v1_vehicle_proto_goTypes,
+		DependencyIndexes: file_api_v1_vehicle_proto_depIdxs,
+		MessageInfos:      file_api_v1_vehicle_proto_msgTypes,
+	}.Build()
+	File_api_v1_vehicle_proto = out.File
+	file_api_v1_vehicle_proto_goTypes = nil
+	file_api_v1_vehicle_proto_depIdxs = nil
+}
@@ -0,0 +1,79 @@
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"sync"
+	"time"
+)
+
+// MemoryStore 프로세스 내 토큰 버킷 저장소
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]*bucket
+	now     func() time.Time
+	idleTTL time.Duration
+}
+
+type bucket struct {
+	tokens float64
+	last   time.Time
+}
+
+// NewMemoryStore idleTTL 동안 사용되지 않은 버킷을 주기적으로 정리하는 저장소 생성
+func NewMemoryStore(ctx context.Context, idleTTL time.Duration) *MemoryStore {
+	s := &MemoryStore{
+		buckets: map[string]*bucket{},
+		now:     time.Now,
+		idleTTL: idleTTL,
+	}
+	go s.janitor(ctx)
+	return s
+}
+
+func (s *MemoryStore) Allow(_ context.Context, key string, rate Rate) (bool, time.Duration, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	now := s.now()
+	b, ok := s.buckets[key]
+	if !ok {
+		b = &bucket{tokens: float64(rate.Burst), last: now}
+		s.buckets[key] = b
+	}
+
+	elapsed := now.Sub(b.last).Seconds()
+	b.tokens = math.Min(float64(rate.Burst), b.tokens+elapsed*rate.PerSecond)
+	b.last = now
+
+	if b.tokens >= 1 {
+		b.tokens--
+		return true, 0, nil
+	}
+	wait := time.Duration((1 - b.tokens) / rate.PerSecond * float64(time.Second))
+	return false, wait, nil
+}
+
+func (s *MemoryStore) janitor(ctx context.Context) {
+	ticker := time.NewTicker(s.idleTTL)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.evictIdle()
+		}
+	}
+}
+
+func (s *MemoryStore) evictIdle() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	cutoff := s.now().Add(-s.idleTTL)
+	for k, b := range s.buckets {
+		if b.last.Before(cutoff) {
+			delete(s.buckets, k)
+		}
+	}
+}
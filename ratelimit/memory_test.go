@@ -0,0 +1,88 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMemoryStore_Allow(t *testing.T) {
+	rate := Rate{PerSecond: 1, Burst: 2}
+	tests := []struct {
+		name      string
+		key       string
+		at        time.Duration // 시작 시각으로부터의 경과 시간
+		allowed   bool
+		wantRetry time.Duration
+	}{
+		{"burst 1", "a", 0, true, 0},
+		{"burst 2", "a", 0, true, 0},
+		{"bucket empty", "a", 0, false, time.Second},
+		{"partially refilled", "a", 500 * time.Millisecond, false, 500 * time.Millisecond},
+		{"refilled", "a", time.Second, true, 0},
+		{"other key has own bucket", "b", time.Second, true, 0},
+		// 오래 쉬어도 버스트 이상 쌓이지 않음
+		{"capped at burst 1", "a", time.Hour, true, 0},
+		{"capped at burst 2", "a", time.Hour, true, 0},
+		{"capped at burst 3", "a", time.Hour, false, time.Second},
+	}
+
+	start := time.Now()
+	s := newTestStore(start)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			now := start.Add(tt.at)
+			s.now = func() time.Time { return now }
+			allowed, retry, err := s.Allow(context.Background(), tt.key, rate)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if allowed != tt.allowed || retry != tt.wantRetry {
+				t.Errorf("Allow = %v, %s; want %v, %s", allowed, retry, tt.allowed, tt.wantRetry)
+			}
+		})
+	}
+}
+
+func TestMemoryStore_EvictIdle(t *testing.T) {
+	start := time.Now()
+	s := newTestStore(start)
+	rate := PerMinute(60, 1)
+	_, _, _ = s.Allow(context.Background(), "idle", rate)
+	s.now = func() time.Time { return start.Add(30 * time.Second) }
+	_, _, _ = s.Allow(context.Background(), "recent", rate)
+
+	s.now = func() time.Time { return start.Add(time.Minute + time.Second) }
+	s.evictIdle()
+	if _, ok := s.buckets["idle"]; ok {
+		t.Error("idle bucket not evicted")
+	}
+	if _, ok := s.buckets["recent"]; !ok {
+		t.Error("recent bucket evicted")
+	}
+}
+
+func TestRate_Enabled(t *testing.T) {
+	tests := []struct {
+		rate Rate
+		want bool
+	}{
+		{PerMinute(60, 10), true},
+		{PerMinute(0, 10), false},
+		{PerMinute(60, 0), false},
+	}
+	for _, tt := range tests {
+		if got := tt.rate.Enabled(); got != tt.want {
+			t.Errorf("%+v.Enabled() = %v, want %v", tt.rate, got, tt.want)
+		}
+	}
+}
+
+// newTestStore 정리 고루틴 없이 시각을 고정한 저장소. idleTTL 은 1분
+func newTestStore(now time.Time) *MemoryStore {
+	return &MemoryStore{
+		buckets: map[string]*bucket{},
+		now:     func() time.Time { return now },
+		idleTTL: time.Minute,
+	}
+}
@@ -0,0 +1,31 @@
+// Package ratelimit 는 키 단위 토큰 버킷 기반의 요청 제한을 제공합니다.
+// 기본 구현은 프로세스 내 메모리 저장소이며, 여러 인스턴스가 한도를 공유해야 하면
+// Store 인터페이스를 구현한 외부 저장소(Redis 등)로 교체할 수 있습니다.
+package ratelimit
+
+import (
+	"context"
+	"time"
+)
+
+// Rate 초당 충전되는 토큰 수와 버킷 크기
+type Rate struct {
+	PerSecond float64
+	Burst     int
+}
+
+// Enabled 한도가 설정되어 있는지 여부
+func (r Rate) Enabled() bool {
+	return r.PerSecond > 0 && r.Burst > 0
+}
+
+// PerMinute 분당 n 회, 버스트 burst 인 Rate
+func PerMinute(n int, burst int) Rate {
+	return Rate{PerSecond: float64(n) / 60, Burst: burst}
+}
+
+// Store 토큰 버킷 상태 저장소
+type Store interface {
+	// Allow key 의 버킷에서 토큰 하나를 소비. 거부되면 다음 토큰까지 기다릴 시간을 반환
+	Allow(ctx context.Context, key string, rate Rate) (allowed bool, retryAfter time.Duration, err error)
+}
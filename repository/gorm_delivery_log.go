@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+)
+
+type gormDeliveryLogs struct {
+	db *gorm.DB
+}
+
+func (r gormDeliveryLogs) Create(ctx context.Context, log *models.DeliveryLog) error {
+	return r.db.WithContext(ctx).Create(log).Error
+}
+
+func (r gormDeliveryLogs) GetByTrip(ctx context.Context, tripID int) (*models.DeliveryLog, error) {
+	return first[models.DeliveryLog](r.db.WithContext(ctx).Where("trip_id = ?", tripID).Order("load_order"))
+}
+
+// Save 기본 키가 없는 테이블이므로 운행 ID 와 패키지 ID 로 대상 행을 지정해 모든 컬럼을 갱신
+func (r gormDeliveryLogs) Save(ctx context.Context, log *models.DeliveryLog) error {
+	result := r.db.WithContext(ctx).Model(&models.DeliveryLog{}).
+		Where("trip_id = ? AND package_id = ?", log.TripID, log.PackageID).
+		Select("*").Omit("Package", "Region").
+		Updates(log)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func (r gormDeliveryLogs) DeleteByTrip(ctx context.Context, tripID int) error {
+	return deleteWhere(r.db.WithContext(ctx).Where("trip_id = ?", tripID), &models.DeliveryLog{})
+}
+
+func (r gormDeliveryLogs) ListByPackages(ctx context.Context, packageIDs []int) ([]models.DeliveryLog, error) {
+	return find[models.DeliveryLog](r.db.WithContext(ctx).Where("package_id IN ?", packageIDs).Order("trip_id, load_order"))
+}
+
+func (r gormDeliveryLogs) ListByTrips(ctx context.Context, tripIDs []int) ([]models.DeliveryLog, error) {
+	return find[models.DeliveryLog](r.db.WithContext(ctx).Where("trip_id IN ?", tripIDs).Order("trip_id, load_order"))
+}
+
+func (r gormDeliveryLogs) Find(ctx context.Context, q Query) ([]models.DeliveryLog, error) {
+	return search[models.DeliveryLog](r.db.WithContext(ctx).Model(&models.DeliveryLog{}), q, deliveryLogSortFields, deliveryLogDateFields, nil)
+}
+
+func (r gormDeliveryLogs) Count(ctx context.Context, filters map[string]string) (int64, error) {
+	return count(r.db.WithContext(ctx).Model(&models.DeliveryLog{}), filters, deliveryLogDateFields, nil)
+}
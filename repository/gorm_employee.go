@@ -0,0 +1,102 @@
+package repository
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+)
+
+type gormEmployees struct {
+	db *gorm.DB
+}
+
+func (r gormEmployees) Create(ctx context.Context, emp *models.Employee) error {
+	return r.db.WithContext(ctx).Create(emp).Error
+}
+
+func (r gormEmployees) Get(ctx context.Context, employeeID int) (*models.Employee, error) {
+	return first[models.Employee](r.db.WithContext(ctx).Where("employee_id = ?", employeeID))
+}
+
+func (r gormEmployees) GetByUsername(ctx context.Context, username string) (*models.Employee, error) {
+	return first[models.Employee](r.db.WithContext(ctx).Where("username = ?", username))
+}
+
+func (r gormEmployees) GetMany(ctx context.Context, employeeIDs []int) ([]models.Employee, error) {
+	return find[models.Employee](r.db.WithContext(ctx).Where("employee_id IN ?", employeeIDs))
+}
+
+func (r gormEmployees) Lock(ctx context.Context, employeeID int) (*models.Employee, error) {
+	return first[models.Employee](forUpdate(r.db.WithContext(ctx)).Where("employee_id = ?", employeeID))
+}
+
+func (r gormEmployees) Save(ctx context.Context, emp *models.Employee) error {
+	return r.db.WithContext(ctx).Save(emp).Error
+}
+
+func (r gormEmployees) Update(ctx context.Context, employeeID int, columns map[string]any) error {
+	return r.db.WithContext(ctx).Model(&models.Employee{}).Where("employee_id = ?", employeeID).Updates(columns).Error
+}
+
+func (r gormEmployees) IncrementFailedLogins(ctx context.Context, employeeID int) (int, error) {
+	var failures int
+	err := r.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+		if err := tx.Model(&models.Employee{}).
+			Where("employee_id = ?", employeeID).
+			Update("failed_login_count", gorm.Expr("failed_login_count + 1")).Error; err != nil {
+			return err
+		}
+		return tx.Model(&models.Employee{}).
+			Where("employee_id = ?", employeeID).
+			Pluck("failed_login_count", &failures).Error
+	})
+	return failures, err
+}
+
+func (r gormEmployees) Delete(ctx context.Context, employeeID int) error {
+	return deleteWhere(r.db.WithContext(ctx).Where("employee_id = ?", employeeID), &models.Employee{})
+}
+
+func (r gormEmployees) Find(ctx context.Context, q Query) ([]models.Employee, error) {
+	query := r.db.WithContext(ctx).Model(&models.Employee{})
+	filters := make(map[string]string, len(q.Filters))
+	for k, v := range q.Filters {
+		if k == "name" {
+			query = query.Where("name LIKE ? ESCAPE '!'", "%"+escapeLike(v)+"%")
+		} else {
+			filters[k] = v
+		}
+	}
+	q.Filters = filters
+	return search[models.Employee](query, q, employeeSortFields, nil, employeeBoolFields)
+}
+
+func (r gormEmployees) CreateResetToken(ctx context.Context, token *models.PasswordResetToken) error {
+	return r.db.WithContext(ctx).Create(token).Error
+}
+
+func (r gormEmployees) GetResetToken(ctx context.Context, tokenHash string) (*models.PasswordResetToken, error) {
+	return first[models.PasswordResetToken](r.db.WithContext(ctx).Where("token_hash = ?", tokenHash))
+}
+
+func (r gormEmployees) ExpireResetTokens(ctx context.Context, employeeID int, at time.Time) error {
+	return r.db.WithContext(ctx).Model(&models.PasswordResetToken{}).
+		Where("employee_id = ? AND used_at IS NULL", employeeID).
+		Update("expires_at", at).Error
+}
+
+// UseResetToken 조건부 UPDATE 로 같은 토큰의 동시 사용을 막음
+func (r gormEmployees) UseResetToken(ctx context.Context, tokenID int, at time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.PasswordResetToken{}).
+		Where("token_id = ? AND used_at IS NULL AND expires_at > ?", tokenID, at).
+		Update("used_at", at)
+	return result.RowsAffected > 0, result.Error
+}
+
+// escapeLike LIKE 패턴의 와일드카드 문자를 ESCAPE '!' 기준으로 이스케이프
+func escapeLike(s string) string {
+	return strings.NewReplacer("!", "!!", "%", "!%", "_", "!_").Replace(s)
+}
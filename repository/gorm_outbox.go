@@ -0,0 +1,40 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type gormOutbox struct {
+	db *gorm.DB
+}
+
+func (r gormOutbox) Pending(ctx context.Context, limit int) ([]models.OutboxEvent, error) {
+	return find[models.OutboxEvent](r.db.WithContext(ctx).
+		Clauses(clause.Locking{Strength: "UPDATE", Options: "SKIP LOCKED"}).
+		Where("processed_at IS NULL").
+		Order("outbox_id").Limit(limit))
+}
+
+func (r gormOutbox) OldestPending(ctx context.Context, aggregateType, aggregateID string) (int64, error) {
+	var oldest sql.NullInt64
+	err := r.db.WithContext(ctx).Model(&models.OutboxEvent{}).
+		Where("aggregate_type = ? AND aggregate_id = ? AND processed_at IS NULL", aggregateType, aggregateID).
+		Select("MIN(outbox_id)").Scan(&oldest).Error
+	return oldest.Int64, err
+}
+
+func (r gormOutbox) Update(ctx context.Context, outboxID int64, columns map[string]any) error {
+	return r.db.WithContext(ctx).Model(&models.OutboxEvent{}).Where("outbox_id = ?", outboxID).Updates(columns).Error
+}
+
+func (r gormOutbox) DeleteProcessed(ctx context.Context, before time.Time) error {
+	return r.db.WithContext(ctx).
+		Where("processed_at IS NOT NULL AND processed_at < ?", before).
+		Delete(&models.OutboxEvent{}).Error
+}
@@ -0,0 +1,48 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+)
+
+type gormPackages struct {
+	db *gorm.DB
+}
+
+func (r gormPackages) Create(ctx context.Context, pkg *models.Package) error {
+	return r.db.WithContext(ctx).Create(pkg).Error
+}
+
+func (r gormPackages) Get(ctx context.Context, packageID int) (*models.Package, error) {
+	return first[models.Package](r.db.WithContext(ctx).Where("package_id = ?", packageID))
+}
+
+func (r gormPackages) GetMany(ctx context.Context, packageIDs []int) ([]models.Package, error) {
+	return find[models.Package](r.db.WithContext(ctx).Where("package_id IN ?", packageIDs))
+}
+
+func (r gormPackages) ListByRegions(ctx context.Context, regionIDs []string) ([]models.Package, error) {
+	return find[models.Package](r.db.WithContext(ctx).Where("region_id IN ?", regionIDs).Order("package_id"))
+}
+
+func (r gormPackages) Lock(ctx context.Context, packageID int) (*models.Package, error) {
+	return first[models.Package](forUpdate(r.db.WithContext(ctx)).Where("package_id = ?", packageID))
+}
+
+func (r gormPackages) Save(ctx context.Context, pkg *models.Package) error {
+	return r.db.WithContext(ctx).Save(pkg).Error
+}
+
+func (r gormPackages) Delete(ctx context.Context, packageID int) error {
+	return deleteWhere(r.db.WithContext(ctx).Where("package_id = ?", packageID), &models.Package{})
+}
+
+func (r gormPackages) Find(ctx context.Context, q Query) ([]models.Package, error) {
+	return search[models.Package](r.db.WithContext(ctx).Model(&models.Package{}), q, packageSortFields, packageDateFields, nil)
+}
+
+func (r gormPackages) Count(ctx context.Context, filters map[string]string) (int64, error) {
+	return count(r.db.WithContext(ctx).Model(&models.Package{}), filters, packageDateFields, nil)
+}
@@ -0,0 +1,44 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+)
+
+type gormRegions struct {
+	db *gorm.DB
+}
+
+func (r gormRegions) Create(ctx context.Context, region *models.Region) error {
+	return r.db.WithContext(ctx).Create(region).Error
+}
+
+func (r gormRegions) Get(ctx context.Context, regionID string) (*models.Region, error) {
+	return first[models.Region](r.db.WithContext(ctx).Where("region_id = ?", regionID))
+}
+
+func (r gormRegions) GetMany(ctx context.Context, regionIDs []string) ([]models.Region, error) {
+	return find[models.Region](r.db.WithContext(ctx).Where("region_id IN ?", regionIDs))
+}
+
+func (r gormRegions) Lock(ctx context.Context, regionID string) (*models.Region, error) {
+	return first[models.Region](forUpdate(r.db.WithContext(ctx)).Where("region_id = ?", regionID))
+}
+
+func (r gormRegions) Save(ctx context.Context, region *models.Region) error {
+	return r.db.WithContext(ctx).Save(region).Error
+}
+
+func (r gormRegions) Delete(ctx context.Context, regionID string) error {
+	return deleteWhere(r.db.WithContext(ctx).Where("region_id = ?", regionID), &models.Region{})
+}
+
+func (r gormRegions) Find(ctx context.Context, q Query) ([]models.Region, error) {
+	return search[models.Region](r.db.WithContext(ctx).Model(&models.Region{}), q, regionSortFields, regionDateFields, regionBoolFields)
+}
+
+func (r gormRegions) Count(ctx context.Context, filters map[string]string) (int64, error) {
+	return count(r.db.WithContext(ctx).Model(&models.Region{}), filters, regionDateFields, regionBoolFields)
+}
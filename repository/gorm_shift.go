@@ -0,0 +1,58 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+)
+
+type gormShifts struct {
+	db *gorm.DB
+}
+
+func (r gormShifts) Create(ctx context.Context, shift *models.Shift) error {
+	return r.db.WithContext(ctx).Create(shift).Error
+}
+
+func (r gormShifts) Get(ctx context.Context, shiftID int) (*models.Shift, error) {
+	return first[models.Shift](r.db.WithContext(ctx).Where("shift_id = ?", shiftID))
+}
+
+func (r gormShifts) List(ctx context.Context, filters map[string]string, activeAt *time.Time) ([]models.Shift, error) {
+	query := applyFilters(r.db.WithContext(ctx).Model(&models.Shift{}), filters, nil, nil)
+	if activeAt != nil {
+		query = activeAtTime(query, *activeAt)
+	}
+	return find[models.Shift](query.Order("start_time DESC"))
+}
+
+func (r gormShifts) FindOverlap(ctx context.Context, vehicleID string, employeeID int, start time.Time, end *time.Time) (*models.Shift, error) {
+	query := r.db.WithContext(ctx).Where("end_time IS NULL OR end_time > ?", start)
+	if end != nil {
+		query = query.Where("start_time < ?", *end)
+	}
+	return first[models.Shift](query.Where("vehicle_id = ? OR employee_id = ?", vehicleID, employeeID))
+}
+
+func (r gormShifts) ActiveForEmployee(ctx context.Context, employeeID int, at time.Time) (*models.Shift, error) {
+	return first[models.Shift](activeAtTime(r.db.WithContext(ctx).Where("employee_id = ?", employeeID), at))
+}
+
+func (r gormShifts) ActiveForVehicle(ctx context.Context, vehicleID string, at time.Time) (*models.Shift, error) {
+	return first[models.Shift](activeAtTime(r.db.WithContext(ctx).Where("vehicle_id = ?", vehicleID), at))
+}
+
+func (r gormShifts) Save(ctx context.Context, shift *models.Shift) error {
+	return r.db.WithContext(ctx).Save(shift).Error
+}
+
+func (r gormShifts) Delete(ctx context.Context, shiftID int) error {
+	return deleteWhere(r.db.WithContext(ctx).Where("shift_id = ?", shiftID), &models.Shift{})
+}
+
+// activeAtTime at 시각에 시작했고 아직 끝나지 않은 근무
+func activeAtTime(query *gorm.DB, at time.Time) *gorm.DB {
+	return query.Where("start_time <= ?", at).Where("end_time IS NULL OR end_time > ?", at)
+}
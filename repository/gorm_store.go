@@ -0,0 +1,203 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math/rand/v2"
+	"strconv"
+	"time"
+
+	"github.com/baboyiban/go-api-server/database"
+	"github.com/baboyiban/go-api-server/metrics"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// 교착 상태 에러 코드
+const (
+	mysqlErrDeadlock       = 1213
+	pgDeadlockDetected     = "40P01"
+	pgSerializationFailure = "40001"
+)
+
+type gormStore struct {
+	db              *gorm.DB
+	deadlockRetries int
+	inTx            bool
+	primary         *gormStore // Replica 로 만든 Store 의 트랜잭션은 주 DB 에서 시작
+}
+
+// NewGormStore GORM 으로 DB 에 접근하는 Store. 교착 상태로 롤백된 트랜잭션은 deadlockRetries 번까지 다시 실행
+func NewGormStore(db *gorm.DB, deadlockRetries int) Store {
+	return &gormStore{db: db, deadlockRetries: deadlockRetries}
+}
+
+func (s *gormStore) Regions() RegionRepository           { return gormRegions{s.db} }
+func (s *gormStore) Packages() PackageRepository         { return gormPackages{s.db} }
+func (s *gormStore) Vehicles() VehicleRepository         { return gormVehicles{s.db} }
+func (s *gormStore) TripLogs() TripLogRepository         { return gormTripLogs{s.db} }
+func (s *gormStore) DeliveryLogs() DeliveryLogRepository { return gormDeliveryLogs{s.db} }
+func (s *gormStore) Employees() EmployeeRepository       { return gormEmployees{s.db} }
+func (s *gormStore) Shifts() ShiftRepository             { return gormShifts{s.db} }
+func (s *gormStore) Webhooks() WebhookRepository         { return gormWebhooks{s.db} }
+func (s *gormStore) Events() EventRecorder               { return gormEvents{s.db} }
+func (s *gormStore) Outbox() outbox.Repository           { return gormOutbox{s.db} }
+
+func (s *gormStore) Replica() Store {
+	if s.inTx || s.primary != nil {
+		return s
+	}
+	return &gormStore{db: database.UseReplica(s.db), deadlockRetries: s.deadlockRetries, primary: s}
+}
+
+func (s *gormStore) Transaction(ctx context.Context, fn func(tx Store) error) error {
+	if s.primary != nil {
+		return s.primary.Transaction(ctx, fn)
+	}
+	run := func() error {
+		return s.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			return fn(&gormStore{db: tx, inTx: true})
+		})
+	}
+	if s.inTx {
+		// 중첩 트랜잭션(세이브포인트)은 교착 상태가 나면 바깥 트랜잭션 전체가 롤백되므로 바깥에서 재시도
+		return run()
+	}
+	err := run()
+	for attempt := 1; attempt <= s.deadlockRetries && isDeadlock(err); attempt++ {
+		metrics.ObserveTxRetry()
+		slog.WarnContext(ctx, "교착 상태로 트랜잭션 재시도", "attempt", attempt, "error", err)
+		select {
+		case <-ctx.Done():
+			return err
+		case <-time.After(deadlockBackoff(attempt)):
+		}
+		err = run()
+	}
+	return err
+}
+
+// deadlockBackoff 같은 트랜잭션끼리 다시 부딪히지 않도록 시도마다 늘어나는 대기 시간에 무작위 값을 더함
+func deadlockBackoff(attempt int) time.Duration {
+	base := time.Duration(attempt) * 20 * time.Millisecond
+	return base + rand.N(base)
+}
+
+// isDeadlock DB 가 교착 상태나 직렬화 실패로 트랜잭션을 롤백했는지 여부
+func isDeadlock(err error) bool {
+	if err == nil {
+		return false
+	}
+	var myErr *mysql.MySQLError
+	if errors.As(err, &myErr) {
+		return myErr.Number == mysqlErrDeadlock
+	}
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) {
+		return pgErr.Code == pgDeadlockDetected || pgErr.Code == pgSerializationFailure
+	}
+	return false
+}
+
+type gormEvents struct {
+	db *gorm.DB
+}
+
+func (e gormEvents) Record(ctx context.Context, eventType, aggregateType, aggregateID string, data any) error {
+	row, err := outbox.NewRow(eventType, aggregateType, aggregateID, data)
+	if err != nil {
+		return err
+	}
+	return e.db.WithContext(ctx).Create(row).Error
+}
+
+// first 조건에 맞는 첫 행. 없으면 ErrNotFound
+func first[T any](query *gorm.DB) (*T, error) {
+	var v T
+	if err := query.First(&v).Error; err != nil {
+		return nil, err
+	}
+	return &v, nil
+}
+
+func find[T any](query *gorm.DB) ([]T, error) {
+	var rows []T
+	if err := query.Find(&rows).Error; err != nil {
+		return nil, err
+	}
+	return rows, nil
+}
+
+// forUpdate SELECT ... FOR UPDATE
+func forUpdate(query *gorm.DB) *gorm.DB {
+	return query.Clauses(clause.Locking{Strength: "UPDATE"})
+}
+
+// deleteWhere 삭제된 행이 없으면 ErrNotFound, 다른 행이 참조하고 있으면 ErrInUse
+func deleteWhere(query *gorm.DB, model any) error {
+	result := query.Delete(model)
+	if errors.Is(result.Error, gorm.ErrForeignKeyViolated) {
+		// TranslateError 로 드라이버 에러가 번역되면 참조 무결성 위반은 방향과 관계없이 같은 에러가 되므로 삭제에서는 ErrInUse 로 구분
+		return ErrInUse
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
+
+// search Query 의 필터, 정렬, 페이지를 적용해 조회
+func search[T any](query *gorm.DB, q Query, sortFields, dateFields, boolFields map[string]bool) ([]T, error) {
+	query = applyFilters(query, q.Filters, dateFields, boolFields)
+	if field, desc, ok := parseSort(q.Sort, sortFields); ok {
+		query = query.Order(clause.OrderByColumn{Column: clause.Column{Name: field}, Desc: desc})
+	}
+	if q.Page.Limit > 0 {
+		query = query.Limit(q.Page.Limit)
+	}
+	if q.Page.Offset > 0 {
+		query = query.Offset(q.Page.Offset)
+	}
+	return find[T](query)
+}
+
+func count(query *gorm.DB, filters map[string]string, dateFields, boolFields map[string]bool) (int64, error) {
+	var total int64
+	err := applyFilters(query, filters, dateFields, boolFields).Count(&total).Error
+	return total, err
+}
+
+func applyFilters(query *gorm.DB, filters map[string]string, dateFields, boolFields map[string]bool) *gorm.DB {
+	for k, v := range filters {
+		switch b, err := strconv.ParseBool(v); {
+		case dateFields[k]:
+			query = query.Where(dateOf(query, k)+" = ?", v)
+		case boolFields[k] && err == nil:
+			// 불리언 컬럼은 드라이버마다 표현이 달라(MySQL tinyint, PostgreSQL boolean) 문자열 대신 bool 로 바인딩
+			query = query.Where(k+" = ?", b)
+		default:
+			query = query.Where(k+" = ?", v)
+		}
+	}
+	return query
+}
+
+// dateOf 시각 컬럼의 날짜(YYYY-MM-DD) 부분을 구하는 SQL 식
+func dateOf(query *gorm.DB, column string) string {
+	switch query.Dialector.Name() {
+	case "postgres":
+		return "CAST(" + column + " AS DATE)"
+	case "sqlite":
+		// SQLite 의 DATE() 는 오프셋이 붙은 시각을 UTC 로 바꾸므로 저장된 현지 날짜를 그대로 자름
+		return "SUBSTR(" + column + ", 1, 10)"
+	default:
+		return "DATE(" + column + ")"
+	}
+}
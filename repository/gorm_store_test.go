@@ -0,0 +1,211 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/database"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/go-sql-driver/mysql"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// newSQLiteStore 임시 파일 SQLite 에 모든 테이블을 만든 GORM 저장소
+func newSQLiteStore(t *testing.T) Store {
+	t.Helper()
+	db, err := database.InitDB(context.Background(), config.DBConfig{
+		Driver:       config.DriverSQLite,
+		Name:         filepath.Join(t.TempDir(), "test.db"),
+		MaxOpenConns: 1,
+		AutoMigrate:  true,
+	}, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { _ = database.Close(db) })
+	return NewGormStore(db, 0)
+}
+
+// stores 같은 테스트를 GORM 과 메모리 저장소에 모두 실행
+func stores(t *testing.T) map[string]Store {
+	return map[string]Store{"gorm": newSQLiteStore(t), "memory": NewMemoryStore()}
+}
+
+func TestFind_Filters(t *testing.T) {
+	ctx := context.Background()
+	for name, store := range stores(t) {
+		t.Run(name, func(t *testing.T) {
+			for _, err := range []error{
+				store.Regions().Create(ctx, &models.Region{RegionID: "R01", RegionName: "true", MaxCapacity: 1, IsFull: true}),
+				store.Regions().Create(ctx, &models.Region{RegionID: "R02", RegionName: "false", MaxCapacity: 1}),
+				store.Vehicles().Create(ctx, &models.Vehicle{VehicleID: "A01", NeedsConfirmation: true}),
+				store.Vehicles().Create(ctx, &models.Vehicle{VehicleID: "A02"}),
+				store.Employees().Create(ctx, &models.Employee{Password: "x", Position: "관리직", Name: "Kim", IsActive: true}),
+				store.Employees().Create(ctx, &models.Employee{Password: "x", Position: "운송직", Name: "Lee"}),
+			} {
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			tests := []struct {
+				name    string
+				find    func(filters map[string]string) ([]string, error)
+				filters map[string]string
+				want    []string
+			}{
+				{"bool column true", findRegions(store), map[string]string{"is_full": "true"}, []string{"R01"}},
+				{"bool column 0", findRegions(store), map[string]string{"is_full": "0"}, []string{"R02"}},
+				// 불리언이 아닌 컬럼의 "true"/"false" 는 문자열로 비교
+				{"text column true", findRegions(store), map[string]string{"region_name": "true"}, []string{"R01"}},
+				{"text column false", findRegions(store), map[string]string{"region_name": "false"}, []string{"R02"}},
+				{"vehicle bool", findVehicles(store), map[string]string{"needs_confirmation": "false"}, []string{"A02"}},
+				{"employee bool", findEmployees(store), map[string]string{"is_active": "true", "position": "관리직"}, []string{"Kim"}},
+				{"employee bool no match", findEmployees(store), map[string]string{"is_active": "false", "position": "관리직"}, nil},
+			}
+			for _, tt := range tests {
+				t.Run(tt.name, func(t *testing.T) {
+					got, err := tt.find(tt.filters)
+					if err != nil {
+						t.Fatal(err)
+					}
+					if !slices.Equal(got, tt.want) {
+						t.Errorf("got %v, want %v", got, tt.want)
+					}
+				})
+			}
+		})
+	}
+}
+
+func findRegions(store Store) func(map[string]string) ([]string, error) {
+	return func(filters map[string]string) ([]string, error) {
+		rows, err := store.Regions().Find(context.Background(), Query{Filters: filters, Sort: "region_id"})
+		var ids []string
+		for _, r := range rows {
+			ids = append(ids, r.RegionID)
+		}
+		return ids, err
+	}
+}
+
+func findVehicles(store Store) func(map[string]string) ([]string, error) {
+	return func(filters map[string]string) ([]string, error) {
+		rows, err := store.Vehicles().Find(context.Background(), Query{Filters: filters, Sort: "vehicle_id"})
+		var ids []string
+		for _, v := range rows {
+			ids = append(ids, v.VehicleID)
+		}
+		return ids, err
+	}
+}
+
+func findEmployees(store Store) func(map[string]string) ([]string, error) {
+	return func(filters map[string]string) ([]string, error) {
+		rows, err := store.Employees().Find(context.Background(), Query{Filters: filters, Sort: "employee_id"})
+		var names []string
+		for _, e := range rows {
+			names = append(names, e.Name)
+		}
+		return names, err
+	}
+}
+
+func TestIsDeadlock(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{"nil", nil, false},
+		{"mysql deadlock", &mysql.MySQLError{Number: 1213}, true},
+		{"mysql lock wait timeout", &mysql.MySQLError{Number: 1205}, false},
+		{"postgres deadlock", &pgconn.PgError{Code: "40P01"}, true},
+		{"postgres serialization failure", &pgconn.PgError{Code: "40001"}, true},
+		{"postgres unique violation", &pgconn.PgError{Code: "23505"}, false},
+		{"wrapped", fmt.Errorf("update region: %w", &pgconn.PgError{Code: "40P01"}), true},
+		{"other", errors.New("deadlock"), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isDeadlock(tt.err); got != tt.want {
+				t.Errorf("isDeadlock(%v) = %v, want %v", tt.err, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTransaction_DeadlockRetry(t *testing.T) {
+	deadlock := &mysql.MySQLError{Number: 1213, Message: "Deadlock found when trying to get lock"}
+	other := errors.New("boom")
+	tests := []struct {
+		name      string
+		failures  int   // 처음 몇 번 실패할지
+		failWith  error // 실패할 때 돌려줄 에러
+		wantCalls int
+		wantErr   error
+	}{
+		{"success", 0, deadlock, 1, nil},
+		{"deadlock then success", 2, deadlock, 3, nil},
+		{"retries exhausted", 5, deadlock, 3, deadlock},
+		{"other errors are not retried", 5, other, 1, other},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newSQLiteStore(t).(*gormStore)
+			store.deadlockRetries = 2
+			ctx := context.Background()
+
+			calls := 0
+			err := store.Transaction(ctx, func(tx Store) error {
+				calls++
+				if err := tx.Regions().Create(ctx, &models.Region{RegionID: "R01", MaxCapacity: 1}); err != nil {
+					return err
+				}
+				if calls <= tt.failures {
+					return tt.failWith
+				}
+				return nil
+			})
+			if !errors.Is(err, tt.wantErr) || calls != tt.wantCalls {
+				t.Errorf("err = %v, calls = %d, want %v, %d", err, calls, tt.wantErr, tt.wantCalls)
+			}
+			// 실패한 시도는 롤백되어 성공한 시도의 행만 남음
+			var want int64
+			if tt.wantErr == nil {
+				want = 1
+			}
+			if total, err := store.Regions().Count(ctx, nil); err != nil || total != want {
+				t.Errorf("regions = %d, %v, want %d", total, err, want)
+			}
+		})
+	}
+}
+
+func TestTransaction_NestedDeadlockNotRetried(t *testing.T) {
+	store := newSQLiteStore(t).(*gormStore)
+	store.deadlockRetries = 2
+	ctx := context.Background()
+
+	// 안쪽 트랜잭션은 재시도하지 않고 바깥 트랜잭션이 전체를 다시 실행
+	outer, inner := 0, 0
+	err := store.Transaction(ctx, func(tx Store) error {
+		outer++
+		return tx.Transaction(ctx, func(Store) error {
+			inner++
+			if outer == 1 {
+				return &pgconn.PgError{Code: "40P01"}
+			}
+			return nil
+		})
+	})
+	if err != nil || outer != 2 || inner != 2 {
+		t.Errorf("err = %v, outer = %d, inner = %d, want nil, 2, 2", err, outer, inner)
+	}
+}
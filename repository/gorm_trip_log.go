@@ -0,0 +1,52 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+)
+
+type gormTripLogs struct {
+	db *gorm.DB
+}
+
+func (r gormTripLogs) Create(ctx context.Context, trip *models.TripLog) error {
+	return r.db.WithContext(ctx).Create(trip).Error
+}
+
+func (r gormTripLogs) Get(ctx context.Context, tripID int) (*models.TripLog, error) {
+	return first[models.TripLog](r.db.WithContext(ctx).Where("trip_id = ?", tripID))
+}
+
+func (r gormTripLogs) GetMany(ctx context.Context, tripIDs []int) ([]models.TripLog, error) {
+	return find[models.TripLog](r.db.WithContext(ctx).Where("trip_id IN ?", tripIDs))
+}
+
+func (r gormTripLogs) ListByVehicles(ctx context.Context, vehicleIDs []string) ([]models.TripLog, error) {
+	return find[models.TripLog](r.db.WithContext(ctx).Where("vehicle_id IN ?", vehicleIDs).Order("trip_id"))
+}
+
+func (r gormTripLogs) ListByDriver(ctx context.Context, driverID int) ([]models.TripLog, error) {
+	return find[models.TripLog](r.db.WithContext(ctx).Where("driver_id = ?", driverID).Order("trip_id DESC"))
+}
+
+func (r gormTripLogs) ListBByDriver(ctx context.Context, driverID int) ([]models.TripLogB, error) {
+	return find[models.TripLogB](r.db.WithContext(ctx).Where("driver_id = ?", driverID).Order("trip_id DESC"))
+}
+
+func (r gormTripLogs) Save(ctx context.Context, trip *models.TripLog) error {
+	return r.db.WithContext(ctx).Save(trip).Error
+}
+
+func (r gormTripLogs) Delete(ctx context.Context, tripID int) error {
+	return deleteWhere(r.db.WithContext(ctx).Where("trip_id = ?", tripID), &models.TripLog{})
+}
+
+func (r gormTripLogs) Find(ctx context.Context, q Query) ([]models.TripLog, error) {
+	return search[models.TripLog](r.db.WithContext(ctx).Model(&models.TripLog{}), q, tripLogSortFields, tripLogDateFields, nil)
+}
+
+func (r gormTripLogs) Count(ctx context.Context, filters map[string]string) (int64, error) {
+	return count(r.db.WithContext(ctx).Model(&models.TripLog{}), filters, tripLogDateFields, nil)
+}
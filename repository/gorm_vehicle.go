@@ -0,0 +1,79 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+)
+
+type gormVehicles struct {
+	db *gorm.DB
+}
+
+func (r gormVehicles) Create(ctx context.Context, vehicle *models.Vehicle) error {
+	return r.db.WithContext(ctx).Create(vehicle).Error
+}
+
+func (r gormVehicles) Get(ctx context.Context, internalID int) (*models.Vehicle, error) {
+	return first[models.Vehicle](r.db.WithContext(ctx).Where("internal_id = ?", internalID))
+}
+
+func (r gormVehicles) GetByVehicleID(ctx context.Context, vehicleID string) (*models.Vehicle, error) {
+	return first[models.Vehicle](r.db.WithContext(ctx).Where("vehicle_id = ?", vehicleID))
+}
+
+func (r gormVehicles) GetMany(ctx context.Context, vehicleIDs []string) ([]models.Vehicle, error) {
+	return find[models.Vehicle](r.db.WithContext(ctx).Where("vehicle_id IN ?", vehicleIDs))
+}
+
+func (r gormVehicles) Lock(ctx context.Context, internalID int) (*models.Vehicle, error) {
+	return first[models.Vehicle](forUpdate(r.db.WithContext(ctx)).Where("internal_id = ?", internalID))
+}
+
+func (r gormVehicles) LockByVehicleID(ctx context.Context, vehicleID string) (*models.Vehicle, error) {
+	return first[models.Vehicle](forUpdate(r.db.WithContext(ctx)).Where("vehicle_id = ?", vehicleID))
+}
+
+func (r gormVehicles) Save(ctx context.Context, vehicle *models.Vehicle) error {
+	return r.db.WithContext(ctx).Save(vehicle).Error
+}
+
+func (r gormVehicles) Delete(ctx context.Context, internalID int) error {
+	return deleteWhere(r.db.WithContext(ctx).Where("internal_id = ?", internalID), &models.Vehicle{})
+}
+
+func (r gormVehicles) Find(ctx context.Context, q Query) ([]models.Vehicle, error) {
+	return search[models.Vehicle](r.db.WithContext(ctx).Model(&models.Vehicle{}), q, vehicleSortFields, nil, vehicleBoolFields)
+}
+
+func (r gormVehicles) Count(ctx context.Context, filters map[string]string) (int64, error) {
+	return count(r.db.WithContext(ctx).Model(&models.Vehicle{}), filters, nil, vehicleBoolFields)
+}
+
+func (r gormVehicles) CreateConfirmation(ctx context.Context, conf *models.VehicleConfirmation) error {
+	return r.db.WithContext(ctx).Create(conf).Error
+}
+
+func (r gormVehicles) GetConfirmation(ctx context.Context, vehicleID string, confirmationID int) (*models.VehicleConfirmation, error) {
+	return first[models.VehicleConfirmation](r.db.WithContext(ctx).
+		Where("confirmation_id = ? AND vehicle_id = ?", confirmationID, vehicleID))
+}
+
+func (r gormVehicles) SaveConfirmation(ctx context.Context, conf *models.VehicleConfirmation) error {
+	return r.db.WithContext(ctx).Save(conf).Error
+}
+
+func (r gormVehicles) ListConfirmations(ctx context.Context, vehicleID, status string) ([]models.VehicleConfirmation, error) {
+	query := r.db.WithContext(ctx).Where("vehicle_id = ?", vehicleID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	return find[models.VehicleConfirmation](query.Order("confirmation_id DESC"))
+}
+
+func (r gormVehicles) PendingConfirmations(ctx context.Context, vehicleID string) ([]models.VehicleConfirmation, error) {
+	return find[models.VehicleConfirmation](r.db.WithContext(ctx).
+		Where("vehicle_id = ? AND status = ?", vehicleID, models.ConfirmationPending).
+		Order("confirmation_id"))
+}
@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+	"gorm.io/gorm"
+)
+
+type gormWebhooks struct {
+	db *gorm.DB
+}
+
+func (r gormWebhooks) CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	return r.db.WithContext(ctx).Create(sub).Error
+}
+
+func (r gormWebhooks) GetSubscription(ctx context.Context, subscriptionID int) (*models.WebhookSubscription, error) {
+	return first[models.WebhookSubscription](r.db.WithContext(ctx).Where("subscription_id = ?", subscriptionID))
+}
+
+func (r gormWebhooks) ListSubscriptions(ctx context.Context, activeOnly bool) ([]models.WebhookSubscription, error) {
+	query := r.db.WithContext(ctx)
+	if activeOnly {
+		query = query.Where("is_active = ?", true)
+	}
+	return find[models.WebhookSubscription](query.Order("subscription_id"))
+}
+
+func (r gormWebhooks) SaveSubscription(ctx context.Context, sub *models.WebhookSubscription) error {
+	return r.db.WithContext(ctx).Save(sub).Error
+}
+
+// DeleteSubscription 전송과 시도 기록은 ON DELETE CASCADE
+func (r gormWebhooks) DeleteSubscription(ctx context.Context, subscriptionID int) error {
+	return deleteWhere(r.db.WithContext(ctx).Where("subscription_id = ?", subscriptionID), &models.WebhookSubscription{})
+}
+
+func (r gormWebhooks) CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error {
+	if len(deliveries) == 0 {
+		return nil
+	}
+	return r.db.WithContext(ctx).Create(&deliveries).Error
+}
+
+func (r gormWebhooks) GetDelivery(ctx context.Context, deliveryID int) (*models.WebhookDelivery, error) {
+	return first[models.WebhookDelivery](r.db.WithContext(ctx).Where("delivery_id = ?", deliveryID))
+}
+
+func (r gormWebhooks) ListDeliveries(ctx context.Context, subscriptionID int, status string) ([]models.WebhookDelivery, error) {
+	query := r.db.WithContext(ctx).Where("subscription_id = ?", subscriptionID)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+	return find[models.WebhookDelivery](query.Order("delivery_id DESC"))
+}
+
+func (r gormWebhooks) HasEvent(ctx context.Context, eventID string) (bool, error) {
+	var n int64
+	err := r.db.WithContext(ctx).Model(&models.WebhookDelivery{}).Where("event_id = ?", eventID).Count(&n).Error
+	return n > 0, err
+}
+
+func (r gormWebhooks) DueDeliveries(ctx context.Context, at time.Time, limit int) ([]models.WebhookDelivery, error) {
+	return find[models.WebhookDelivery](r.db.WithContext(ctx).
+		Where("status = ? AND next_attempt_at <= ?", models.WebhookDeliveryPending, at).
+		Order("next_attempt_at").Limit(limit))
+}
+
+func (r gormWebhooks) ClaimDelivery(ctx context.Context, deliveryID int, at, until time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.WebhookDelivery{}).
+		Where("delivery_id = ? AND status = ? AND next_attempt_at <= ?", deliveryID, models.WebhookDeliveryPending, at).
+		Update("next_attempt_at", until)
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r gormWebhooks) ResetDelivery(ctx context.Context, deliveryID int, until time.Time) (bool, error) {
+	result := r.db.WithContext(ctx).Model(&models.WebhookDelivery{}).
+		Where("delivery_id = ? AND status IN ?", deliveryID, []string{models.WebhookDeliverySucceeded, models.WebhookDeliveryFailed}).
+		Updates(map[string]any{
+			"status":          models.WebhookDeliveryPending,
+			"attempts":        0,
+			"next_attempt_at": until,
+			"delivered_at":    nil,
+		})
+	return result.RowsAffected == 1, result.Error
+}
+
+func (r gormWebhooks) UpdateDelivery(ctx context.Context, deliveryID int, columns map[string]any) error {
+	return r.db.WithContext(ctx).Model(&models.WebhookDelivery{}).Where("delivery_id = ?", deliveryID).Updates(columns).Error
+}
+
+func (r gormWebhooks) CreateAttempt(ctx context.Context, attempt *models.WebhookAttempt) error {
+	return r.db.WithContext(ctx).Create(attempt).Error
+}
+
+func (r gormWebhooks) ListAttempts(ctx context.Context, deliveryID int) ([]models.WebhookAttempt, error) {
+	return find[models.WebhookAttempt](r.db.WithContext(ctx).Where("delivery_id = ?", deliveryID).Order("attempt_id"))
+}
@@ -0,0 +1,103 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryDeliveryLogs struct {
+	m *MemoryStore
+}
+
+func deliveryLogIs(tripID, packageID int) func(*models.DeliveryLog) bool {
+	return func(l *models.DeliveryLog) bool { return l.TripID == tripID && l.PackageID == packageID }
+}
+
+// checkDeliveryLog 패키지, 지역 외래 키
+func checkDeliveryLog(d *memoryData, log *models.DeliveryLog) error {
+	if !exists(d.packages, packageIs(log.PackageID)) {
+		return ErrInvalidReference
+	}
+	if log.RegionID != "" && !exists(d.regions, regionIs(log.RegionID)) {
+		return ErrInvalidReference
+	}
+	return nil
+}
+
+// sortDeliveryLogs 운행 ID, 적재 순서 순
+func sortDeliveryLogs(logs []models.DeliveryLog) []models.DeliveryLog {
+	slices.SortStableFunc(logs, func(a, b models.DeliveryLog) int {
+		return cmp.Or(cmp.Compare(a.TripID, b.TripID), cmp.Compare(a.LoadOrder, b.LoadOrder))
+	})
+	return logs
+}
+
+func (r memoryDeliveryLogs) Create(_ context.Context, log *models.DeliveryLog) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := checkDeliveryLog(d, log); err != nil {
+			return err
+		}
+		if log.RegisteredAt.IsZero() {
+			log.RegisteredAt = time.Now()
+		}
+		d.deliveryLogs = append(d.deliveryLogs, *log)
+		return nil
+	})
+}
+
+func (r memoryDeliveryLogs) GetByTrip(_ context.Context, tripID int) (*models.DeliveryLog, error) {
+	return read(r.m, func(d *memoryData) (*models.DeliveryLog, error) {
+		logs := sortDeliveryLogs(filterRows(d.deliveryLogs, func(l *models.DeliveryLog) bool { return l.TripID == tripID }))
+		if len(logs) == 0 {
+			return nil, ErrNotFound
+		}
+		return &logs[0], nil
+	})
+}
+
+func (r memoryDeliveryLogs) Save(_ context.Context, log *models.DeliveryLog) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := checkDeliveryLog(d, log); err != nil {
+			return err
+		}
+		return replaceRow(d.deliveryLogs, deliveryLogIs(log.TripID, log.PackageID), *log)
+	})
+}
+
+func (r memoryDeliveryLogs) DeleteByTrip(_ context.Context, tripID int) error {
+	return r.m.do(func(d *memoryData) error {
+		return removeRows(&d.deliveryLogs, func(l *models.DeliveryLog) bool { return l.TripID == tripID })
+	})
+}
+
+func (r memoryDeliveryLogs) ListByPackages(_ context.Context, packageIDs []int) ([]models.DeliveryLog, error) {
+	return read(r.m, func(d *memoryData) ([]models.DeliveryLog, error) {
+		return sortDeliveryLogs(filterRows(d.deliveryLogs, func(l *models.DeliveryLog) bool {
+			return slices.Contains(packageIDs, l.PackageID)
+		})), nil
+	})
+}
+
+func (r memoryDeliveryLogs) ListByTrips(_ context.Context, tripIDs []int) ([]models.DeliveryLog, error) {
+	return read(r.m, func(d *memoryData) ([]models.DeliveryLog, error) {
+		return sortDeliveryLogs(filterRows(d.deliveryLogs, func(l *models.DeliveryLog) bool {
+			return slices.Contains(tripIDs, l.TripID)
+		})), nil
+	})
+}
+
+func (r memoryDeliveryLogs) Find(_ context.Context, q Query) ([]models.DeliveryLog, error) {
+	return read(r.m, func(d *memoryData) ([]models.DeliveryLog, error) {
+		return searchRows(d.deliveryLogs, q, deliveryLogSortFields, deliveryLogDateFields), nil
+	})
+}
+
+func (r memoryDeliveryLogs) Count(_ context.Context, filters map[string]string) (int64, error) {
+	return read(r.m, func(d *memoryData) (int64, error) {
+		return countRows(d.deliveryLogs, filters, deliveryLogDateFields), nil
+	})
+}
@@ -0,0 +1,204 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryEmployees struct {
+	m *MemoryStore
+}
+
+func employeeIs(id int) func(*models.Employee) bool {
+	return func(e *models.Employee) bool { return e.EmployeeID == id }
+}
+
+// checkEmployee 배정 차량 외래 키, username·배정 차량 고유 키
+func checkEmployee(d *memoryData, emp *models.Employee) error {
+	if emp.AssignedVehicleID != nil && !exists(d.vehicles, vehicleNumberIs(*emp.AssignedVehicleID)) {
+		return ErrInvalidReference
+	}
+	if emp.Username != nil && exists(d.employees, func(e *models.Employee) bool {
+		return e.EmployeeID != emp.EmployeeID && e.Username != nil && *e.Username == *emp.Username
+	}) {
+		return ErrDuplicate
+	}
+	if emp.AssignedVehicleID != nil && exists(d.employees, func(e *models.Employee) bool {
+		return e.EmployeeID != emp.EmployeeID && e.AssignedVehicleID != nil && *e.AssignedVehicleID == *emp.AssignedVehicleID
+	}) {
+		return ErrDuplicate
+	}
+	return nil
+}
+
+func (r memoryEmployees) Create(_ context.Context, emp *models.Employee) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := checkEmployee(d, emp); err != nil {
+			return err
+		}
+		if emp.EmployeeID == 0 {
+			emp.EmployeeID = d.nextID("employee")
+		} else if exists(d.employees, employeeIs(emp.EmployeeID)) {
+			return ErrDuplicate
+		}
+		d.employees = append(d.employees, *emp)
+		return nil
+	})
+}
+
+func (r memoryEmployees) Get(_ context.Context, employeeID int) (*models.Employee, error) {
+	return read(r.m, func(d *memoryData) (*models.Employee, error) {
+		return firstMatch(d.employees, employeeIs(employeeID))
+	})
+}
+
+func (r memoryEmployees) GetByUsername(_ context.Context, username string) (*models.Employee, error) {
+	return read(r.m, func(d *memoryData) (*models.Employee, error) {
+		return firstMatch(d.employees, func(e *models.Employee) bool { return e.Username != nil && *e.Username == username })
+	})
+}
+
+func (r memoryEmployees) GetMany(_ context.Context, employeeIDs []int) ([]models.Employee, error) {
+	return read(r.m, func(d *memoryData) ([]models.Employee, error) {
+		return filterRows(d.employees, func(e *models.Employee) bool { return slices.Contains(employeeIDs, e.EmployeeID) }), nil
+	})
+}
+
+// Lock 메모리 Store 의 트랜잭션은 전체를 잠그므로 조회와 같음
+func (r memoryEmployees) Lock(ctx context.Context, employeeID int) (*models.Employee, error) {
+	return r.Get(ctx, employeeID)
+}
+
+func (r memoryEmployees) Save(_ context.Context, emp *models.Employee) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := checkEmployee(d, emp); err != nil {
+			return err
+		}
+		return replaceRow(d.employees, employeeIs(emp.EmployeeID), *emp)
+	})
+}
+
+func (r memoryEmployees) Update(_ context.Context, employeeID int, columns map[string]any) error {
+	return r.m.do(func(d *memoryData) error {
+		i := slices.IndexFunc(d.employees, func(e models.Employee) bool { return e.EmployeeID == employeeID })
+		if i < 0 {
+			return nil // UPDATE 대상이 없어도 에러가 아님
+		}
+		emp := d.employees[i]
+		if err := updateColumns(&emp, columns); err != nil {
+			return err
+		}
+		// 비밀번호 해시는 JSON 으로 직렬화하지 않으므로 updateColumns 가 반영하지 못함
+		if hash, ok := columns["password"].(string); ok {
+			emp.Password = hash
+		}
+		if err := checkEmployee(d, &emp); err != nil {
+			return err
+		}
+		d.employees[i] = emp
+		return nil
+	})
+}
+
+func (r memoryEmployees) IncrementFailedLogins(_ context.Context, employeeID int) (int, error) {
+	return read(r.m, func(d *memoryData) (int, error) {
+		i := slices.IndexFunc(d.employees, func(e models.Employee) bool { return e.EmployeeID == employeeID })
+		if i < 0 {
+			return 0, nil
+		}
+		d.employees[i].FailedLoginCount++
+		return d.employees[i].FailedLoginCount, nil
+	})
+}
+
+// Delete 재설정 토큰은 ON DELETE CASCADE, 운행 기록의 운전자는 ON DELETE SET NULL
+func (r memoryEmployees) Delete(_ context.Context, employeeID int) error {
+	return r.m.do(func(d *memoryData) error {
+		if !exists(d.employees, employeeIs(employeeID)) {
+			return ErrNotFound
+		}
+		if exists(d.shifts, func(s *models.Shift) bool { return s.EmployeeID == employeeID }) {
+			return ErrInUse
+		}
+		if err := removeRows(&d.employees, employeeIs(employeeID)); err != nil {
+			return err
+		}
+		d.resetTokens = slices.DeleteFunc(d.resetTokens, func(t models.PasswordResetToken) bool {
+			return t.EmployeeID == employeeID
+		})
+		for i, t := range d.tripLogs {
+			if t.DriverID != nil && *t.DriverID == employeeID {
+				d.tripLogs[i].DriverID = nil
+			}
+		}
+		for i, t := range d.tripLogsB {
+			if t.DriverID != nil && *t.DriverID == employeeID {
+				d.tripLogsB[i].DriverID = nil
+			}
+		}
+		return nil
+	})
+}
+
+func (r memoryEmployees) Find(_ context.Context, q Query) ([]models.Employee, error) {
+	return read(r.m, func(d *memoryData) ([]models.Employee, error) {
+		emps := d.employees
+		filters := make(map[string]string, len(q.Filters))
+		for k, v := range q.Filters {
+			if k == "name" {
+				emps = filterRows(emps, func(e *models.Employee) bool { return strings.Contains(e.Name, v) })
+			} else {
+				filters[k] = v
+			}
+		}
+		q.Filters = filters
+		return searchRows(emps, q, employeeSortFields, nil), nil
+	})
+}
+
+func (r memoryEmployees) CreateResetToken(_ context.Context, token *models.PasswordResetToken) error {
+	return r.m.do(func(d *memoryData) error {
+		if !exists(d.employees, employeeIs(token.EmployeeID)) {
+			return ErrInvalidReference
+		}
+		if exists(d.resetTokens, func(t *models.PasswordResetToken) bool { return t.TokenHash == token.TokenHash }) {
+			return ErrDuplicate
+		}
+		token.TokenID = d.nextID("password_reset_token")
+		d.resetTokens = append(d.resetTokens, *token)
+		return nil
+	})
+}
+
+func (r memoryEmployees) GetResetToken(_ context.Context, tokenHash string) (*models.PasswordResetToken, error) {
+	return read(r.m, func(d *memoryData) (*models.PasswordResetToken, error) {
+		return firstMatch(d.resetTokens, func(t *models.PasswordResetToken) bool { return t.TokenHash == tokenHash })
+	})
+}
+
+func (r memoryEmployees) ExpireResetTokens(_ context.Context, employeeID int, at time.Time) error {
+	return r.m.do(func(d *memoryData) error {
+		for i, t := range d.resetTokens {
+			if t.EmployeeID == employeeID && t.UsedAt == nil {
+				d.resetTokens[i].ExpiresAt = at
+			}
+		}
+		return nil
+	})
+}
+
+func (r memoryEmployees) UseResetToken(_ context.Context, tokenID int, at time.Time) (bool, error) {
+	return read(r.m, func(d *memoryData) (bool, error) {
+		for i, t := range d.resetTokens {
+			if t.TokenID == tokenID && t.UsedAt == nil && t.ExpiresAt.After(at) {
+				d.resetTokens[i].UsedAt = &at
+				return true, nil
+			}
+		}
+		return false, nil
+	})
+}
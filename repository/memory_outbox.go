@@ -0,0 +1,50 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryOutbox struct {
+	m *MemoryStore
+}
+
+func (r memoryOutbox) Pending(_ context.Context, limit int) ([]models.OutboxEvent, error) {
+	return read(r.m, func(d *memoryData) ([]models.OutboxEvent, error) {
+		rows := filterRows(d.events, func(e *models.OutboxEvent) bool { return e.ProcessedAt == nil })
+		return rows[:min(limit, len(rows))], nil
+	})
+}
+
+func (r memoryOutbox) OldestPending(_ context.Context, aggregateType, aggregateID string) (int64, error) {
+	return read(r.m, func(d *memoryData) (int64, error) {
+		for _, e := range d.events {
+			if e.ProcessedAt == nil && e.AggregateType == aggregateType && e.AggregateID == aggregateID {
+				return e.OutboxID, nil
+			}
+		}
+		return 0, nil
+	})
+}
+
+func (r memoryOutbox) Update(_ context.Context, outboxID int64, columns map[string]any) error {
+	return r.m.do(func(d *memoryData) error {
+		i := slices.IndexFunc(d.events, func(e models.OutboxEvent) bool { return e.OutboxID == outboxID })
+		if i < 0 {
+			return nil
+		}
+		return updateColumns(&d.events[i], columns)
+	})
+}
+
+func (r memoryOutbox) DeleteProcessed(_ context.Context, before time.Time) error {
+	return r.m.do(func(d *memoryData) error {
+		d.events = slices.DeleteFunc(d.events, func(e models.OutboxEvent) bool {
+			return e.ProcessedAt != nil && e.ProcessedAt.Before(before)
+		})
+		return nil
+	})
+}
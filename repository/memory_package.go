@@ -0,0 +1,106 @@
+package repository
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryPackages struct {
+	m *MemoryStore
+}
+
+func packageIs(id int) func(*models.Package) bool {
+	return func(p *models.Package) bool { return p.PackageID == id }
+}
+
+// checkPackage unique_package_info 고유 키와 지역 외래 키
+func checkPackage(d *memoryData, pkg *models.Package) error {
+	if !exists(d.regions, regionIs(pkg.RegionID)) {
+		return ErrInvalidReference
+	}
+	if exists(d.packages, func(p *models.Package) bool {
+		return p.PackageID != pkg.PackageID && p.PackageType == pkg.PackageType && p.RegionID == pkg.RegionID
+	}) {
+		return ErrDuplicate
+	}
+	return nil
+}
+
+func (r memoryPackages) Create(_ context.Context, pkg *models.Package) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := checkPackage(d, pkg); err != nil {
+			return err
+		}
+		if pkg.PackageID == 0 {
+			pkg.PackageID = d.nextID("package")
+		} else if exists(d.packages, packageIs(pkg.PackageID)) {
+			return ErrDuplicate
+		}
+		if pkg.PackageStatus == "" {
+			pkg.PackageStatus = "등록됨"
+		}
+		if pkg.RegisteredAt.IsZero() {
+			pkg.RegisteredAt = time.Now()
+		}
+		d.packages = append(d.packages, *pkg)
+		return nil
+	})
+}
+
+func (r memoryPackages) Get(_ context.Context, packageID int) (*models.Package, error) {
+	return read(r.m, func(d *memoryData) (*models.Package, error) {
+		return firstMatch(d.packages, packageIs(packageID))
+	})
+}
+
+func (r memoryPackages) GetMany(_ context.Context, packageIDs []int) ([]models.Package, error) {
+	return read(r.m, func(d *memoryData) ([]models.Package, error) {
+		return filterRows(d.packages, func(p *models.Package) bool { return slices.Contains(packageIDs, p.PackageID) }), nil
+	})
+}
+
+func (r memoryPackages) ListByRegions(_ context.Context, regionIDs []string) ([]models.Package, error) {
+	return read(r.m, func(d *memoryData) ([]models.Package, error) {
+		pkgs := filterRows(d.packages, func(p *models.Package) bool { return slices.Contains(regionIDs, p.RegionID) })
+		slices.SortFunc(pkgs, func(a, b models.Package) int { return a.PackageID - b.PackageID })
+		return pkgs, nil
+	})
+}
+
+// Lock 메모리 Store 의 트랜잭션은 전체를 잠그므로 조회와 같음
+func (r memoryPackages) Lock(ctx context.Context, packageID int) (*models.Package, error) {
+	return r.Get(ctx, packageID)
+}
+
+func (r memoryPackages) Save(_ context.Context, pkg *models.Package) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := checkPackage(d, pkg); err != nil {
+			return err
+		}
+		return replaceRow(d.packages, packageIs(pkg.PackageID), *pkg)
+	})
+}
+
+func (r memoryPackages) Delete(_ context.Context, packageID int) error {
+	return r.m.do(func(d *memoryData) error {
+		if exists(d.deliveryLogs, func(l *models.DeliveryLog) bool { return l.PackageID == packageID }) {
+			return ErrInUse
+		}
+		return removeRows(&d.packages, packageIs(packageID))
+	})
+}
+
+func (r memoryPackages) Find(_ context.Context, q Query) ([]models.Package, error) {
+	return read(r.m, func(d *memoryData) ([]models.Package, error) {
+		return searchRows(d.packages, q, packageSortFields, packageDateFields), nil
+	})
+}
+
+func (r memoryPackages) Count(_ context.Context, filters map[string]string) (int64, error) {
+	return read(r.m, func(d *memoryData) (int64, error) {
+		return countRows(d.packages, filters, packageDateFields), nil
+	})
+}
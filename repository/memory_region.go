@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryRegions struct {
+	m *MemoryStore
+}
+
+func regionIs(id string) func(*models.Region) bool {
+	return func(r *models.Region) bool { return r.RegionID == id }
+}
+
+func (r memoryRegions) Create(_ context.Context, region *models.Region) error {
+	return r.m.do(func(d *memoryData) error {
+		if exists(d.regions, regionIs(region.RegionID)) {
+			return ErrDuplicate
+		}
+		d.regions = append(d.regions, *region)
+		return nil
+	})
+}
+
+func (r memoryRegions) Get(_ context.Context, regionID string) (*models.Region, error) {
+	return read(r.m, func(d *memoryData) (*models.Region, error) {
+		return firstMatch(d.regions, regionIs(regionID))
+	})
+}
+
+func (r memoryRegions) GetMany(_ context.Context, regionIDs []string) ([]models.Region, error) {
+	return read(r.m, func(d *memoryData) ([]models.Region, error) {
+		return filterRows(d.regions, func(v *models.Region) bool { return slices.Contains(regionIDs, v.RegionID) }), nil
+	})
+}
+
+// Lock 메모리 Store 의 트랜잭션은 전체를 잠그므로 조회와 같음
+func (r memoryRegions) Lock(ctx context.Context, regionID string) (*models.Region, error) {
+	return r.Get(ctx, regionID)
+}
+
+func (r memoryRegions) Save(_ context.Context, region *models.Region) error {
+	return r.m.do(func(d *memoryData) error {
+		if replaceRow(d.regions, regionIs(region.RegionID), *region) != nil {
+			d.regions = append(d.regions, *region)
+		}
+		return nil
+	})
+}
+
+func (r memoryRegions) Delete(_ context.Context, regionID string) error {
+	return r.m.do(func(d *memoryData) error {
+		if exists(d.packages, func(p *models.Package) bool { return p.RegionID == regionID }) ||
+			exists(d.deliveryLogs, func(l *models.DeliveryLog) bool { return l.RegionID == regionID }) {
+			return ErrInUse
+		}
+		return removeRows(&d.regions, regionIs(regionID))
+	})
+}
+
+func (r memoryRegions) Find(_ context.Context, q Query) ([]models.Region, error) {
+	return read(r.m, func(d *memoryData) ([]models.Region, error) {
+		return searchRows(d.regions, q, regionSortFields, regionDateFields), nil
+	})
+}
+
+func (r memoryRegions) Count(_ context.Context, filters map[string]string) (int64, error) {
+	return read(r.m, func(d *memoryData) (int64, error) {
+		return countRows(d.regions, filters, regionDateFields), nil
+	})
+}
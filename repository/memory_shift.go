@@ -0,0 +1,86 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryShifts struct {
+	m *MemoryStore
+}
+
+func shiftIs(id int) func(*models.Shift) bool {
+	return func(s *models.Shift) bool { return s.ShiftID == id }
+}
+
+// activeAt at 시각에 시작했고 아직 끝나지 않은 근무
+func activeAt(at time.Time) func(*models.Shift) bool {
+	return func(s *models.Shift) bool {
+		return !s.StartTime.After(at) && (s.EndTime == nil || s.EndTime.After(at))
+	}
+}
+
+func (r memoryShifts) Create(_ context.Context, shift *models.Shift) error {
+	return r.m.do(func(d *memoryData) error {
+		if !exists(d.employees, employeeIs(shift.EmployeeID)) || !exists(d.vehicles, vehicleNumberIs(shift.VehicleID)) {
+			return ErrInvalidReference
+		}
+		shift.ShiftID = d.nextID("shift")
+		d.shifts = append(d.shifts, *shift)
+		return nil
+	})
+}
+
+func (r memoryShifts) Get(_ context.Context, shiftID int) (*models.Shift, error) {
+	return read(r.m, func(d *memoryData) (*models.Shift, error) {
+		return firstMatch(d.shifts, shiftIs(shiftID))
+	})
+}
+
+func (r memoryShifts) List(_ context.Context, filters map[string]string, at *time.Time) ([]models.Shift, error) {
+	return read(r.m, func(d *memoryData) ([]models.Shift, error) {
+		shifts := d.shifts
+		if at != nil {
+			shifts = filterRows(shifts, activeAt(*at))
+		}
+		return searchRows(shifts, Query{Filters: filters, Sort: "-start_time"}, columns("start_time"), nil), nil
+	})
+}
+
+func (r memoryShifts) FindOverlap(_ context.Context, vehicleID string, employeeID int, start time.Time, end *time.Time) (*models.Shift, error) {
+	return read(r.m, func(d *memoryData) (*models.Shift, error) {
+		return firstMatch(d.shifts, func(s *models.Shift) bool {
+			return (s.EndTime == nil || s.EndTime.After(start)) &&
+				(end == nil || s.StartTime.Before(*end)) &&
+				(s.VehicleID == vehicleID || s.EmployeeID == employeeID)
+		})
+	})
+}
+
+func (r memoryShifts) ActiveForEmployee(_ context.Context, employeeID int, at time.Time) (*models.Shift, error) {
+	return read(r.m, func(d *memoryData) (*models.Shift, error) {
+		active := activeAt(at)
+		return firstMatch(d.shifts, func(s *models.Shift) bool { return s.EmployeeID == employeeID && active(s) })
+	})
+}
+
+func (r memoryShifts) ActiveForVehicle(_ context.Context, vehicleID string, at time.Time) (*models.Shift, error) {
+	return read(r.m, func(d *memoryData) (*models.Shift, error) {
+		active := activeAt(at)
+		return firstMatch(d.shifts, func(s *models.Shift) bool { return s.VehicleID == vehicleID && active(s) })
+	})
+}
+
+func (r memoryShifts) Save(_ context.Context, shift *models.Shift) error {
+	return r.m.do(func(d *memoryData) error {
+		return replaceRow(d.shifts, shiftIs(shift.ShiftID), *shift)
+	})
+}
+
+func (r memoryShifts) Delete(_ context.Context, shiftID int) error {
+	return r.m.do(func(d *memoryData) error {
+		return removeRows(&d.shifts, shiftIs(shiftID))
+	})
+}
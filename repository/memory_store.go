@@ -0,0 +1,331 @@
+package repository
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+)
+
+// MemoryStore 테스트용 메모리 Store. 모든 연산은 하나의 잠금으로 직렬화되고,
+// 트랜잭션이 실패하면 시작 시점의 상태로 되돌림. DB 와 같은 고유 키, 외래 키 제약을 검사함
+type MemoryStore struct {
+	mu   *sync.Mutex
+	data *memoryData
+	inTx bool // 트랜잭션 안에서는 이미 잠금을 잡고 있음
+}
+
+type memoryData struct {
+	regions       []models.Region
+	packages      []models.Package
+	vehicles      []models.Vehicle
+	confirmations []models.VehicleConfirmation
+	tripLogs      []models.TripLog
+	tripLogsB     []models.TripLogB
+	deliveryLogs  []models.DeliveryLog
+	employees     []models.Employee
+	resetTokens   []models.PasswordResetToken
+	shifts        []models.Shift
+	webhookSubs   []models.WebhookSubscription
+	deliveries    []models.WebhookDelivery
+	attempts      []models.WebhookAttempt
+	events        []models.OutboxEvent
+	lastID        map[string]int // 테이블별 자동 증가 값
+}
+
+var _ Store = (*MemoryStore)(nil)
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{mu: &sync.Mutex{}, data: &memoryData{lastID: map[string]int{}}}
+}
+
+func (m *MemoryStore) Regions() RegionRepository           { return memoryRegions{m} }
+func (m *MemoryStore) Packages() PackageRepository         { return memoryPackages{m} }
+func (m *MemoryStore) Vehicles() VehicleRepository         { return memoryVehicles{m} }
+func (m *MemoryStore) TripLogs() TripLogRepository         { return memoryTripLogs{m} }
+func (m *MemoryStore) DeliveryLogs() DeliveryLogRepository { return memoryDeliveryLogs{m} }
+func (m *MemoryStore) Employees() EmployeeRepository       { return memoryEmployees{m} }
+func (m *MemoryStore) Shifts() ShiftRepository             { return memoryShifts{m} }
+func (m *MemoryStore) Webhooks() WebhookRepository         { return memoryWebhooks{m} }
+func (m *MemoryStore) Events() EventRecorder               { return memoryEvents{m} }
+func (m *MemoryStore) Outbox() outbox.Repository           { return memoryOutbox{m} }
+
+// Replica 메모리 Store 는 복제본이 없으므로 자기 자신
+func (m *MemoryStore) Replica() Store { return m }
+
+func (m *MemoryStore) Transaction(ctx context.Context, fn func(tx Store) error) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if !m.inTx {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+	}
+	snapshot := m.data.clone()
+	if err := fn(&MemoryStore{mu: m.mu, data: m.data, inTx: true}); err != nil {
+		*m.data = *snapshot
+		return err
+	}
+	return nil
+}
+
+// RecordedEvents 커밋된 아웃박스 이벤트를 기록 순서대로
+func (m *MemoryStore) RecordedEvents() []outbox.Event {
+	events, _ := read(m, func(d *memoryData) ([]outbox.Event, error) {
+		events := make([]outbox.Event, 0, len(d.events))
+		for i := range d.events {
+			events = append(events, outbox.FromRow(&d.events[i]))
+		}
+		return events, nil
+	})
+	return events
+}
+
+// AddTripLogB B 차량 운행 기록은 별도 시스템이 기록하므로 테스트에서 직접 추가
+func (m *MemoryStore) AddTripLogB(trip models.TripLogB) {
+	_ = m.do(func(d *memoryData) error {
+		if trip.TripID == 0 {
+			trip.TripID = d.nextID("trip_log_B")
+		}
+		d.tripLogsB = append(d.tripLogsB, trip)
+		return nil
+	})
+}
+
+func (d *memoryData) clone() *memoryData {
+	return &memoryData{
+		regions:       slices.Clone(d.regions),
+		packages:      slices.Clone(d.packages),
+		vehicles:      slices.Clone(d.vehicles),
+		confirmations: slices.Clone(d.confirmations),
+		tripLogs:      slices.Clone(d.tripLogs),
+		tripLogsB:     slices.Clone(d.tripLogsB),
+		deliveryLogs:  slices.Clone(d.deliveryLogs),
+		employees:     slices.Clone(d.employees),
+		resetTokens:   slices.Clone(d.resetTokens),
+		shifts:        slices.Clone(d.shifts),
+		webhookSubs:   slices.Clone(d.webhookSubs),
+		deliveries:    slices.Clone(d.deliveries),
+		attempts:      slices.Clone(d.attempts),
+		events:        slices.Clone(d.events),
+		lastID:        maps.Clone(d.lastID),
+	}
+}
+
+func (d *memoryData) nextID(table string) int {
+	d.lastID[table]++
+	return d.lastID[table]
+}
+
+// do 트랜잭션 밖이면 잠금을 잡고 fn 실행
+func (m *MemoryStore) do(fn func(d *memoryData) error) error {
+	if !m.inTx {
+		m.mu.Lock()
+		defer m.mu.Unlock()
+	}
+	return fn(m.data)
+}
+
+func read[T any](m *MemoryStore, fn func(d *memoryData) (T, error)) (T, error) {
+	var v T
+	err := m.do(func(d *memoryData) error {
+		var err error
+		v, err = fn(d)
+		return err
+	})
+	return v, err
+}
+
+type memoryEvents struct {
+	m *MemoryStore
+}
+
+func (e memoryEvents) Record(_ context.Context, eventType, aggregateType, aggregateID string, data any) error {
+	row, err := outbox.NewRow(eventType, aggregateType, aggregateID, data)
+	if err != nil {
+		return err
+	}
+	return e.m.do(func(d *memoryData) error {
+		row.OutboxID = int64(d.nextID("outbox_event"))
+		d.events = append(d.events, *row)
+		return nil
+	})
+}
+
+// firstMatch 조건에 맞는 첫 행의 복사본. 없으면 ErrNotFound
+func firstMatch[T any](rows []T, match func(*T) bool) (*T, error) {
+	for i := range rows {
+		if match(&rows[i]) {
+			v := rows[i]
+			return &v, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+func filterRows[T any](rows []T, match func(*T) bool) []T {
+	res := []T{}
+	for i := range rows {
+		if match(&rows[i]) {
+			res = append(res, rows[i])
+		}
+	}
+	return res
+}
+
+// replaceRow 조건에 맞는 첫 행을 v 로 교체. 없으면 ErrNotFound
+func replaceRow[T any](rows []T, match func(*T) bool, v T) error {
+	for i := range rows {
+		if match(&rows[i]) {
+			rows[i] = v
+			return nil
+		}
+	}
+	return ErrNotFound
+}
+
+// removeRows 조건에 맞는 행을 모두 삭제. 없으면 ErrNotFound
+func removeRows[T any](rows *[]T, match func(*T) bool) error {
+	n := len(*rows)
+	*rows = slices.DeleteFunc(*rows, func(v T) bool { return match(&v) })
+	if len(*rows) == n {
+		return ErrNotFound
+	}
+	return nil
+}
+
+func exists[T any](rows []T, match func(*T) bool) bool {
+	_, err := firstMatch(rows, match)
+	return err == nil
+}
+
+// searchRows MySQL 구현과 같은 의미로 필터, 정렬, 페이지 적용. 컬럼 값은 모델의 json 태그(컬럼 이름과 같음)로 읽음
+func searchRows[T any](rows []T, q Query, sortFields, dateFields map[string]bool) []T {
+	type row struct {
+		value T
+		cols  map[string]any
+	}
+	matched := []row{}
+	for _, r := range rows {
+		cols := rowColumns(r)
+		if matchFilters(cols, q.Filters, dateFields) {
+			matched = append(matched, row{r, cols})
+		}
+	}
+	if field, desc, ok := parseSort(q.Sort, sortFields); ok {
+		slices.SortStableFunc(matched, func(a, b row) int {
+			c := compareColumn(a.cols[field], b.cols[field])
+			if desc {
+				return -c
+			}
+			return c
+		})
+	}
+	if q.Page.Offset > 0 {
+		matched = matched[min(q.Page.Offset, len(matched)):]
+	}
+	if q.Page.Limit > 0 {
+		matched = matched[:min(q.Page.Limit, len(matched))]
+	}
+	res := make([]T, 0, len(matched))
+	for _, r := range matched {
+		res = append(res, r.value)
+	}
+	return res
+}
+
+func countRows[T any](rows []T, filters map[string]string, dateFields map[string]bool) int64 {
+	var n int64
+	for _, r := range rows {
+		if matchFilters(rowColumns(r), filters, dateFields) {
+			n++
+		}
+	}
+	return n
+}
+
+func rowColumns(v any) map[string]any {
+	b, _ := json.Marshal(v)
+	dec := json.NewDecoder(bytes.NewReader(b))
+	dec.UseNumber()
+	cols := map[string]any{}
+	_ = dec.Decode(&cols)
+	return cols
+}
+
+// updateColumns 컬럼 이름으로 값을 덮어씀
+func updateColumns[T any](v *T, columns map[string]any) error {
+	cols := rowColumns(v)
+	maps.Copy(cols, columns)
+	b, err := json.Marshal(cols)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(b, v)
+}
+
+func matchFilters(cols map[string]any, filters map[string]string, dateFields map[string]bool) bool {
+	for k, want := range filters {
+		got := cols[k]
+		if got == nil {
+			return false
+		}
+		if dateFields[k] {
+			s, _ := got.(string)
+			if !strings.HasPrefix(s, want) || len(want) != len("2006-01-02") {
+				return false
+			}
+			continue
+		}
+		if b, ok := got.(bool); ok {
+			if w, err := strconv.ParseBool(want); err != nil || b != w {
+				return false
+			}
+			continue
+		}
+		if fmt.Sprint(got) != want {
+			return false
+		}
+	}
+	return true
+}
+
+// compareColumn NULL 이 가장 작음 (MySQL 과 같음)
+func compareColumn(a, b any) int {
+	switch {
+	case a == nil && b == nil:
+		return 0
+	case a == nil:
+		return -1
+	case b == nil:
+		return 1
+	}
+	switch av := a.(type) {
+	case json.Number:
+		bv, _ := b.(json.Number)
+		af, _ := av.Float64()
+		bf, _ := bv.Float64()
+		return cmp.Compare(af, bf)
+	case bool:
+		bv, _ := b.(bool)
+		return cmp.Compare(boolInt(av), boolInt(bv))
+	default:
+		return cmp.Compare(fmt.Sprint(a), fmt.Sprint(b))
+	}
+}
+
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+	return 0
+}
@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryTripLogs struct {
+	m *MemoryStore
+}
+
+func tripIs(id int) func(*models.TripLog) bool {
+	return func(t *models.TripLog) bool { return t.TripID == id }
+}
+
+// checkTripLog 차량, 운전자 외래 키
+func checkTripLog(d *memoryData, trip *models.TripLog) error {
+	if !exists(d.vehicles, vehicleNumberIs(trip.VehicleID)) {
+		return ErrInvalidReference
+	}
+	if trip.DriverID != nil && !exists(d.employees, employeeIs(*trip.DriverID)) {
+		return ErrInvalidReference
+	}
+	return nil
+}
+
+func (r memoryTripLogs) Create(_ context.Context, trip *models.TripLog) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := checkTripLog(d, trip); err != nil {
+			return err
+		}
+		trip.TripID = d.nextID("trip_log")
+		d.tripLogs = append(d.tripLogs, *trip)
+		return nil
+	})
+}
+
+func (r memoryTripLogs) Get(_ context.Context, tripID int) (*models.TripLog, error) {
+	return read(r.m, func(d *memoryData) (*models.TripLog, error) {
+		return firstMatch(d.tripLogs, tripIs(tripID))
+	})
+}
+
+func (r memoryTripLogs) GetMany(_ context.Context, tripIDs []int) ([]models.TripLog, error) {
+	return read(r.m, func(d *memoryData) ([]models.TripLog, error) {
+		return filterRows(d.tripLogs, func(t *models.TripLog) bool { return slices.Contains(tripIDs, t.TripID) }), nil
+	})
+}
+
+func (r memoryTripLogs) ListByVehicles(_ context.Context, vehicleIDs []string) ([]models.TripLog, error) {
+	return read(r.m, func(d *memoryData) ([]models.TripLog, error) {
+		return filterRows(d.tripLogs, func(t *models.TripLog) bool { return slices.Contains(vehicleIDs, t.VehicleID) }), nil
+	})
+}
+
+func (r memoryTripLogs) ListByDriver(_ context.Context, driverID int) ([]models.TripLog, error) {
+	return read(r.m, func(d *memoryData) ([]models.TripLog, error) {
+		trips := filterRows(d.tripLogs, func(t *models.TripLog) bool { return t.DriverID != nil && *t.DriverID == driverID })
+		slices.Reverse(trips)
+		return trips, nil
+	})
+}
+
+func (r memoryTripLogs) ListBByDriver(_ context.Context, driverID int) ([]models.TripLogB, error) {
+	return read(r.m, func(d *memoryData) ([]models.TripLogB, error) {
+		trips := filterRows(d.tripLogsB, func(t *models.TripLogB) bool { return t.DriverID != nil && *t.DriverID == driverID })
+		slices.SortFunc(trips, func(a, b models.TripLogB) int { return b.TripID - a.TripID })
+		return trips, nil
+	})
+}
+
+func (r memoryTripLogs) Save(_ context.Context, trip *models.TripLog) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := checkTripLog(d, trip); err != nil {
+			return err
+		}
+		return replaceRow(d.tripLogs, tripIs(trip.TripID), *trip)
+	})
+}
+
+func (r memoryTripLogs) Delete(_ context.Context, tripID int) error {
+	return r.m.do(func(d *memoryData) error {
+		return removeRows(&d.tripLogs, tripIs(tripID))
+	})
+}
+
+func (r memoryTripLogs) Find(_ context.Context, q Query) ([]models.TripLog, error) {
+	return read(r.m, func(d *memoryData) ([]models.TripLog, error) {
+		return searchRows(d.tripLogs, q, tripLogSortFields, tripLogDateFields), nil
+	})
+}
+
+func (r memoryTripLogs) Count(_ context.Context, filters map[string]string) (int64, error) {
+	return read(r.m, func(d *memoryData) (int64, error) {
+		return countRows(d.tripLogs, filters, tripLogDateFields), nil
+	})
+}
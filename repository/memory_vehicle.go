@@ -0,0 +1,153 @@
+package repository
+
+import (
+	"context"
+	"slices"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryVehicles struct {
+	m *MemoryStore
+}
+
+func vehicleIs(internalID int) func(*models.Vehicle) bool {
+	return func(v *models.Vehicle) bool { return v.InternalID == internalID }
+}
+
+func vehicleNumberIs(vehicleID string) func(*models.Vehicle) bool {
+	return func(v *models.Vehicle) bool { return v.VehicleID == vehicleID }
+}
+
+func (r memoryVehicles) Create(_ context.Context, vehicle *models.Vehicle) error {
+	return r.m.do(func(d *memoryData) error {
+		if exists(d.vehicles, vehicleNumberIs(vehicle.VehicleID)) {
+			return ErrDuplicate
+		}
+		if vehicle.InternalID == 0 {
+			vehicle.InternalID = d.nextID("vehicle")
+		} else if exists(d.vehicles, vehicleIs(vehicle.InternalID)) {
+			return ErrDuplicate
+		}
+		d.vehicles = append(d.vehicles, *vehicle)
+		return nil
+	})
+}
+
+func (r memoryVehicles) Get(_ context.Context, internalID int) (*models.Vehicle, error) {
+	return read(r.m, func(d *memoryData) (*models.Vehicle, error) {
+		return firstMatch(d.vehicles, vehicleIs(internalID))
+	})
+}
+
+func (r memoryVehicles) GetByVehicleID(_ context.Context, vehicleID string) (*models.Vehicle, error) {
+	return read(r.m, func(d *memoryData) (*models.Vehicle, error) {
+		return firstMatch(d.vehicles, vehicleNumberIs(vehicleID))
+	})
+}
+
+func (r memoryVehicles) GetMany(_ context.Context, vehicleIDs []string) ([]models.Vehicle, error) {
+	return read(r.m, func(d *memoryData) ([]models.Vehicle, error) {
+		return filterRows(d.vehicles, func(v *models.Vehicle) bool { return slices.Contains(vehicleIDs, v.VehicleID) }), nil
+	})
+}
+
+// Lock 메모리 Store 의 트랜잭션은 전체를 잠그므로 조회와 같음
+func (r memoryVehicles) Lock(ctx context.Context, internalID int) (*models.Vehicle, error) {
+	return r.Get(ctx, internalID)
+}
+
+func (r memoryVehicles) LockByVehicleID(ctx context.Context, vehicleID string) (*models.Vehicle, error) {
+	return r.GetByVehicleID(ctx, vehicleID)
+}
+
+func (r memoryVehicles) Save(_ context.Context, vehicle *models.Vehicle) error {
+	return r.m.do(func(d *memoryData) error {
+		if exists(d.vehicles, func(v *models.Vehicle) bool {
+			return v.VehicleID == vehicle.VehicleID && v.InternalID != vehicle.InternalID
+		}) {
+			return ErrDuplicate
+		}
+		return replaceRow(d.vehicles, vehicleIs(vehicle.InternalID), *vehicle)
+	})
+}
+
+// Delete 확인 요청은 ON DELETE CASCADE 로 함께 삭제됨
+func (r memoryVehicles) Delete(_ context.Context, internalID int) error {
+	return r.m.do(func(d *memoryData) error {
+		vehicle, err := firstMatch(d.vehicles, vehicleIs(internalID))
+		if err != nil {
+			return err
+		}
+		if exists(d.tripLogs, func(t *models.TripLog) bool { return t.VehicleID == vehicle.VehicleID }) ||
+			exists(d.shifts, func(s *models.Shift) bool { return s.VehicleID == vehicle.VehicleID }) {
+			return ErrInUse
+		}
+		d.confirmations = slices.DeleteFunc(d.confirmations, func(c models.VehicleConfirmation) bool {
+			return c.VehicleID == vehicle.VehicleID
+		})
+		for i, e := range d.employees {
+			if e.AssignedVehicleID != nil && *e.AssignedVehicleID == vehicle.VehicleID {
+				d.employees[i].AssignedVehicleID = nil
+			}
+		}
+		return removeRows(&d.vehicles, vehicleIs(internalID))
+	})
+}
+
+func (r memoryVehicles) Find(_ context.Context, q Query) ([]models.Vehicle, error) {
+	return read(r.m, func(d *memoryData) ([]models.Vehicle, error) {
+		return searchRows(d.vehicles, q, vehicleSortFields, nil), nil
+	})
+}
+
+func (r memoryVehicles) Count(_ context.Context, filters map[string]string) (int64, error) {
+	return read(r.m, func(d *memoryData) (int64, error) {
+		return countRows(d.vehicles, filters, nil), nil
+	})
+}
+
+func (r memoryVehicles) CreateConfirmation(_ context.Context, conf *models.VehicleConfirmation) error {
+	return r.m.do(func(d *memoryData) error {
+		if !exists(d.vehicles, vehicleNumberIs(conf.VehicleID)) {
+			return ErrInvalidReference
+		}
+		conf.ConfirmationID = d.nextID("vehicle_confirmation")
+		d.confirmations = append(d.confirmations, *conf)
+		return nil
+	})
+}
+
+func (r memoryVehicles) GetConfirmation(_ context.Context, vehicleID string, confirmationID int) (*models.VehicleConfirmation, error) {
+	return read(r.m, func(d *memoryData) (*models.VehicleConfirmation, error) {
+		return firstMatch(d.confirmations, func(c *models.VehicleConfirmation) bool {
+			return c.ConfirmationID == confirmationID && c.VehicleID == vehicleID
+		})
+	})
+}
+
+func (r memoryVehicles) SaveConfirmation(_ context.Context, conf *models.VehicleConfirmation) error {
+	return r.m.do(func(d *memoryData) error {
+		return replaceRow(d.confirmations, func(c *models.VehicleConfirmation) bool {
+			return c.ConfirmationID == conf.ConfirmationID
+		}, *conf)
+	})
+}
+
+func (r memoryVehicles) ListConfirmations(_ context.Context, vehicleID, status string) ([]models.VehicleConfirmation, error) {
+	return read(r.m, func(d *memoryData) ([]models.VehicleConfirmation, error) {
+		confs := filterRows(d.confirmations, func(c *models.VehicleConfirmation) bool {
+			return c.VehicleID == vehicleID && (status == "" || c.Status == status)
+		})
+		slices.Reverse(confs)
+		return confs, nil
+	})
+}
+
+func (r memoryVehicles) PendingConfirmations(_ context.Context, vehicleID string) ([]models.VehicleConfirmation, error) {
+	return read(r.m, func(d *memoryData) ([]models.VehicleConfirmation, error) {
+		return filterRows(d.confirmations, func(c *models.VehicleConfirmation) bool {
+			return c.VehicleID == vehicleID && c.Status == models.ConfirmationPending
+		}), nil
+	})
+}
@@ -0,0 +1,166 @@
+package repository
+
+import (
+	"cmp"
+	"context"
+	"slices"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+)
+
+type memoryWebhooks struct {
+	m *MemoryStore
+}
+
+func subscriptionIs(id int) func(*models.WebhookSubscription) bool {
+	return func(s *models.WebhookSubscription) bool { return s.SubscriptionID == id }
+}
+
+func deliveryIs(id int) func(*models.WebhookDelivery) bool {
+	return func(d *models.WebhookDelivery) bool { return d.DeliveryID == id }
+}
+
+func (r memoryWebhooks) CreateSubscription(_ context.Context, sub *models.WebhookSubscription) error {
+	return r.m.do(func(d *memoryData) error {
+		sub.SubscriptionID = d.nextID("webhook_subscription")
+		d.webhookSubs = append(d.webhookSubs, *sub)
+		return nil
+	})
+}
+
+func (r memoryWebhooks) GetSubscription(_ context.Context, subscriptionID int) (*models.WebhookSubscription, error) {
+	return read(r.m, func(d *memoryData) (*models.WebhookSubscription, error) {
+		return firstMatch(d.webhookSubs, subscriptionIs(subscriptionID))
+	})
+}
+
+func (r memoryWebhooks) ListSubscriptions(_ context.Context, activeOnly bool) ([]models.WebhookSubscription, error) {
+	return read(r.m, func(d *memoryData) ([]models.WebhookSubscription, error) {
+		return filterRows(d.webhookSubs, func(s *models.WebhookSubscription) bool { return s.IsActive || !activeOnly }), nil
+	})
+}
+
+func (r memoryWebhooks) SaveSubscription(_ context.Context, sub *models.WebhookSubscription) error {
+	return r.m.do(func(d *memoryData) error {
+		return replaceRow(d.webhookSubs, subscriptionIs(sub.SubscriptionID), *sub)
+	})
+}
+
+// DeleteSubscription 전송과 시도 기록은 ON DELETE CASCADE
+func (r memoryWebhooks) DeleteSubscription(_ context.Context, subscriptionID int) error {
+	return r.m.do(func(d *memoryData) error {
+		if err := removeRows(&d.webhookSubs, subscriptionIs(subscriptionID)); err != nil {
+			return err
+		}
+		var removed []int
+		d.deliveries = slices.DeleteFunc(d.deliveries, func(w models.WebhookDelivery) bool {
+			if w.SubscriptionID != subscriptionID {
+				return false
+			}
+			removed = append(removed, w.DeliveryID)
+			return true
+		})
+		d.attempts = slices.DeleteFunc(d.attempts, func(a models.WebhookAttempt) bool {
+			return slices.Contains(removed, a.DeliveryID)
+		})
+		return nil
+	})
+}
+
+func (r memoryWebhooks) CreateDeliveries(_ context.Context, deliveries []models.WebhookDelivery) error {
+	return r.m.do(func(d *memoryData) error {
+		for i := range deliveries {
+			if !exists(d.webhookSubs, subscriptionIs(deliveries[i].SubscriptionID)) {
+				return ErrInvalidReference
+			}
+			deliveries[i].DeliveryID = d.nextID("webhook_delivery")
+			d.deliveries = append(d.deliveries, deliveries[i])
+		}
+		return nil
+	})
+}
+
+func (r memoryWebhooks) GetDelivery(_ context.Context, deliveryID int) (*models.WebhookDelivery, error) {
+	return read(r.m, func(d *memoryData) (*models.WebhookDelivery, error) {
+		return firstMatch(d.deliveries, deliveryIs(deliveryID))
+	})
+}
+
+func (r memoryWebhooks) ListDeliveries(_ context.Context, subscriptionID int, status string) ([]models.WebhookDelivery, error) {
+	return read(r.m, func(d *memoryData) ([]models.WebhookDelivery, error) {
+		rows := filterRows(d.deliveries, func(w *models.WebhookDelivery) bool {
+			return w.SubscriptionID == subscriptionID && (status == "" || w.Status == status)
+		})
+		slices.Reverse(rows)
+		return rows, nil
+	})
+}
+
+func (r memoryWebhooks) HasEvent(_ context.Context, eventID string) (bool, error) {
+	return read(r.m, func(d *memoryData) (bool, error) {
+		return exists(d.deliveries, func(w *models.WebhookDelivery) bool { return w.EventID == eventID }), nil
+	})
+}
+
+func (r memoryWebhooks) DueDeliveries(_ context.Context, at time.Time, limit int) ([]models.WebhookDelivery, error) {
+	return read(r.m, func(d *memoryData) ([]models.WebhookDelivery, error) {
+		rows := filterRows(d.deliveries, func(w *models.WebhookDelivery) bool {
+			return w.Status == models.WebhookDeliveryPending && !w.NextAttemptAt.After(at)
+		})
+		slices.SortStableFunc(rows, func(a, b models.WebhookDelivery) int { return a.NextAttemptAt.Compare(b.NextAttemptAt) })
+		return rows[:min(limit, len(rows))], nil
+	})
+}
+
+func (r memoryWebhooks) ClaimDelivery(_ context.Context, deliveryID int, at, until time.Time) (bool, error) {
+	return read(r.m, func(d *memoryData) (bool, error) {
+		i := slices.IndexFunc(d.deliveries, func(w models.WebhookDelivery) bool { return w.DeliveryID == deliveryID })
+		if i < 0 || d.deliveries[i].Status != models.WebhookDeliveryPending || d.deliveries[i].NextAttemptAt.After(at) {
+			return false, nil
+		}
+		d.deliveries[i].NextAttemptAt = until
+		return true, nil
+	})
+}
+
+func (r memoryWebhooks) ResetDelivery(_ context.Context, deliveryID int, until time.Time) (bool, error) {
+	return read(r.m, func(d *memoryData) (bool, error) {
+		i := slices.IndexFunc(d.deliveries, func(w models.WebhookDelivery) bool { return w.DeliveryID == deliveryID })
+		if i < 0 || d.deliveries[i].Status == models.WebhookDeliveryPending {
+			return false, nil
+		}
+		w := &d.deliveries[i]
+		w.Status, w.Attempts, w.NextAttemptAt, w.DeliveredAt = models.WebhookDeliveryPending, 0, until, nil
+		return true, nil
+	})
+}
+
+func (r memoryWebhooks) UpdateDelivery(_ context.Context, deliveryID int, columns map[string]any) error {
+	return r.m.do(func(d *memoryData) error {
+		i := slices.IndexFunc(d.deliveries, func(w models.WebhookDelivery) bool { return w.DeliveryID == deliveryID })
+		if i < 0 {
+			return nil // UPDATE 대상이 없어도 에러가 아님
+		}
+		return updateColumns(&d.deliveries[i], columns)
+	})
+}
+
+func (r memoryWebhooks) CreateAttempt(_ context.Context, attempt *models.WebhookAttempt) error {
+	return r.m.do(func(d *memoryData) error {
+		if !exists(d.deliveries, deliveryIs(attempt.DeliveryID)) {
+			return ErrInvalidReference
+		}
+		attempt.AttemptID = d.nextID("webhook_attempt")
+		d.attempts = append(d.attempts, *attempt)
+		return nil
+	})
+}
+
+func (r memoryWebhooks) ListAttempts(_ context.Context, deliveryID int) ([]models.WebhookAttempt, error) {
+	return read(r.m, func(d *memoryData) ([]models.WebhookAttempt, error) {
+		rows := filterRows(d.attempts, func(a *models.WebhookAttempt) bool { return a.DeliveryID == deliveryID })
+		slices.SortFunc(rows, func(a, b models.WebhookAttempt) int { return cmp.Compare(a.AttemptID, b.AttemptID) })
+		return rows, nil
+	})
+}
@@ -0,0 +1,257 @@
+// Package repository 는 서비스 계층이 사용하는 집계별 저장소 인터페이스와 그 구현을 제공합니다.
+//
+// 서비스는 Store 로 지역, 택배, 차량, 운행, 배송 기록, 직원, 근무, 웹훅 저장소에 접근하고,
+// 여러 저장소에 걸친 변경과 아웃박스 이벤트는 Store.Transaction 안에서 함께 커밋합니다.
+// NewGormStore 는 운영 DB(MySQL, PostgreSQL, SQLite)를, NewMemoryStore 는 DB 없이 서비스와 핸들러를 테스트하기 위한 구현을 반환합니다.
+// 두 구현은 같은 에러(ErrNotFound, ErrDuplicate, ErrInvalidReference, ErrInUse)를 반환하므로 apperror.Translate 가 그대로 동작합니다.
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/go-sql-driver/mysql"
+	"gorm.io/gorm"
+)
+
+// 저장소가 반환하는 에러. GORM 과 MySQL 드라이버의 에러 값을 그대로 사용
+var (
+	ErrNotFound         = gorm.ErrRecordNotFound
+	ErrDuplicate        = gorm.ErrDuplicatedKey
+	ErrInvalidReference = gorm.ErrForeignKeyViolated
+	// ErrInUse 다른 행이 참조하고 있어 삭제할 수 없음 (MySQL 1451 과 같은 값, GORM 저장소는 삭제 중 외래 키 위반을 이 값으로 바꿈)
+	ErrInUse = &mysql.MySQLError{Number: 1451, Message: "Cannot delete or update a parent row: a foreign key constraint fails"}
+)
+
+// Store 집계별 저장소와 트랜잭션
+type Store interface {
+	Regions() RegionRepository
+	Packages() PackageRepository
+	Vehicles() VehicleRepository
+	TripLogs() TripLogRepository
+	DeliveryLogs() DeliveryLogRepository
+	Employees() EmployeeRepository
+	Shifts() ShiftRepository
+	Webhooks() WebhookRepository
+	// Events 아웃박스 이벤트 기록. 도메인 변경과 함께 커밋되도록 Transaction 안에서 호출
+	Events() EventRecorder
+	// Outbox 기록된 아웃박스 행. outbox.Dispatcher 가 전달 상태를 관리할 때 사용
+	Outbox() outbox.Repository
+	// Transaction fn 이 에러를 반환하면 fn 안에서 tx 로 수행한 변경과 이벤트를 모두 되돌림
+	Transaction(ctx context.Context, fn func(tx Store) error) error
+	// Replica 조회를 읽기 전용 복제본으로 보내는 Store. 복제 지연이 있으므로 목록, 검색, 보고용 조회에만 사용하고
+	// 쓰기 직후 다시 읽는 경로는 주 DB 를 사용. 쓰기와 트랜잭션은 항상 주 DB 로 감
+	Replica() Store
+}
+
+// EventRecorder 도메인 이벤트를 아웃박스에 기록
+type EventRecorder interface {
+	Record(ctx context.Context, eventType, aggregateType, aggregateID string, data any) error
+}
+
+// Query 검색 조건
+type Query struct {
+	// Filters 컬럼 이름(REST 검색 파라미터와 같음)과 값. 날짜/시각 컬럼은 날짜(YYYY-MM-DD) 단위로 비교
+	Filters map[string]string
+	// Sort 정렬 컬럼. - 로 시작하면 내림차순, 허용되지 않은 컬럼이면 무시
+	Sort string
+	Page dto.PageRequest
+}
+
+type RegionRepository interface {
+	Create(ctx context.Context, region *models.Region) error
+	Get(ctx context.Context, regionID string) (*models.Region, error)
+	// GetMany 없는 ID 는 결과에서 빠짐
+	GetMany(ctx context.Context, regionIDs []string) ([]models.Region, error)
+	// Lock 트랜잭션이 끝날 때까지 지역 행을 잠가 적재량 변경을 직렬화
+	Lock(ctx context.Context, regionID string) (*models.Region, error)
+	Save(ctx context.Context, region *models.Region) error
+	Delete(ctx context.Context, regionID string) error
+	Find(ctx context.Context, q Query) ([]models.Region, error)
+	Count(ctx context.Context, filters map[string]string) (int64, error)
+}
+
+type PackageRepository interface {
+	Create(ctx context.Context, pkg *models.Package) error
+	Get(ctx context.Context, packageID int) (*models.Package, error)
+	GetMany(ctx context.Context, packageIDs []int) ([]models.Package, error)
+	// ListByRegions 패키지 ID 순
+	ListByRegions(ctx context.Context, regionIDs []string) ([]models.Package, error)
+	// Lock 트랜잭션이 끝날 때까지 패키지 행을 잠가 같은 패키지에 대한 상태 변경을 직렬화
+	Lock(ctx context.Context, packageID int) (*models.Package, error)
+	Save(ctx context.Context, pkg *models.Package) error
+	Delete(ctx context.Context, packageID int) error
+	Find(ctx context.Context, q Query) ([]models.Package, error)
+	Count(ctx context.Context, filters map[string]string) (int64, error)
+}
+
+type VehicleRepository interface {
+	Create(ctx context.Context, vehicle *models.Vehicle) error
+	Get(ctx context.Context, internalID int) (*models.Vehicle, error)
+	GetByVehicleID(ctx context.Context, vehicleID string) (*models.Vehicle, error)
+	GetMany(ctx context.Context, vehicleIDs []string) ([]models.Vehicle, error)
+	// Lock, LockByVehicleID 트랜잭션이 끝날 때까지 차량 행을 잠가 같은 차량에 대한 변경을 직렬화
+	Lock(ctx context.Context, internalID int) (*models.Vehicle, error)
+	LockByVehicleID(ctx context.Context, vehicleID string) (*models.Vehicle, error)
+	Save(ctx context.Context, vehicle *models.Vehicle) error
+	Delete(ctx context.Context, internalID int) error
+	Find(ctx context.Context, q Query) ([]models.Vehicle, error)
+	Count(ctx context.Context, filters map[string]string) (int64, error)
+
+	CreateConfirmation(ctx context.Context, conf *models.VehicleConfirmation) error
+	GetConfirmation(ctx context.Context, vehicleID string, confirmationID int) (*models.VehicleConfirmation, error)
+	SaveConfirmation(ctx context.Context, conf *models.VehicleConfirmation) error
+	// ListConfirmations 최근 요청부터. status 가 비어 있으면 전체
+	ListConfirmations(ctx context.Context, vehicleID, status string) ([]models.VehicleConfirmation, error)
+	// PendingConfirmations 처리되지 않은 요청을 오래된 순으로
+	PendingConfirmations(ctx context.Context, vehicleID string) ([]models.VehicleConfirmation, error)
+}
+
+type TripLogRepository interface {
+	Create(ctx context.Context, trip *models.TripLog) error
+	Get(ctx context.Context, tripID int) (*models.TripLog, error)
+	GetMany(ctx context.Context, tripIDs []int) ([]models.TripLog, error)
+	// ListByVehicles 운행 ID 순
+	ListByVehicles(ctx context.Context, vehicleIDs []string) ([]models.TripLog, error)
+	// ListByDriver, ListBByDriver 직원이 운전한 A/B 차량 운행을 최근 운행부터
+	ListByDriver(ctx context.Context, driverID int) ([]models.TripLog, error)
+	ListBByDriver(ctx context.Context, driverID int) ([]models.TripLogB, error)
+	Save(ctx context.Context, trip *models.TripLog) error
+	Delete(ctx context.Context, tripID int) error
+	Find(ctx context.Context, q Query) ([]models.TripLog, error)
+	Count(ctx context.Context, filters map[string]string) (int64, error)
+}
+
+// DeliveryLogRepository 배송 기록은 운행 ID 와 패키지 ID 로 식별
+type DeliveryLogRepository interface {
+	Create(ctx context.Context, log *models.DeliveryLog) error
+	// GetByTrip 운행의 첫 번째 배송 기록
+	GetByTrip(ctx context.Context, tripID int) (*models.DeliveryLog, error)
+	Save(ctx context.Context, log *models.DeliveryLog) error
+	// DeleteByTrip 운행의 배송 기록을 모두 삭제
+	DeleteByTrip(ctx context.Context, tripID int) error
+	// ListByPackages, ListByTrips 운행 ID, 적재 순서 순
+	ListByPackages(ctx context.Context, packageIDs []int) ([]models.DeliveryLog, error)
+	ListByTrips(ctx context.Context, tripIDs []int) ([]models.DeliveryLog, error)
+	Find(ctx context.Context, q Query) ([]models.DeliveryLog, error)
+	Count(ctx context.Context, filters map[string]string) (int64, error)
+}
+
+type EmployeeRepository interface {
+	Create(ctx context.Context, emp *models.Employee) error
+	Get(ctx context.Context, employeeID int) (*models.Employee, error)
+	GetByUsername(ctx context.Context, username string) (*models.Employee, error)
+	GetMany(ctx context.Context, employeeIDs []int) ([]models.Employee, error)
+	// Lock 트랜잭션이 끝날 때까지 직원 행을 잠금
+	Lock(ctx context.Context, employeeID int) (*models.Employee, error)
+	Save(ctx context.Context, emp *models.Employee) error
+	// Update 지정한 컬럼만 변경. 다른 요청이 바꾼 컬럼을 덮어쓰지 않아야 할 때 사용
+	Update(ctx context.Context, employeeID int, columns map[string]any) error
+	// IncrementFailedLogins 연속 로그인 실패 횟수를 원자적으로 1 증가시키고 증가한 값을 반환
+	IncrementFailedLogins(ctx context.Context, employeeID int) (int, error)
+	Delete(ctx context.Context, employeeID int) error
+	// Find name 필터는 부분 일치
+	Find(ctx context.Context, q Query) ([]models.Employee, error)
+
+	CreateResetToken(ctx context.Context, token *models.PasswordResetToken) error
+	GetResetToken(ctx context.Context, tokenHash string) (*models.PasswordResetToken, error)
+	// ExpireResetTokens 직원의 사용되지 않은 토큰을 at 시각에 만료
+	ExpireResetTokens(ctx context.Context, employeeID int, at time.Time) error
+	// UseResetToken 사용되지 않았고 만료되지 않은 토큰만 사용 처리. 동시에 같은 토큰을 쓰면 하나만 true
+	UseResetToken(ctx context.Context, tokenID int, at time.Time) (bool, error)
+}
+
+type ShiftRepository interface {
+	Create(ctx context.Context, shift *models.Shift) error
+	Get(ctx context.Context, shiftID int) (*models.Shift, error)
+	// List 최근 시작한 근무부터. activeAt 이 주어지면 그 시각에 진행 중인 근무만
+	List(ctx context.Context, filters map[string]string, activeAt *time.Time) ([]models.Shift, error)
+	// FindOverlap [start, end) 구간에 같은 차량 또는 같은 직원이 배정된 근무. end 가 nil 이면 끝나지 않은 구간
+	FindOverlap(ctx context.Context, vehicleID string, employeeID int, start time.Time, end *time.Time) (*models.Shift, error)
+	// ActiveForEmployee, ActiveForVehicle at 시각에 진행 중인 근무
+	ActiveForEmployee(ctx context.Context, employeeID int, at time.Time) (*models.Shift, error)
+	ActiveForVehicle(ctx context.Context, vehicleID string, at time.Time) (*models.Shift, error)
+	Save(ctx context.Context, shift *models.Shift) error
+	Delete(ctx context.Context, shiftID int) error
+}
+
+// WebhookRepository 웹훅 구독과 전송 큐. 대기 중인 전송 행이 곧 재시도 큐
+type WebhookRepository interface {
+	CreateSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	GetSubscription(ctx context.Context, subscriptionID int) (*models.WebhookSubscription, error)
+	// ListSubscriptions 구독 ID 순. activeOnly 면 활성 구독만
+	ListSubscriptions(ctx context.Context, activeOnly bool) ([]models.WebhookSubscription, error)
+	SaveSubscription(ctx context.Context, sub *models.WebhookSubscription) error
+	// DeleteSubscription 전송과 시도 기록도 함께 삭제
+	DeleteSubscription(ctx context.Context, subscriptionID int) error
+
+	CreateDeliveries(ctx context.Context, deliveries []models.WebhookDelivery) error
+	GetDelivery(ctx context.Context, deliveryID int) (*models.WebhookDelivery, error)
+	// ListDeliveries 최근 전송부터. status 가 비어 있으면 전체
+	ListDeliveries(ctx context.Context, subscriptionID int, status string) ([]models.WebhookDelivery, error)
+	// HasEvent 이벤트의 전송이 이미 큐에 들어갔는지 여부
+	HasEvent(ctx context.Context, eventID string) (bool, error)
+	// DueDeliveries at 시각까지 전송할 대기 행을 다음 시도 시각 순으로 최대 limit 개
+	DueDeliveries(ctx context.Context, at time.Time, limit int) ([]models.WebhookDelivery, error)
+	// ClaimDelivery 대기 중이고 at 시각까지 전송할 행만 다음 시도 시각을 until 로 미룸. 동시에 시도하면 하나만 true
+	ClaimDelivery(ctx context.Context, deliveryID int, at, until time.Time) (bool, error)
+	// ResetDelivery 성공 또는 실패로 끝난 전송만 대기 상태로 되돌리고 시도 횟수를 초기화. 다음 시도 시각은 until.
+	// 대기 중이거나 동시에 다른 요청이 먼저 되돌렸으면 false
+	ResetDelivery(ctx context.Context, deliveryID int, until time.Time) (bool, error)
+	// UpdateDelivery 지정한 컬럼만 변경
+	UpdateDelivery(ctx context.Context, deliveryID int, columns map[string]any) error
+
+	CreateAttempt(ctx context.Context, attempt *models.WebhookAttempt) error
+	// ListAttempts 시도 순
+	ListAttempts(ctx context.Context, deliveryID int) ([]models.WebhookAttempt, error)
+}
+
+// 정렬 가능한 컬럼
+var (
+	regionSortFields      = columns("region_id", "region_name", "coord_x", "coord_y", "max_capacity", "current_capacity", "is_full", "saturated_at")
+	packageSortFields     = columns("package_id", "package_type", "region_id", "package_status", "registered_at")
+	vehicleSortFields     = columns("internal_id", "vehicle_id", "current_load", "max_load", "led_status", "needs_confirmation", "coord_x", "coord_y")
+	tripLogSortFields     = columns("trip_id", "vehicle_id", "driver_id", "start_time", "end_time", "status", "destination")
+	deliveryLogSortFields = columns("trip_id", "package_id", "region_id", "load_order", "registered_at",
+		"first_transport_time", "input_time", "second_transport_time", "completed_at")
+	employeeSortFields = columns("employee_id", "position", "is_active", "name", "username", "hire_date")
+)
+
+// 날짜 단위로 비교하는 필터 컬럼
+var (
+	regionDateFields      = columns("saturated_at")
+	packageDateFields     = columns("registered_at")
+	tripLogDateFields     = columns("start_time", "end_time")
+	deliveryLogDateFields = columns("registered_at", "first_transport_time", "input_time", "second_transport_time", "completed_at")
+)
+
+// 불리언 필터 컬럼. 이 컬럼만 true/false 를 bool 로 바인딩하고 나머지는 문자열 그대로 비교
+var (
+	regionBoolFields   = columns("is_full")
+	vehicleBoolFields  = columns("needs_confirmation")
+	employeeBoolFields = columns("is_active")
+)
+
+func columns(names ...string) map[string]bool {
+	m := make(map[string]bool, len(names))
+	for _, n := range names {
+		m[n] = true
+	}
+	return m
+}
+
+// parseSort 정렬 파라미터를 컬럼과 방향으로. 허용되지 않은 컬럼이면 ok 가 false
+func parseSort(sort string, allowed map[string]bool) (field string, desc, ok bool) {
+	if sort == "" {
+		return "", false, false
+	}
+	field = sort
+	if sort[0] == '-' {
+		field = sort[1:]
+		desc = true
+	}
+	return field, desc, allowed[field]
+}
@@ -3,36 +3,256 @@ package service
 import (
 	"context"
 	"errors"
+	"sync"
+	"time"
 
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/config"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
 	"github.com/baboyiban/go-api-server/utils"
-	"gorm.io/gorm"
 )
 
 type AuthService struct {
-	db *gorm.DB
+	store         repository.Store
+	status        *EmployeeStatusCache
+	lockout       config.LockoutConfig
+	resetTokenTTL time.Duration
+	now           func() time.Time
 }
 
-func (s *AuthService) DB() *gorm.DB {
-	return s.db
+func NewAuthService(store repository.Store, status *EmployeeStatusCache, cfg config.AuthConfig) *AuthService {
+	return &AuthService{
+		store:         store,
+		status:        status,
+		lockout:       cfg.Lockout,
+		resetTokenTTL: cfg.Password.ResetTokenTTL,
+		now:           time.Now,
+	}
 }
 
-func NewAuthService(db *gorm.DB) *AuthService {
-	return &AuthService{db: db}
-}
+// dummyHash 존재하지 않는 직원으로 로그인할 때도 bcrypt 비교를 수행해 응답 시간으로 계정 존재 여부가 드러나지 않게 함
+var dummyHash = sync.OnceValue(func() string {
+	hash, _ := utils.HashPassword("dummy-password-for-timing")
+	return hash
+})
 
-func (s *AuthService) Login(ctx context.Context, req dto.LoginRequest) (string, *models.Employee, error) {
-	var emp models.Employee
-	if err := s.db.WithContext(ctx).Where("employee_id = ?", req.EmployeeID).First(&emp).Error; err != nil {
+func (s *AuthService) Login(ctx context.Context, req dto.LoginRequest) (string, *dto.EmployeeResponse, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.Login")
+	defer span.End()
+	var emp *models.Employee
+	var err error
+	if req.Username != "" {
+		emp, err = s.store.Employees().GetByUsername(ctx, req.Username)
+	} else {
+		emp, err = s.store.Employees().Get(ctx, req.EmployeeID)
+	}
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			utils.CheckPasswordHash(req.Password, dummyHash())
+			return "", nil, apperror.ErrInvalidCredentials
+		}
 		return "", nil, err
 	}
+
+	now := s.now()
+	if emp.LockedUntil != nil && emp.LockedUntil.After(now) {
+		retryAfter := emp.LockedUntil.Sub(now)
+		return "", nil, apperror.ErrAccountLocked.
+			WithDetail("too many failed login attempts, retry after %s", retryAfter.Round(time.Second)).
+			WithRetryAfter(retryAfter)
+	}
+
 	if !utils.CheckPasswordHash(req.Password, emp.Password) {
-		return "", nil, errors.New("invalid password")
+		if err := s.recordFailedLogin(ctx, emp.EmployeeID, now); err != nil {
+			return "", nil, err
+		}
+		return "", nil, apperror.ErrInvalidCredentials
+	}
+
+	if !emp.IsActive {
+		return "", nil, apperror.ErrAccountDisabled
+	}
+
+	updates := map[string]any{}
+	if emp.FailedLoginCount > 0 || emp.LockedUntil != nil {
+		updates["failed_login_count"] = 0
+		updates["locked_until"] = nil
+	}
+	// 이전 cost 로 저장된 해시는 평문을 알고 있는 지금 새 cost 로 교체
+	if utils.NeedsRehash(emp.Password) {
+		if hash, err := utils.HashPassword(req.Password); err == nil {
+			updates["password"] = hash
+		}
 	}
-	token, err := utils.GenerateJWT(emp.EmployeeID, emp.Position)
+	if len(updates) > 0 {
+		if err := s.store.Employees().Update(ctx, emp.EmployeeID, updates); err != nil {
+			return "", nil, err
+		}
+		emp.FailedLoginCount = 0
+		emp.LockedUntil = nil
+	}
+
+	token, err := utils.GenerateJWT(emp.EmployeeID, emp.Position, emp.TokenVersion)
 	if err != nil {
 		return "", nil, err
 	}
-	return token, &emp, nil
+	return token, toEmployeeResponse(emp), nil
+}
+
+// recordFailedLogin 실패 횟수를 원자적으로 증가시키고, 임계값을 넘으면 잠금 시각을 기록
+func (s *AuthService) recordFailedLogin(ctx context.Context, employeeID int, now time.Time) error {
+	return s.store.Transaction(ctx, func(tx repository.Store) error {
+		count, err := tx.Employees().IncrementFailedLogins(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		d := s.lockoutDuration(count)
+		if d == 0 {
+			return nil
+		}
+		return tx.Employees().Update(ctx, employeeID, map[string]any{"locked_until": now.Add(d)})
+	})
+}
+
+// lockoutDuration 연속 실패 횟수에 따른 잠금 시간 (지수 백오프, 최대값 제한)
+func (s *AuthService) lockoutDuration(failures int) time.Duration {
+	p := s.lockout
+	if p.MaxFailedLogins <= 0 || failures < p.MaxFailedLogins {
+		return 0
+	}
+	d := p.BaseDuration
+	for i := p.MaxFailedLogins; i < failures; i++ {
+		d *= 2
+		if d >= p.MaxDuration {
+			return p.MaxDuration
+		}
+	}
+	return min(d, p.MaxDuration)
+}
+
+// Me 로그인한 직원 정보
+func (s *AuthService) Me(ctx context.Context, employeeID int) (*dto.EmployeeResponse, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.Me")
+	defer span.End()
+	emp, err := s.store.Employees().Get(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	return toEmployeeResponse(emp), nil
+}
+
+// ChangePassword 현재 비밀번호를 확인한 뒤 본인의 비밀번호를 변경
+func (s *AuthService) ChangePassword(ctx context.Context, employeeID int, req dto.ChangePasswordRequest) error {
+	ctx, span := tracer.Start(ctx, "AuthService.ChangePassword")
+	defer span.End()
+	emp, err := s.store.Employees().Get(ctx, employeeID)
+	if err != nil {
+		return err
+	}
+	if !utils.CheckPasswordHash(req.OldPassword, emp.Password) {
+		return apperror.Validation([]apperror.FieldError{{
+			Field: "old_password", Rule: "mismatch", Message: "does not match the current password",
+		}})
+	}
+	if req.OldPassword == req.NewPassword {
+		return apperror.Validation([]apperror.FieldError{{
+			Field: "new_password", Rule: "nefield", Message: "must differ from the current password",
+		}})
+	}
+	hash, err := hashNewPassword("new_password", req.NewPassword)
+	if err != nil {
+		return err
+	}
+	// 토큰 버전을 올려 다른 기기의 기존 토큰과 세션을 무효화
+	err = s.store.Transaction(ctx, func(tx repository.Store) error {
+		emp, err := tx.Employees().Lock(ctx, employeeID)
+		if err != nil {
+			return err
+		}
+		return tx.Employees().Update(ctx, employeeID, map[string]any{"password": hash, "token_version": emp.TokenVersion + 1})
+	})
+	if err != nil {
+		return err
+	}
+	s.status.Invalidate(employeeID)
+	return nil
+}
+
+// IssueResetToken 관리자가 직원의 일회용 비밀번호 재설정 토큰을 발급. 기존에 발급된 미사용 토큰은 무효화
+func (s *AuthService) IssueResetToken(ctx context.Context, employeeID, issuedBy int) (*dto.PasswordResetTokenResponse, error) {
+	ctx, span := tracer.Start(ctx, "AuthService.IssueResetToken")
+	defer span.End()
+	token, hash, err := newResetToken()
+	if err != nil {
+		return nil, err
+	}
+	now := s.now()
+	rt := models.PasswordResetToken{
+		EmployeeID: employeeID,
+		TokenHash:  hash,
+		IssuedBy:   issuedBy,
+		ExpiresAt:  now.Add(s.resetTokenTTL),
+		CreatedAt:  now,
+	}
+	err = s.store.Transaction(ctx, func(tx repository.Store) error {
+		if _, err := tx.Employees().Get(ctx, employeeID); err != nil {
+			return err
+		}
+		if err := tx.Employees().ExpireResetTokens(ctx, employeeID, now); err != nil {
+			return err
+		}
+		return tx.Employees().CreateResetToken(ctx, &rt)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &dto.PasswordResetTokenResponse{
+		EmployeeID: employeeID,
+		Token:      token,
+		ExpiresAt:  rt.ExpiresAt,
+	}, nil
+}
+
+// ResetPassword 재설정 토큰으로 비밀번호를 변경하고 토큰을 사용 처리. 계정 잠금도 함께 해제
+func (s *AuthService) ResetPassword(ctx context.Context, req dto.ResetPasswordRequest) error {
+	ctx, span := tracer.Start(ctx, "AuthService.ResetPassword")
+	defer span.End()
+	hash, err := hashNewPassword("new_password", req.NewPassword)
+	if err != nil {
+		return err
+	}
+	now := s.now()
+	var employeeID int
+	err = s.store.Transaction(ctx, func(tx repository.Store) error {
+		// 사용 처리를 조건부 UPDATE 로 먼저 수행해 같은 토큰의 동시 사용을 막음
+		rt, err := tx.Employees().GetResetToken(ctx, hashResetToken(req.Token))
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return apperror.ErrInvalidResetToken
+			}
+			return err
+		}
+		used, err := tx.Employees().UseResetToken(ctx, rt.TokenID, now)
+		if err != nil {
+			return err
+		}
+		if !used {
+			return apperror.ErrInvalidResetToken
+		}
+		emp, err := tx.Employees().Lock(ctx, rt.EmployeeID)
+		if err != nil {
+			return err
+		}
+		employeeID = emp.EmployeeID
+		return tx.Employees().Update(ctx, emp.EmployeeID, map[string]any{
+			"password": hash, "failed_login_count": 0, "locked_until": nil, "token_version": emp.TokenVersion + 1,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	s.status.Invalidate(employeeID)
+	return nil
 }
@@ -0,0 +1,208 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/utils"
+)
+
+const testPassword = "correct-horse"
+
+// newAuthService 시계를 고정한 AuthService. 반환된 함수로 시간을 앞당김
+func newAuthService(t *testing.T) (*AuthService, *repository.MemoryStore, func(time.Duration)) {
+	hash, err := utils.HashPassword(testPassword)
+	if err != nil {
+		t.Fatal(err)
+	}
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Employee{Password: hash, Position: "관리직", IsActive: true, Name: "Kim", Username: ptr("admin")},
+		&models.Employee{Password: hash, Position: driverPosition, IsActive: false, Name: "Lee"})
+	cfg := config.AuthConfig{
+		Lockout:  config.LockoutConfig{MaxFailedLogins: 3, BaseDuration: time.Minute, MaxDuration: 4 * time.Minute},
+		Password: config.PasswordConfig{ResetTokenTTL: time.Hour},
+	}
+	svc := NewAuthService(store, NewEmployeeStatusCache(store, time.Minute), cfg)
+	now := time.Date(2026, 3, 2, 9, 0, 0, 0, time.UTC)
+	svc.now = func() time.Time { return now }
+	return svc, store, func(d time.Duration) { now = now.Add(d) }
+}
+
+func TestAuthService_Login(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    dto.LoginRequest
+		status int
+	}{
+		{"by employee id", dto.LoginRequest{EmployeeID: 1, Password: testPassword}, http.StatusOK},
+		{"by username", dto.LoginRequest{Username: "admin", Password: testPassword}, http.StatusOK},
+		{"wrong password", dto.LoginRequest{EmployeeID: 1, Password: "wrong-password"}, http.StatusUnauthorized},
+		{"unknown employee", dto.LoginRequest{EmployeeID: 99, Password: testPassword}, http.StatusUnauthorized},
+		{"unknown username", dto.LoginRequest{Username: "nobody", Password: testPassword}, http.StatusUnauthorized},
+		{"deactivated", dto.LoginRequest{EmployeeID: 2, Password: testPassword}, http.StatusForbidden},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newAuthService(t)
+			token, emp, err := svc.Login(context.Background(), tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			claims, err := utils.ParseJWT(token)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if claims["position"] != emp.Position {
+				t.Errorf("claims = %v, employee = %+v", claims, emp)
+			}
+		})
+	}
+}
+
+func TestAuthService_Lockout(t *testing.T) {
+	svc, store, advance := newAuthService(t)
+	ctx := context.Background()
+	wrong := dto.LoginRequest{EmployeeID: 1, Password: "wrong-password"}
+	right := dto.LoginRequest{EmployeeID: 1, Password: testPassword}
+
+	steps := []struct {
+		name    string
+		advance time.Duration
+		req     dto.LoginRequest
+		status  int
+	}{
+		{"first failure", 0, wrong, http.StatusUnauthorized},
+		{"second failure", 0, wrong, http.StatusUnauthorized},
+		{"third failure locks", 0, wrong, http.StatusUnauthorized},
+		{"locked even with right password", 30 * time.Second, right, http.StatusTooManyRequests},
+		{"unlocked after base duration", time.Minute, right, http.StatusOK},
+		{"counter was reset", 0, wrong, http.StatusUnauthorized},
+	}
+	for _, step := range steps {
+		advance(step.advance)
+		_, _, err := svc.Login(ctx, step.req)
+		if got := statusOf(err); got != step.status {
+			t.Fatalf("%s: status = %d, want %d (err %v)", step.name, got, step.status, err)
+		}
+	}
+	emp, _ := store.Employees().Get(ctx, 1)
+	if emp.FailedLoginCount != 1 || emp.LockedUntil != nil {
+		t.Errorf("failed_login_count = %d, locked_until = %v", emp.FailedLoginCount, emp.LockedUntil)
+	}
+}
+
+func TestAuthService_LockoutDuration(t *testing.T) {
+	svc := &AuthService{lockout: config.LockoutConfig{MaxFailedLogins: 3, BaseDuration: time.Minute, MaxDuration: 4 * time.Minute}}
+	tests := []struct {
+		failures int
+		want     time.Duration
+	}{
+		{2, 0},
+		{3, time.Minute},
+		{4, 2 * time.Minute},
+		{5, 4 * time.Minute},
+		{10, 4 * time.Minute},
+	}
+	for _, tt := range tests {
+		if got := svc.lockoutDuration(tt.failures); got != tt.want {
+			t.Errorf("lockoutDuration(%d) = %s, want %s", tt.failures, got, tt.want)
+		}
+	}
+}
+
+func TestAuthService_ChangePassword(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    dto.ChangePasswordRequest
+		status int
+	}{
+		{"changed", dto.ChangePasswordRequest{OldPassword: testPassword, NewPassword: "battery-staple"}, http.StatusOK},
+		{"wrong old password", dto.ChangePasswordRequest{OldPassword: "wrong-password", NewPassword: "battery-staple"}, http.StatusBadRequest},
+		{"same password", dto.ChangePasswordRequest{OldPassword: testPassword, NewPassword: testPassword}, http.StatusBadRequest},
+		{"weak new password", dto.ChangePasswordRequest{OldPassword: testPassword, NewPassword: "short"}, http.StatusBadRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newAuthService(t)
+			if _, err := svc.status.Status(context.Background(), 1); err != nil {
+				t.Fatal(err)
+			}
+			err := svc.ChangePassword(context.Background(), 1, tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			want, wantVersion := testPassword, 0
+			if err == nil {
+				want, wantVersion = tt.req.NewPassword, 1
+			}
+			// 캐시된 상태가 무효화되어 기존 토큰이 거부되어야 함
+			if status, _ := svc.status.Status(context.Background(), 1); status.TokenVersion != wantVersion {
+				t.Errorf("token version = %d, want %d", status.TokenVersion, wantVersion)
+			}
+			if _, _, err := svc.Login(context.Background(), dto.LoginRequest{EmployeeID: 1, Password: want}); err != nil {
+				t.Errorf("login with %q: %v", want, err)
+			}
+		})
+	}
+}
+
+func TestAuthService_ResetPassword(t *testing.T) {
+	ctx := context.Background()
+	svc, _, advance := newAuthService(t)
+
+	first, err := svc.IssueResetToken(ctx, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	second, err := svc.IssueResetToken(ctx, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := svc.IssueResetToken(ctx, 99, 1); statusOf(err) != http.StatusNotFound {
+		t.Errorf("issue for missing employee: err = %v", err)
+	}
+
+	steps := []struct {
+		name    string
+		advance time.Duration
+		token   string
+		status  int
+	}{
+		{"replaced token", 0, first.Token, http.StatusBadRequest},
+		{"unknown token", 0, "not-a-token", http.StatusBadRequest},
+		{"valid token", time.Minute, second.Token, http.StatusOK},
+		{"reused token", 0, second.Token, http.StatusBadRequest},
+	}
+	for _, step := range steps {
+		advance(step.advance)
+		err := svc.ResetPassword(ctx, dto.ResetPasswordRequest{Token: step.token, NewPassword: "battery-staple"})
+		if got := statusOf(err); got != step.status {
+			t.Fatalf("%s: status = %d, want %d (err %v)", step.name, got, step.status, err)
+		}
+	}
+	if _, _, err := svc.Login(ctx, dto.LoginRequest{EmployeeID: 1, Password: "battery-staple"}); err != nil {
+		t.Errorf("login with reset password: %v", err)
+	}
+	if status, _ := svc.status.Status(ctx, 1); status.TokenVersion != 1 {
+		t.Errorf("token version after reset = %d, want 1", status.TokenVersion)
+	}
+
+	expired, err := svc.IssueResetToken(ctx, 1, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	advance(2 * time.Hour)
+	if err := svc.ResetPassword(ctx, dto.ResetPasswordRequest{Token: expired.Token, NewPassword: "another-pass"}); statusOf(err) != http.StatusBadRequest {
+		t.Errorf("expired token: err = %v", err)
+	}
+}
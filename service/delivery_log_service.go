@@ -6,53 +6,21 @@ import (
 
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
 	"github.com/baboyiban/go-api-server/utils"
-	"gorm.io/gorm"
 )
 
-var allowedDeliveryLogSortFields = map[string]bool{
-	"trip_id":               true,
-	"package_id":            true,
-	"region_id":             true,
-	"load_order":            true,
-	"registered_at":         true,
-	"first_transport_time":  true,
-	"input_time":            true,
-	"second_transport_time": true,
-	"completed_at":          true,
-}
-
-func applyDeliveryLogSort(query *gorm.DB, sort string) *gorm.DB {
-	if sort == "" {
-		return query
-	}
-	field := sort
-	desc := false
-	if sort[0] == '-' {
-		field = sort[1:]
-		desc = true
-	}
-	if allowedDeliveryLogSortFields[field] {
-		order := field
-		if desc {
-			order += " DESC"
-		} else {
-			order += " ASC"
-		}
-		return query.Order(order)
-	}
-	return query
-}
-
 type DeliveryLogService struct {
-	db *gorm.DB
+	store repository.Store
 }
 
-func NewDeliveryLogService(db *gorm.DB) *DeliveryLogService {
-	return &DeliveryLogService{db: db}
+func NewDeliveryLogService(store repository.Store) *DeliveryLogService {
+	return &DeliveryLogService{store: store}
 }
 
 func (s *DeliveryLogService) CreateDeliveryLog(ctx context.Context, req dto.CreateDeliveryLogRequest) (*dto.DeliveryLogResponse, error) {
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.CreateDeliveryLog")
+	defer span.End()
 	log := models.DeliveryLog{
 		TripID:              req.TripID,
 		PackageID:           req.PackageID,
@@ -69,34 +37,33 @@ func (s *DeliveryLogService) CreateDeliveryLog(ctx context.Context, req dto.Crea
 			log.RegisteredAt = *t
 		}
 	}
-	if err := s.db.WithContext(ctx).Create(&log).Error; err != nil {
+	if err := s.store.DeliveryLogs().Create(ctx, &log); err != nil {
 		return nil, err
 	}
 	return toDeliveryLogResponse(&log), nil
 }
 
 func (s *DeliveryLogService) GetDeliveryLogByID(ctx context.Context, tripID int) (*dto.DeliveryLogResponse, error) {
-	var log models.DeliveryLog
-	if err := s.db.WithContext(ctx).Where("trip_id = ?", tripID).First(&log).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.GetDeliveryLogByID")
+	defer span.End()
+	log, err := s.store.DeliveryLogs().GetByTrip(ctx, tripID)
+	if err != nil {
 		return nil, err
 	}
-	return toDeliveryLogResponse(&log), nil
+	return toDeliveryLogResponse(log), nil
 }
 
 func (s *DeliveryLogService) DeleteDeliveryLog(ctx context.Context, tripID int) error {
-	result := s.db.WithContext(ctx).Where("trip_id = ?", tripID).Delete(&models.DeliveryLog{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return nil
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.DeleteDeliveryLog")
+	defer span.End()
+	return s.store.DeliveryLogs().DeleteByTrip(ctx, tripID)
 }
 
 func (s *DeliveryLogService) UpdateDeliveryLog(ctx context.Context, tripID int, req dto.UpdateDeliveryLogRequest) (*dto.DeliveryLogResponse, error) {
-	var log models.DeliveryLog
-	if err := s.db.WithContext(ctx).Where("trip_id = ?", tripID).First(&log).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.UpdateDeliveryLog")
+	defer span.End()
+	log, err := s.store.DeliveryLogs().GetByTrip(ctx, tripID)
+	if err != nil {
 		return nil, err
 	}
 	log.LoadOrder = req.LoadOrder
@@ -109,17 +76,17 @@ func (s *DeliveryLogService) UpdateDeliveryLog(ctx context.Context, tripID int,
 	log.InputTime = utils.ParseTimePtr(req.InputTime)
 	log.SecondTransportTime = utils.ParseTimePtr(req.SecondTransportTime)
 	log.CompletedAt = utils.ParseTimePtr(req.CompletedAt)
-	if err := s.db.WithContext(ctx).Save(&log).Error; err != nil {
+	if err := s.store.DeliveryLogs().Save(ctx, log); err != nil {
 		return nil, err
 	}
-	return toDeliveryLogResponse(&log), nil
+	return toDeliveryLogResponse(log), nil
 }
 
 func (s *DeliveryLogService) ListDeliveryLogs(ctx context.Context, sort string) ([]dto.DeliveryLogResponse, error) {
-	var logs []models.DeliveryLog
-	query := s.db.WithContext(ctx).Model(&models.DeliveryLog{})
-	query = applyDeliveryLogSort(query, sort)
-	if err := query.Find(&logs).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.ListDeliveryLogs")
+	defer span.End()
+	logs, err := s.store.Replica().DeliveryLogs().Find(ctx, repository.Query{Sort: sort})
+	if err != nil {
 		return nil, err
 	}
 	var res []dto.DeliveryLogResponse
@@ -130,34 +97,58 @@ func (s *DeliveryLogService) ListDeliveryLogs(ctx context.Context, sort string)
 }
 
 func (s *DeliveryLogService) SearchDeliveryLogs(ctx context.Context, params map[string]string, sort string) ([]dto.DeliveryLogResponse, error) {
-	var logs []models.DeliveryLog
-	query := s.db.WithContext(ctx).Model(&models.DeliveryLog{})
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.SearchDeliveryLogs")
+	defer span.End()
+	logs, err := s.store.Replica().DeliveryLogs().Find(ctx, repository.Query{Filters: params, Sort: sort})
+	if err != nil {
+		return nil, err
+	}
+	var res []dto.DeliveryLogResponse
+	for _, l := range logs {
+		res = append(res, *toDeliveryLogResponse(&l))
+	}
+	return res, nil
+}
 
-	dateFields := map[string]bool{
-		"registered_at":         true,
-		"first_transport_time":  true,
-		"input_time":            true,
-		"second_transport_time": true,
-		"completed_at":          true,
+// PageDeliveryLogs SearchDeliveryLogs 와 같은 조건으로 한 페이지와 전체 개수를 조회
+func (s *DeliveryLogService) PageDeliveryLogs(ctx context.Context, params map[string]string, sort string, page dto.PageRequest) ([]dto.DeliveryLogResponse, int64, error) {
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.PageDeliveryLogs")
+	defer span.End()
+	logs, total, err := paginate(ctx, s.store.Replica().DeliveryLogs(), repository.Query{Filters: params, Sort: sort, Page: page})
+	if err != nil {
+		return nil, 0, err
 	}
+	return toDeliveryLogResponses(logs), total, nil
+}
 
-	for k, v := range params {
-		if dateFields[k] {
-			query = query.Where("DATE("+k+") = ?", v)
-		} else {
-			query = query.Where(k+" = ?", v)
-		}
+// ListDeliveryLogsByPackages 여러 패키지의 배송 기록을 한 번에 조회
+func (s *DeliveryLogService) ListDeliveryLogsByPackages(ctx context.Context, packageIDs []int) ([]dto.DeliveryLogResponse, error) {
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.ListDeliveryLogsByPackages")
+	defer span.End()
+	logs, err := s.store.DeliveryLogs().ListByPackages(ctx, packageIDs)
+	if err != nil {
+		return nil, err
 	}
+	return toDeliveryLogResponses(logs), nil
+}
 
-	query = applyDeliveryLogSort(query, sort)
-	if err := query.Find(&logs).Error; err != nil {
+// ListDeliveryLogsByTrips 여러 운행의 배송 기록을 적재 순서대로 한 번에 조회
+func (s *DeliveryLogService) ListDeliveryLogsByTrips(ctx context.Context, tripIDs []int) ([]dto.DeliveryLogResponse, error) {
+	ctx, span := tracer.Start(ctx, "DeliveryLogService.ListDeliveryLogsByTrips")
+	defer span.End()
+	logs, err := s.store.DeliveryLogs().ListByTrips(ctx, tripIDs)
+	if err != nil {
 		return nil, err
 	}
-	var res []dto.DeliveryLogResponse
+	return toDeliveryLogResponses(logs), nil
+}
+
+func toDeliveryLogResponses(logs []models.DeliveryLog) []dto.DeliveryLogResponse {
+	res := make([]dto.DeliveryLogResponse, 0, len(logs))
 	for _, l := range logs {
 		res = append(res, *toDeliveryLogResponse(&l))
 	}
-	return res, nil
+	return res
 }
 
 func toDeliveryLogResponse(m *models.DeliveryLog) *dto.DeliveryLogResponse {
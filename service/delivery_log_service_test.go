@@ -0,0 +1,138 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+func newDeliveryStore(t *testing.T) *repository.MemoryStore {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul"},
+		&models.Package{PackageType: "box", RegionID: "R01"},
+		&models.Package{PackageType: "bag", RegionID: "R01"},
+		&models.Vehicle{VehicleID: "A01"},
+		&models.TripLog{VehicleID: "A01", Status: "운행중"})
+	return store
+}
+
+func TestDeliveryLogService_CreateDeliveryLog(t *testing.T) {
+	registered := "2026-03-02T09:00:00Z"
+	tests := []struct {
+		name   string
+		req    dto.CreateDeliveryLogRequest
+		status int
+	}{
+		{"created", dto.CreateDeliveryLogRequest{TripID: 1, PackageID: 1, RegionID: "R01", LoadOrder: 1}, http.StatusOK},
+		{"explicit registered at", dto.CreateDeliveryLogRequest{TripID: 1, PackageID: 2, RegionID: "R01", RegisteredAt: &registered}, http.StatusOK},
+		{"unknown package", dto.CreateDeliveryLogRequest{TripID: 1, PackageID: 99, RegionID: "R01"}, http.StatusUnprocessableEntity},
+		{"unknown region", dto.CreateDeliveryLogRequest{TripID: 1, PackageID: 1, RegionID: "R99"}, http.StatusUnprocessableEntity},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newDeliveryStore(t)
+			log, err := NewDeliveryLogService(store).CreateDeliveryLog(context.Background(), tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			if log.RegisteredAt == nil || (tt.req.RegisteredAt != nil && *log.RegisteredAt != *tt.req.RegisteredAt) {
+				t.Errorf("registered_at = %v", log.RegisteredAt)
+			}
+		})
+	}
+}
+
+func TestDeliveryLogService_UpdateDeliveryLog(t *testing.T) {
+	completed := time.Now().UTC().Format(time.RFC3339)
+	tests := []struct {
+		name   string
+		tripID int
+		req    dto.UpdateDeliveryLogRequest
+		status int
+	}{
+		{"reorder and complete", 1, dto.UpdateDeliveryLogRequest{LoadOrder: 3, CompletedAt: &completed}, http.StatusOK},
+		{"missing trip", 99, dto.UpdateDeliveryLogRequest{LoadOrder: 1}, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newDeliveryStore(t)
+			seed(t, store, &models.DeliveryLog{TripID: 1, PackageID: 1, RegionID: "R01", LoadOrder: 1})
+			svc := NewDeliveryLogService(store)
+
+			_, err := svc.UpdateDeliveryLog(context.Background(), tt.tripID, tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			stored, _ := svc.GetDeliveryLogByID(context.Background(), tt.tripID)
+			if stored.LoadOrder != tt.req.LoadOrder || stored.CompletedAt == nil {
+				t.Errorf("stored = %+v", stored)
+			}
+		})
+	}
+}
+
+func TestDeliveryLogService_ListByTrips(t *testing.T) {
+	store := newDeliveryStore(t)
+	seed(t, store,
+		&models.TripLog{VehicleID: "A01", Status: "운행중"},
+		&models.DeliveryLog{TripID: 2, PackageID: 1, RegionID: "R01", LoadOrder: 1},
+		&models.DeliveryLog{TripID: 1, PackageID: 2, RegionID: "R01", LoadOrder: 2},
+		&models.DeliveryLog{TripID: 1, PackageID: 1, RegionID: "R01", LoadOrder: 1})
+	svc := NewDeliveryLogService(store)
+
+	tests := []struct {
+		name    string
+		tripIDs []int
+		want    [][2]int // trip_id, package_id
+	}{
+		{"ordered by trip then load order", []int{1, 2}, [][2]int{{1, 1}, {1, 2}, {2, 1}}},
+		{"single trip", []int{2}, [][2]int{{2, 1}}},
+		{"unknown trip", []int{99}, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			logs, err := svc.ListDeliveryLogsByTrips(context.Background(), tt.tripIDs)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got [][2]int
+			for _, l := range logs {
+				got = append(got, [2]int{l.TripID, l.PackageID})
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestDeliveryLogService_DeleteDeliveryLog(t *testing.T) {
+	store := newDeliveryStore(t)
+	seed(t, store,
+		&models.DeliveryLog{TripID: 1, PackageID: 1, RegionID: "R01"},
+		&models.DeliveryLog{TripID: 1, PackageID: 2, RegionID: "R01"})
+	svc := NewDeliveryLogService(store)
+
+	if err := svc.DeleteDeliveryLog(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if logs, _ := svc.ListDeliveryLogsByTrips(context.Background(), []int{1}); len(logs) != 0 {
+		t.Errorf("remaining logs = %+v", logs)
+	}
+	if err := svc.DeleteDeliveryLog(context.Background(), 1); statusOf(err) != http.StatusNotFound {
+		t.Errorf("second delete: err = %v", err)
+	}
+}
@@ -3,112 +3,186 @@ package service
 import (
 	"context"
 
+	"github.com/baboyiban/go-api-server/apperror"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
 	"github.com/baboyiban/go-api-server/utils"
-	"gorm.io/gorm"
 )
 
-var allowedEmployeeSortFields = map[string]bool{
-	"employee_id": true,
-	"position":    true,
-	"is_active":   true,
-}
-
-func applyEmployeeSort(query *gorm.DB, sort string) *gorm.DB {
-	if sort == "" {
-		return query
-	}
-	field := sort
-	desc := false
-	if sort[0] == '-' {
-		field = sort[1:]
-		desc = true
-	}
-	if allowedEmployeeSortFields[field] {
-		order := field
-		if desc {
-			order += " DESC"
-		} else {
-			order += " ASC"
-		}
-		return query.Order(order)
-	}
-	return query
-}
-
 type EmployeeService struct {
-	db *gorm.DB
+	store  repository.Store
+	status *EmployeeStatusCache
 }
 
-func NewEmployeeService(db *gorm.DB) *EmployeeService {
-	return &EmployeeService{db: db}
+func NewEmployeeService(store repository.Store, status *EmployeeStatusCache) *EmployeeService {
+	return &EmployeeService{store: store, status: status}
 }
 
 func (s *EmployeeService) CreateEmployee(ctx context.Context, req dto.CreateEmployeeRequest) (*dto.EmployeeResponse, error) {
+	ctx, span := tracer.Start(ctx, "EmployeeService.CreateEmployee")
+	defer span.End()
 	isActive := true
 	if req.IsActive != nil {
 		isActive = *req.IsActive
 	}
-	hash, err := utils.HashPassword(req.Password)
+	hash, err := hashNewPassword("password", req.Password)
 	if err != nil {
 		return nil, err
 	}
 	emp := models.Employee{
-		Password: hash,
-		Position: req.Position,
-		IsActive: isActive,
-	}
-	if err := s.db.WithContext(ctx).Create(&emp).Error; err != nil {
+		Password:          hash,
+		Position:          req.Position,
+		IsActive:          isActive,
+		Name:              req.Name,
+		Username:          emptyToNil(req.Username),
+		Phone:             emptyToNil(req.Phone),
+		Email:             emptyToNil(req.Email),
+		HireDate:          utils.ParseDatePtr(req.HireDate),
+		AssignedVehicleID: emptyToNil(req.AssignedVehicleID),
+	}
+	// is_active 는 DB 기본값이 true 라 생성 시 false 가 저장되지 않으므로 같은 트랜잭션에서 갱신
+	err = s.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Employees().Create(ctx, &emp); err != nil {
+			return err
+		}
+		if isActive {
+			return nil
+		}
+		emp.IsActive = false
+		return tx.Employees().Update(ctx, emp.EmployeeID, map[string]any{"is_active": false})
+	})
+	if err != nil {
 		return nil, err
 	}
 	return toEmployeeResponse(&emp), nil
 }
 
 func (s *EmployeeService) GetEmployeeByID(ctx context.Context, id int) (*dto.EmployeeResponse, error) {
-	var emp models.Employee
-	if err := s.db.WithContext(ctx).Where("employee_id = ?", id).First(&emp).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "EmployeeService.GetEmployeeByID")
+	defer span.End()
+	emp, err := s.store.Employees().Get(ctx, id)
+	if err != nil {
 		return nil, err
 	}
-	return toEmployeeResponse(&emp), nil
+	return toEmployeeResponse(emp), nil
 }
 
-func (s *EmployeeService) DeleteEmployee(ctx context.Context, id int) error {
-	result := s.db.WithContext(ctx).Where("employee_id = ?", id).Delete(&models.Employee{})
-	if result.Error != nil {
-		return result.Error
+// GetEmployeesByIDs 여러 직원을 한 번에 조회. 없는 ID 는 결과에서 빠짐
+func (s *EmployeeService) GetEmployeesByIDs(ctx context.Context, ids []int) ([]dto.EmployeeResponse, error) {
+	ctx, span := tracer.Start(ctx, "EmployeeService.GetEmployeesByIDs")
+	defer span.End()
+	emps, err := s.store.Employees().GetMany(ctx, ids)
+	if err != nil {
+		return nil, err
 	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+	res := make([]dto.EmployeeResponse, 0, len(emps))
+	for _, e := range emps {
+		res = append(res, *toEmployeeResponse(&e))
 	}
-	return nil
+	return res, nil
 }
 
-func (s *EmployeeService) UpdateEmployee(ctx context.Context, id int, req dto.UpdateEmployeeRequest) (*dto.EmployeeResponse, error) {
-	var emp models.Employee
-	if err := s.db.WithContext(ctx).Where("employee_id = ?", id).First(&emp).Error; err != nil {
-		return nil, err
+func (s *EmployeeService) DeleteEmployee(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "EmployeeService.DeleteEmployee")
+	defer span.End()
+	if err := s.store.Employees().Delete(ctx, id); err != nil {
+		return err
 	}
+	s.status.Invalidate(id)
+	return nil
+}
+
+// UpdateEmployee 요청에 포함된 항목만 변경. 비밀번호를 바꾸면 기존 토큰도 무효화
+func (s *EmployeeService) UpdateEmployee(ctx context.Context, id, actorID int, req dto.UpdateEmployeeRequest) (*dto.EmployeeResponse, error) {
+	ctx, span := tracer.Start(ctx, "EmployeeService.UpdateEmployee")
+	defer span.End()
+	if req.IsActive != nil && !*req.IsActive && id == actorID {
+		return nil, apperror.ErrSelfDeactivation
+	}
+	columns := map[string]any{}
 	if req.Password != "" {
-		emp.Password = req.Password
+		hash, err := hashNewPassword("password", req.Password)
+		if err != nil {
+			return nil, err
+		}
+		columns["password"] = hash
 	}
 	if req.Position != "" {
-		emp.Position = req.Position
+		columns["position"] = req.Position
 	}
 	if req.IsActive != nil {
-		emp.IsActive = *req.IsActive
+		columns["is_active"] = *req.IsActive
+	}
+	if req.Name != nil {
+		columns["name"] = *req.Name
 	}
-	if err := s.db.WithContext(ctx).Save(&emp).Error; err != nil {
+	// 선택 항목은 빈 문자열을 보내면 값을 지움
+	if req.Username != nil {
+		columns["username"] = emptyToNil(req.Username)
+	}
+	if req.Phone != nil {
+		columns["phone"] = emptyToNil(req.Phone)
+	}
+	if req.Email != nil {
+		columns["email"] = emptyToNil(req.Email)
+	}
+	if req.HireDate != nil {
+		columns["hire_date"] = utils.ParseDatePtr(req.HireDate)
+	}
+	if req.AssignedVehicleID != nil {
+		columns["assigned_vehicle_id"] = emptyToNil(req.AssignedVehicleID)
+	}
+
+	var emp *models.Employee
+	err := s.store.Transaction(ctx, func(tx repository.Store) error {
+		var err error
+		if emp, err = tx.Employees().Lock(ctx, id); err != nil {
+			return err
+		}
+		if len(columns) == 0 {
+			return nil
+		}
+		if _, ok := columns["password"]; ok {
+			columns["token_version"] = emp.TokenVersion + 1
+		}
+		if err := tx.Employees().Update(ctx, id, columns); err != nil {
+			return err
+		}
+		emp, err = tx.Employees().Get(ctx, id)
+		return err
+	})
+	if err != nil {
 		return nil, err
 	}
-	return toEmployeeResponse(&emp), nil
+	s.status.Invalidate(id)
+	return toEmployeeResponse(emp), nil
+}
+
+// SetActive 직원 계정을 비활성화/재활성화. 캐시를 비워 기존 토큰에도 즉시 반영
+func (s *EmployeeService) SetActive(ctx context.Context, id, actorID int, active bool) (*dto.EmployeeResponse, error) {
+	ctx, span := tracer.Start(ctx, "EmployeeService.SetActive")
+	defer span.End()
+	if !active && id == actorID {
+		return nil, apperror.ErrSelfDeactivation
+	}
+	emp, err := s.store.Employees().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.store.Employees().Update(ctx, id, map[string]any{"is_active": active}); err != nil {
+		return nil, err
+	}
+	emp.IsActive = active
+	s.status.Invalidate(id)
+	return toEmployeeResponse(emp), nil
 }
 
 func (s *EmployeeService) ListEmployees(ctx context.Context, sort string) ([]dto.EmployeeResponse, error) {
-	var emps []models.Employee
-	query := s.db.WithContext(ctx).Model(&models.Employee{})
-	query = applyEmployeeSort(query, sort)
-	if err := query.Find(&emps).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "EmployeeService.ListEmployees")
+	defer span.End()
+	emps, err := s.store.Replica().Employees().Find(ctx, repository.Query{Sort: sort})
+	if err != nil {
 		return nil, err
 	}
 	var res []dto.EmployeeResponse
@@ -119,13 +193,10 @@ func (s *EmployeeService) ListEmployees(ctx context.Context, sort string) ([]dto
 }
 
 func (s *EmployeeService) SearchEmployees(ctx context.Context, params map[string]string, sort string) ([]dto.EmployeeResponse, error) {
-	var emps []models.Employee
-	query := s.db.WithContext(ctx).Model(&models.Employee{})
-	for k, v := range params {
-		query = query.Where(k+" = ?", v)
-	}
-	query = applyEmployeeSort(query, sort)
-	if err := query.Find(&emps).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "EmployeeService.SearchEmployees")
+	defer span.End()
+	emps, err := s.store.Replica().Employees().Find(ctx, repository.Query{Filters: params, Sort: sort})
+	if err != nil {
 		return nil, err
 	}
 	var res []dto.EmployeeResponse
@@ -137,8 +208,21 @@ func (s *EmployeeService) SearchEmployees(ctx context.Context, params map[string
 
 func toEmployeeResponse(m *models.Employee) *dto.EmployeeResponse {
 	return &dto.EmployeeResponse{
-		EmployeeID: m.EmployeeID,
-		Position:   m.Position,
-		IsActive:   m.IsActive,
+		EmployeeID:        m.EmployeeID,
+		Position:          m.Position,
+		IsActive:          m.IsActive,
+		Name:              m.Name,
+		Username:          m.Username,
+		Phone:             m.Phone,
+		Email:             m.Email,
+		HireDate:          utils.FormatDatePtr(m.HireDate),
+		AssignedVehicleID: m.AssignedVehicleID,
+	}
+}
+
+func emptyToNil(s *string) *string {
+	if s == nil || *s == "" {
+		return nil
 	}
+	return s
 }
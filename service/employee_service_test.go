@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/utils"
+)
+
+func newEmployeeStore(t *testing.T) *repository.MemoryStore {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Employee{Position: "관리직", IsActive: true, Name: "Kim Admin", Username: ptr("admin")},
+		&models.Employee{Position: driverPosition, IsActive: true, Name: "Lee Driver", Username: ptr("driver")},
+		&models.Employee{Position: driverPosition, IsActive: false, Name: "Park_Retired"})
+	return store
+}
+
+func TestEmployeeService_CreateEmployee(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        dto.CreateEmployeeRequest
+		status     int
+		wantActive bool
+	}{
+		{"active by default", dto.CreateEmployeeRequest{Password: "long-enough", Position: driverPosition, Name: "Choi"}, http.StatusOK, true},
+		{"inactive", dto.CreateEmployeeRequest{Password: "long-enough", Position: driverPosition, Name: "Choi", IsActive: ptr(false)}, http.StatusOK, false},
+		{"weak password", dto.CreateEmployeeRequest{Password: "short", Position: driverPosition, Name: "Choi"}, http.StatusBadRequest, false},
+		{"duplicate username", dto.CreateEmployeeRequest{Password: "long-enough", Position: driverPosition, Name: "Choi", Username: ptr("admin")}, http.StatusConflict, false},
+		{"empty username stored as null", dto.CreateEmployeeRequest{Password: "long-enough", Position: driverPosition, Name: "Choi", Username: ptr("")}, http.StatusOK, true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newEmployeeStore(t)
+			svc := NewEmployeeService(store, NewEmployeeStatusCache(store, 0))
+
+			emp, err := svc.CreateEmployee(context.Background(), tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			if emp.IsActive != tt.wantActive || emp.Username != nil && *emp.Username == "" {
+				t.Errorf("employee = %+v", emp)
+			}
+			stored, _ := store.Employees().Get(context.Background(), emp.EmployeeID)
+			if !utils.CheckPasswordHash(tt.req.Password, stored.Password) {
+				t.Error("password is not stored as a hash of the request password")
+			}
+		})
+	}
+}
+
+func TestEmployeeService_SearchEmployees(t *testing.T) {
+	store := newEmployeeStore(t)
+	svc := NewEmployeeService(store, NewEmployeeStatusCache(store, 0))
+
+	tests := []struct {
+		name   string
+		params map[string]string
+		sort   string
+		want   []int
+	}{
+		{"name contains", map[string]string{"name": "Driver"}, "", []int{2}},
+		{"like wildcard is literal", map[string]string{"name": "_"}, "", []int{3}},
+		{"by position", map[string]string{"position": driverPosition}, "-employee_id", []int{3, 2}},
+		{"active only", map[string]string{"is_active": "true"}, "name", []int{1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			emps, err := svc.SearchEmployees(context.Background(), tt.params, tt.sort)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int
+			for _, e := range emps {
+				got = append(got, e.EmployeeID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEmployeeService_UpdateEmployee(t *testing.T) {
+	tests := []struct {
+		name        string
+		id          int
+		actorID     int
+		req         dto.UpdateEmployeeRequest
+		status      int
+		wantName    string
+		wantActive  bool
+		wantVersion int
+	}{
+		{"only given columns", 2, 1, dto.UpdateEmployeeRequest{Phone: ptr("010-0000-0000")}, http.StatusOK, "Lee Driver", true, 0},
+		{"deactivate other", 2, 1, dto.UpdateEmployeeRequest{IsActive: ptr(false)}, http.StatusOK, "Lee Driver", false, 0},
+		{"password revokes tokens", 2, 1, dto.UpdateEmployeeRequest{Password: "long-enough"}, http.StatusOK, "Lee Driver", true, 1},
+		{"deactivate self", 1, 1, dto.UpdateEmployeeRequest{IsActive: ptr(false), Name: ptr("Renamed")}, http.StatusConflict, "Kim Admin", true, 0},
+		{"missing", 99, 1, dto.UpdateEmployeeRequest{Name: ptr("Nobody")}, http.StatusNotFound, "", false, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newEmployeeStore(t)
+			status := NewEmployeeStatusCache(store, time.Hour)
+			svc := NewEmployeeService(store, status)
+			if _, err := status.Status(context.Background(), tt.id); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := svc.UpdateEmployee(context.Background(), tt.id, tt.actorID, tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if tt.status == http.StatusNotFound {
+				return
+			}
+			stored, _ := store.Employees().Get(context.Background(), tt.id)
+			if stored.Name != tt.wantName || stored.IsActive != tt.wantActive || stored.Username == nil {
+				t.Errorf("employee = %+v", stored)
+			}
+			st, _ := status.Status(context.Background(), tt.id)
+			if st.IsActive != tt.wantActive || st.TokenVersion != tt.wantVersion {
+				t.Errorf("cached status = %+v, want is_active %v, token version %d", st, tt.wantActive, tt.wantVersion)
+			}
+		})
+	}
+}
+
+// invalidatingStore 직원 조회 도중 다른 요청이 상태를 변경하고 캐시를 무효화하는 상황을 흉내냄
+type invalidatingStore struct {
+	repository.Store
+	during func()
+}
+
+type invalidatingEmployees struct {
+	repository.EmployeeRepository
+	during func()
+}
+
+func (s invalidatingStore) Employees() repository.EmployeeRepository {
+	return invalidatingEmployees{s.Store.Employees(), s.during}
+}
+
+func (r invalidatingEmployees) Get(ctx context.Context, id int) (*models.Employee, error) {
+	emp, err := r.EmployeeRepository.Get(ctx, id)
+	r.during()
+	return emp, err
+}
+
+func TestEmployeeStatusCache_InvalidateDuringLoad(t *testing.T) {
+	ctx := context.Background()
+	store := newEmployeeStore(t)
+	var status *EmployeeStatusCache
+	once := true
+	status = NewEmployeeStatusCache(invalidatingStore{store, func() {
+		if once {
+			once = false
+			_ = store.Employees().Update(ctx, 2, map[string]any{"is_active": false})
+			status.Invalidate(2)
+		}
+	}}, time.Hour)
+
+	if st, _ := status.Status(ctx, 2); !st.IsActive {
+		t.Fatalf("first load = %+v, want the value read before the change", st)
+	}
+	// 무효화 이전에 읽은 값이 캐시에 남으면 TTL 동안 비활성화가 반영되지 않음
+	if st, _ := status.Status(ctx, 2); st.IsActive {
+		t.Errorf("second load = %+v, want inactive", st)
+	}
+}
+
+func TestEmployeeService_SetActive(t *testing.T) {
+	tests := []struct {
+		name    string
+		id      int
+		actorID int
+		active  bool
+		status  int
+	}{
+		{"deactivate other", 2, 1, false, http.StatusOK},
+		{"reactivate", 3, 1, true, http.StatusOK},
+		{"deactivate self", 1, 1, false, http.StatusConflict},
+		{"missing", 99, 1, false, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newEmployeeStore(t)
+			status := NewEmployeeStatusCache(store, time.Hour)
+			svc := NewEmployeeService(store, status)
+			// 캐시를 채워 둔 뒤 변경이 즉시 반영되는지 확인
+			if _, err := status.Status(context.Background(), tt.id); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := svc.SetActive(context.Background(), tt.id, tt.actorID, tt.active)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			st, err := status.Status(context.Background(), tt.id)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if st.IsActive != tt.active {
+				t.Errorf("cached is_active = %v, want %v", st.IsActive, tt.active)
+			}
+		})
+	}
+}
+
+func TestEmployeeService_DeleteEmployee(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     int
+		status int
+	}{
+		{"deleted", 3, http.StatusOK},
+		{"has shifts", 2, http.StatusConflict},
+		{"missing", 99, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newEmployeeStore(t)
+			seed(t, store,
+				&models.Vehicle{VehicleID: "A01"},
+				&models.Shift{EmployeeID: 2, VehicleID: "A01", StartTime: time.Now()})
+			status := NewEmployeeStatusCache(store, time.Hour)
+			svc := NewEmployeeService(store, status)
+
+			err := svc.DeleteEmployee(context.Background(), tt.id)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			st, _ := status.Status(context.Background(), tt.id)
+			if st.Exists != (tt.status == http.StatusConflict) {
+				t.Errorf("exists = %v after status %d", st.Exists, tt.status)
+			}
+		})
+	}
+}
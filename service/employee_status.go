@@ -0,0 +1,78 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+// EmployeeStatus 인증 시 확인하는 직원의 현재 상태
+type EmployeeStatus struct {
+	Exists       bool
+	Position     string
+	IsActive     bool
+	TokenVersion int
+}
+
+// EmployeeStatusCache 요청마다 DB 를 조회하지 않도록 직원 상태를 짧게 캐시.
+// 직원 정보를 변경하는 경로는 Invalidate 를 호출해 즉시 반영되도록 해야 함
+type EmployeeStatusCache struct {
+	store repository.Store
+	ttl   time.Duration
+
+	mu      sync.Mutex
+	entries map[int]statusEntry
+	// generation Invalidate 마다 증가. 무효화 전에 시작한 조회 결과가 캐시에 남지 않도록 비교
+	generation uint64
+}
+
+type statusEntry struct {
+	status    EmployeeStatus
+	expiresAt time.Time
+}
+
+// NewEmployeeStatusCache ttl 이 0 이면 캐시하지 않고 매번 조회
+func NewEmployeeStatusCache(store repository.Store, ttl time.Duration) *EmployeeStatusCache {
+	return &EmployeeStatusCache{store: store, ttl: ttl, entries: map[int]statusEntry{}}
+}
+
+func (c *EmployeeStatusCache) Status(ctx context.Context, employeeID int) (EmployeeStatus, error) {
+	now := time.Now()
+	c.mu.Lock()
+	e, ok := c.entries[employeeID]
+	generation := c.generation
+	c.mu.Unlock()
+	if ok && now.Before(e.expiresAt) {
+		return e.status, nil
+	}
+
+	emp, err := c.store.Employees().Get(ctx, employeeID)
+	var status EmployeeStatus
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+	case err != nil:
+		return EmployeeStatus{}, err
+	default:
+		status = EmployeeStatus{Exists: true, Position: emp.Position, IsActive: emp.IsActive, TokenVersion: emp.TokenVersion}
+	}
+
+	if c.ttl > 0 {
+		c.mu.Lock()
+		if c.generation == generation {
+			c.entries[employeeID] = statusEntry{status: status, expiresAt: now.Add(c.ttl)}
+		}
+		c.mu.Unlock()
+	}
+	return status, nil
+}
+
+// Invalidate 캐시된 직원 상태를 제거
+func (c *EmployeeStatusCache) Invalidate(employeeID int) {
+	c.mu.Lock()
+	delete(c.entries, employeeID)
+	c.generation++
+	c.mu.Unlock()
+}
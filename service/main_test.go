@@ -0,0 +1,73 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/utils"
+	"golang.org/x/crypto/bcrypt"
+)
+
+func TestMain(m *testing.M) {
+	// 테스트에서는 해시 비용을 최소로 낮춤
+	utils.ConfigurePassword(bcrypt.MinCost, utils.PasswordPolicy{MinLength: 8})
+	utils.ConfigureJWT("test-secret-test-secret-test-secret", time.Hour)
+	os.Exit(m.Run())
+}
+
+// seed 모델 값을 저장소에 미리 넣음. 지원하지 않는 타입이면 테스트 실패
+func seed(t *testing.T, store repository.Store, rows ...any) {
+	t.Helper()
+	ctx := context.Background()
+	for _, row := range rows {
+		var err error
+		switch r := row.(type) {
+		case *models.Region:
+			err = store.Regions().Create(ctx, r)
+		case *models.Package:
+			err = store.Packages().Create(ctx, r)
+		case *models.Vehicle:
+			err = store.Vehicles().Create(ctx, r)
+		case *models.TripLog:
+			err = store.TripLogs().Create(ctx, r)
+		case *models.DeliveryLog:
+			err = store.DeliveryLogs().Create(ctx, r)
+		case *models.Employee:
+			err = store.Employees().Create(ctx, r)
+		case *models.Shift:
+			err = store.Shifts().Create(ctx, r)
+		default:
+			t.Fatalf("seed: unsupported type %T", row)
+		}
+		if err != nil {
+			t.Fatalf("seed %T: %v", row, err)
+		}
+	}
+}
+
+// statusOf 핸들러가 응답할 HTTP 상태 코드. 에러가 없으면 200
+func statusOf(err error) int {
+	if err == nil {
+		return http.StatusOK
+	}
+	return apperror.Translate(err, "test").Status
+}
+
+// eventTypes 아웃박스에 기록된 이벤트 종류를 순서대로
+func eventTypes(store *repository.MemoryStore) []string {
+	var types []string
+	for _, ev := range store.RecordedEvents() {
+		types = append(types, ev.Type)
+	}
+	return types
+}
+
+func ptr[T any](v T) *T {
+	return &v
+}
@@ -2,124 +2,202 @@ package service
 
 import (
 	"context"
+	"errors"
+	"strconv"
 
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/cache"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/models"
-	"gorm.io/gorm"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
 )
 
-var allowedPackageSortFields = map[string]bool{
-	"package_id":     true,
-	"package_type":   true,
-	"region_id":      true,
-	"package_status": true,
-	"registered_at":  true,
-}
+// packageStatusCompleted 배송이 끝난 패키지 상태
+const packageStatusCompleted = "완료됨"
 
-func applyPackageSort(query *gorm.DB, sort string) *gorm.DB {
-	if sort == "" {
-		return query
-	}
-	field := sort
-	desc := false
-	if sort[0] == '-' {
-		field = sort[1:]
-		desc = true
-	}
-	if allowedPackageSortFields[field] {
-		order := field
-		if desc {
-			order += " DESC"
-		} else {
-			order += " ASC"
-		}
-		return query.Order(order)
-	}
-	return query
-}
+// packageStatusInducted 분류기에 투입된 패키지 상태
+const packageStatusInducted = "투입됨"
+
+// 분류기에 투입되기 전 패키지 상태
+const (
+	packageStatusRegistered = "등록됨"
+	packageStatusInbound    = "A차운송중"
+)
 
 type PackageService struct {
-	db *gorm.DB
+	store repository.Store
+	cache *cache.Cache
 }
 
-func NewPackageService(db *gorm.DB) *PackageService {
-	return &PackageService{db: db}
+// NewPackageService c 는 투입된 패키지가 빠져 적재량이 바뀐 지역을 비울 조회 캐시 (nil 이면 캐시 없음)
+func NewPackageService(store repository.Store, c *cache.Cache) *PackageService {
+	return &PackageService{store: store, cache: c}
 }
 
 func (s *PackageService) CreatePackage(ctx context.Context, req dto.CreatePackageRequest) (*models.Package, error) {
+	ctx, span := tracer.Start(ctx, "PackageService.CreatePackage")
+	defer span.End()
+	if req.PackageStatus == packageStatusInducted {
+		return nil, apperror.ErrSorterManaged.WithDetail("packages are inducted by a sorter scan")
+	}
+	region, err := s.store.Regions().Get(ctx, req.RegionID)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return nil, apperror.InvalidReference("package").WithDetail("region %s does not exist", req.RegionID)
+		}
+		return nil, err
+	}
+	if region.IsFull {
+		return nil, apperror.ErrRegionFull.WithDetail("region %s is full", req.RegionID)
+	}
 	pkg := models.Package{
 		PackageType:   req.PackageType,
 		RegionID:      req.RegionID,
 		PackageStatus: req.PackageStatus,
 	}
-	if err := s.db.WithContext(ctx).Create(&pkg).Error; err != nil {
+	err = s.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Packages().Create(ctx, &pkg); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.PackageCreated, outbox.AggregatePackage, strconv.Itoa(pkg.PackageID), pkg)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &pkg, nil
 }
 
 func (s *PackageService) GetPackageByID(ctx context.Context, id int) (*models.Package, error) {
-	var pkg models.Package
-	if err := s.db.WithContext(ctx).Where("package_id = ?", id).First(&pkg).Error; err != nil {
-		return nil, err
-	}
-	return &pkg, nil
+	ctx, span := tracer.Start(ctx, "PackageService.GetPackageByID")
+	defer span.End()
+	return s.store.Packages().Get(ctx, id)
 }
 
 func (s *PackageService) DeletePackage(ctx context.Context, id int) error {
-	result := s.db.WithContext(ctx).Where("package_id = ?", id).Delete(&models.Package{})
-	if result.Error != nil {
-		return result.Error
+	ctx, span := tracer.Start(ctx, "PackageService.DeletePackage")
+	defer span.End()
+	var released bool
+	err := s.store.Transaction(ctx, func(tx repository.Store) error {
+		// 투입된 패키지면 적재량을 되돌려야 하므로 상태를 잠근 뒤 삭제
+		pkg, err := tx.Packages().Lock(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.Packages().Delete(ctx, id); err != nil {
+			return err
+		}
+		if err := tx.Events().Record(ctx, outbox.PackageDeleted, outbox.AggregatePackage, strconv.Itoa(id), map[string]int{"package_id": id}); err != nil {
+			return err
+		}
+		released = pkg.PackageStatus == packageStatusInducted
+		if released {
+			return releaseBin(ctx, tx, pkg.RegionID)
+		}
+		return nil
+	})
+	if err != nil {
+		return err
 	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
+	if released {
+		s.cache.Invalidate(ctx, regionCache)
 	}
 	return nil
 }
 
 func (s *PackageService) UpdatePackage(ctx context.Context, id int, req dto.UpdatePackageRequest) (*models.Package, error) {
-	var pkg models.Package
-	if err := s.db.WithContext(ctx).Where("package_id = ?", id).First(&pkg).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "PackageService.UpdatePackage")
+	defer span.End()
+	var pkg *models.Package
+	var released bool
+	err := s.store.Transaction(ctx, func(tx repository.Store) error {
+		var err error
+		// 같은 패키지를 스캔하는 분류기와 겹쳐 투입 상태를 덮어쓰지 않도록 잠금
+		if pkg, err = tx.Packages().Lock(ctx, id); err != nil {
+			return err
+		}
+		if err := checkSorterManaged(pkg, req); err != nil {
+			return err
+		}
+		previousStatus := pkg.PackageStatus
+		if req.PackageType != "" {
+			pkg.PackageType = req.PackageType
+		}
+		if req.RegionID != "" {
+			pkg.RegionID = req.RegionID
+		}
+		if req.PackageStatus != "" {
+			pkg.PackageStatus = req.PackageStatus
+		}
+		if err := tx.Packages().Save(ctx, pkg); err != nil {
+			return err
+		}
+		aggregateID := strconv.Itoa(pkg.PackageID)
+		if err := tx.Events().Record(ctx, outbox.PackageUpdated, outbox.AggregatePackage, aggregateID, pkg); err != nil {
+			return err
+		}
+		if pkg.PackageStatus == packageStatusCompleted && previousStatus != packageStatusCompleted {
+			if err := tx.Events().Record(ctx, outbox.PackageCompleted, outbox.AggregatePackage, aggregateID, pkg); err != nil {
+				return err
+			}
+		}
+		// 투입된 패키지가 다음 단계로 넘어가면 적재함 자리를 돌려줌
+		released = previousStatus == packageStatusInducted && pkg.PackageStatus != packageStatusInducted
+		if released {
+			return releaseBin(ctx, tx, pkg.RegionID)
+		}
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
-	if req.PackageType != "" {
-		pkg.PackageType = req.PackageType
-	}
-	if req.RegionID != "" {
-		pkg.RegionID = req.RegionID
+	if released {
+		s.cache.Invalidate(ctx, regionCache)
 	}
-	if req.PackageStatus != "" {
-		pkg.PackageStatus = req.PackageStatus
+	return pkg, nil
+}
+
+// checkSorterManaged 지역 적재량은 분류기 스캔이 지역을 잠그고 올리므로, 수정 요청으로 투입하거나
+// 이미 적재량에 반영된 투입 패키지의 지역을 바꾸지 못하게 함
+func checkSorterManaged(pkg *models.Package, req dto.UpdatePackageRequest) error {
+	if req.PackageStatus == packageStatusInducted && pkg.PackageStatus != packageStatusInducted {
+		return apperror.ErrSorterManaged.WithDetail("package %d can only be inducted by a sorter scan", pkg.PackageID)
 	}
-	if err := s.db.WithContext(ctx).Save(&pkg).Error; err != nil {
-		return nil, err
+	if req.RegionID != "" && req.RegionID != pkg.RegionID && pkg.PackageStatus == packageStatusInducted {
+		return apperror.ErrSorterManaged.WithDetail("package %d is already counted in region %s", pkg.PackageID, pkg.RegionID)
 	}
-	return &pkg, nil
+	return nil
 }
 
 func (s *PackageService) ListPackages(ctx context.Context, sort string) ([]models.Package, error) {
-	var pkgs []models.Package
-	query := s.db.WithContext(ctx).Model(&models.Package{})
-	query = applyPackageSort(query, sort)
-	if err := query.Find(&pkgs).Error; err != nil {
-		return nil, err
-	}
-	return pkgs, nil
+	ctx, span := tracer.Start(ctx, "PackageService.ListPackages")
+	defer span.End()
+	return s.store.Replica().Packages().Find(ctx, repository.Query{Sort: sort})
 }
 
 func (s *PackageService) SearchPackages(ctx context.Context, params map[string]string, sort string) ([]models.Package, error) {
-	var pkgs []models.Package
-	query := s.db.WithContext(ctx).Model(&models.Package{})
-	for k, v := range params {
-		if k == "registered_at" {
-			query = query.Where("DATE("+k+") = ?", v)
-		} else {
-			query = query.Where(k+" = ?", v)
-		}
-	}
-	query = applyPackageSort(query, sort)
-	if err := query.Find(&pkgs).Error; err != nil {
-		return nil, err
-	}
-	return pkgs, nil
+	ctx, span := tracer.Start(ctx, "PackageService.SearchPackages")
+	defer span.End()
+	return s.store.Replica().Packages().Find(ctx, repository.Query{Filters: params, Sort: sort})
+}
+
+// PagePackages SearchPackages 와 같은 조건으로 한 페이지와 전체 개수를 조회
+func (s *PackageService) PagePackages(ctx context.Context, params map[string]string, sort string, page dto.PageRequest) ([]models.Package, int64, error) {
+	ctx, span := tracer.Start(ctx, "PackageService.PagePackages")
+	defer span.End()
+	return paginate(ctx, s.store.Replica().Packages(), repository.Query{Filters: params, Sort: sort, Page: page})
+}
+
+// GetPackagesByIDs 여러 패키지를 한 번에 조회. 없는 ID 는 결과에서 빠짐
+func (s *PackageService) GetPackagesByIDs(ctx context.Context, ids []int) ([]models.Package, error) {
+	ctx, span := tracer.Start(ctx, "PackageService.GetPackagesByIDs")
+	defer span.End()
+	return s.store.Packages().GetMany(ctx, ids)
+}
+
+// ListPackagesByRegions 여러 지역의 패키지를 한 번에 조회
+func (s *PackageService) ListPackagesByRegions(ctx context.Context, regionIDs []string) ([]models.Package, error) {
+	ctx, span := tracer.Start(ctx, "PackageService.ListPackagesByRegions")
+	defer span.End()
+	return s.store.Packages().ListByRegions(ctx, regionIDs)
 }
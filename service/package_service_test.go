@@ -0,0 +1,171 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"slices"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+func newPackageStore(t *testing.T) *repository.MemoryStore {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul"},
+		&models.Region{RegionID: "R02", RegionName: "Busan", IsFull: true},
+		&models.Package{PackageType: "box", RegionID: "R01"})
+	return store
+}
+
+func TestPackageService_CreatePackage(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        dto.CreatePackageRequest
+		status     int
+		wantStatus string
+	}{
+		{"default status", dto.CreatePackageRequest{PackageType: "bag", RegionID: "R01"}, http.StatusOK, "등록됨"},
+		{"explicit status", dto.CreatePackageRequest{PackageType: "bag", RegionID: "R01", PackageStatus: "A차운송중"}, http.StatusOK, "A차운송중"},
+		{"inducted without scan", dto.CreatePackageRequest{PackageType: "bag", RegionID: "R01", PackageStatus: "투입됨"}, http.StatusConflict, ""},
+		{"region full", dto.CreatePackageRequest{PackageType: "bag", RegionID: "R02"}, http.StatusConflict, ""},
+		{"unknown region", dto.CreatePackageRequest{PackageType: "bag", RegionID: "R99"}, http.StatusUnprocessableEntity, ""},
+		{"duplicate type in region", dto.CreatePackageRequest{PackageType: "box", RegionID: "R01"}, http.StatusConflict, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newPackageStore(t)
+			svc := NewPackageService(store, nil)
+
+			pkg, err := svc.CreatePackage(context.Background(), tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				if len(store.RecordedEvents()) != 0 {
+					t.Errorf("events recorded for failed create: %v", eventTypes(store))
+				}
+				return
+			}
+			if pkg.PackageID == 0 || pkg.PackageStatus != tt.wantStatus || pkg.RegisteredAt.IsZero() {
+				t.Errorf("package = %+v", pkg)
+			}
+			if got := eventTypes(store); !slices.Equal(got, []string{outbox.PackageCreated}) {
+				t.Errorf("events = %v", got)
+			}
+		})
+	}
+}
+
+func TestPackageService_UpdatePackage(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     int
+		req    dto.UpdatePackageRequest
+		status int
+		events []string
+	}{
+		{"type only", 1, dto.UpdatePackageRequest{PackageType: "crate"}, http.StatusOK, []string{outbox.PackageUpdated}},
+		{"completed", 1, dto.UpdatePackageRequest{PackageStatus: "완료됨"}, http.StatusOK, []string{outbox.PackageUpdated, outbox.PackageCompleted}},
+		{"unknown region", 1, dto.UpdatePackageRequest{RegionID: "R99"}, http.StatusUnprocessableEntity, nil},
+		{"missing", 99, dto.UpdatePackageRequest{PackageType: "crate"}, http.StatusNotFound, nil},
+		{"region before induction", 1, dto.UpdatePackageRequest{RegionID: "R02"}, http.StatusOK, []string{outbox.PackageUpdated}},
+		// 적재량은 분류기 스캔만 올리므로 수정 요청으로 투입하거나 투입된 패키지를 옮길 수 없음
+		{"induct without scan", 1, dto.UpdatePackageRequest{PackageStatus: "투입됨"}, http.StatusConflict, nil},
+		{"move inducted package", 2, dto.UpdatePackageRequest{RegionID: "R02"}, http.StatusConflict, nil},
+		// 투입된 패키지가 다음 단계로 넘어가면 지역 적재량이 줄어듦
+		{"inducted package same region", 2, dto.UpdatePackageRequest{RegionID: "R01", PackageStatus: "B차운송중"}, http.StatusOK, []string{outbox.PackageUpdated, outbox.RegionUpdated}},
+		{"inducted package type only", 2, dto.UpdatePackageRequest{PackageType: "crate"}, http.StatusOK, []string{outbox.PackageUpdated}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newPackageStore(t)
+			seed(t, store, &models.Package{PackageType: "bag", RegionID: "R01", PackageStatus: "투입됨"})
+			svc := NewPackageService(store, nil)
+			before, _ := svc.GetPackageByID(context.Background(), tt.id)
+
+			_, err := svc.UpdatePackage(context.Background(), tt.id, tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if got := eventTypes(store); !slices.Equal(got, tt.events) {
+				t.Errorf("events = %v, want %v", got, tt.events)
+			}
+			if err != nil {
+				// 실패한 트랜잭션은 변경을 남기지 않아야 함
+				pkg, _ := svc.GetPackageByID(context.Background(), tt.id)
+				if !reflect.DeepEqual(pkg, before) {
+					t.Errorf("package changed by failed update: %+v", pkg)
+				}
+			}
+		})
+	}
+}
+
+func TestPackageService_DeletePackage(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     int
+		status int
+		events []string
+	}{
+		{"deleted", 1, http.StatusOK, []string{outbox.PackageDeleted}},
+		{"inducted", 2, http.StatusOK, []string{outbox.PackageDeleted, outbox.RegionUpdated}},
+		{"missing", 99, http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newPackageStore(t)
+			seed(t, store, &models.Package{PackageType: "bag", RegionID: "R01", PackageStatus: "투입됨"})
+			svc := NewPackageService(store, nil)
+
+			err := svc.DeletePackage(context.Background(), tt.id)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if got := eventTypes(store); !slices.Equal(got, tt.events) {
+				t.Errorf("events = %v, want %v", got, tt.events)
+			}
+		})
+	}
+}
+
+func TestPackageService_SearchPackages(t *testing.T) {
+	store := newPackageStore(t)
+	seed(t, store,
+		&models.Package{PackageType: "bag", RegionID: "R01", PackageStatus: "투입됨"},
+		&models.Package{PackageType: "envelope", RegionID: "R02"})
+	svc := NewPackageService(store, nil)
+
+	tests := []struct {
+		name   string
+		params map[string]string
+		sort   string
+		want   []int
+	}{
+		{"all", nil, "", []int{1, 2, 3}},
+		{"by region", map[string]string{"region_id": "R01"}, "-package_id", []int{2, 1}},
+		{"by status", map[string]string{"package_status": "투입됨"}, "", []int{2}},
+		{"by type sorted", nil, "package_type", []int{2, 1, 3}},
+		{"no match", map[string]string{"region_id": "R03"}, "", nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			pkgs, err := svc.SearchPackages(context.Background(), tt.params, tt.sort)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int
+			for _, p := range pkgs {
+				got = append(got, p.PackageID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
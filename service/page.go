@@ -0,0 +1,26 @@
+package service
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+// searcher 검색과 개수 조회를 지원하는 저장소
+type searcher[T any] interface {
+	Find(ctx context.Context, q repository.Query) ([]T, error)
+	Count(ctx context.Context, filters map[string]string) (int64, error)
+}
+
+// paginate 필터가 적용된 전체 개수를 센 뒤, 정렬하여 요청한 페이지만 조회
+func paginate[T any](ctx context.Context, repo searcher[T], q repository.Query) ([]T, int64, error) {
+	total, err := repo.Count(ctx, q.Filters)
+	if err != nil {
+		return nil, 0, err
+	}
+	items, err := repo.Find(ctx, q)
+	if err != nil {
+		return nil, 0, err
+	}
+	return items, total, nil
+}
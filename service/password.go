@@ -0,0 +1,37 @@
+package service
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/utils"
+)
+
+// hashNewPassword 비밀번호 정책을 검사한 뒤 해시. 비밀번호를 저장하는 모든 경로는 이 함수를 거쳐야 함
+func hashNewPassword(field, password string) (string, error) {
+	if violations := utils.CheckPasswordPolicy(password); len(violations) > 0 {
+		fields := make([]apperror.FieldError, 0, len(violations))
+		for _, v := range violations {
+			fields = append(fields, apperror.FieldError{Field: field, Rule: "password_policy", Message: v})
+		}
+		return "", apperror.Validation(fields)
+	}
+	return utils.HashPassword(password)
+}
+
+// newResetToken 일회용 토큰 원문과 저장용 해시를 생성
+func newResetToken() (token, hash string, err error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", "", err
+	}
+	token = hex.EncodeToString(b)
+	return token, hashResetToken(token), nil
+}
+
+func hashResetToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
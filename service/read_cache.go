@@ -0,0 +1,32 @@
+package service
+
+import (
+	"net/url"
+
+	"github.com/baboyiban/go-api-server/cache"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+// 조회 캐시 네임스페이스. 해당 자원을 바꾸는 트랜잭션이 커밋되면 네임스페이스 전체를 비움
+const (
+	regionCache  = "region"
+	vehicleCache = "vehicle"
+)
+
+// cacheSource 캐시에 채울 결과를 읽을 저장소. 복제본은 커밋이 늦게 반영되어 무효화 직후에 옛 값을 다시 채울 수 있으므로
+// 캐시를 쓰면 주 DB 에서 읽고, 캐시가 없을 때만 복제본에서 읽음
+func cacheSource(store repository.Store, c *cache.Cache) repository.Store {
+	if c == nil {
+		return store.Replica()
+	}
+	return store
+}
+
+// searchCacheKey 검색 조건과 정렬로 만든 캐시 키. 같은 조건이면 파라미터 순서와 관계없이 같은 키
+func searchCacheKey(params map[string]string, sort string) string {
+	values := url.Values{}
+	for k, v := range params {
+		values.Set(k, v)
+	}
+	return "search:" + values.Encode() + "|" + sort
+}
@@ -4,54 +4,41 @@ import (
 	"context"
 	"time"
 
+	"github.com/baboyiban/go-api-server/cache"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/models"
-	"gorm.io/gorm"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
 )
 
-var allowedSortFields = map[string]bool{
-	"region_id":        true,
-	"region_name":      true,
-	"coord_x":          true,
-	"coord_y":          true,
-	"max_capacity":     true,
-	"current_capacity": true,
-	"is_full":          true,
-	"saturated_at":     true,
+type RegionService struct {
+	store repository.Store
+	cache *cache.Cache
 }
 
-func applyRegionSort(query *gorm.DB, sort string) *gorm.DB {
-	if sort == "" {
-		return query
-	}
-	field := sort
-	desc := false
-	if sort[0] == '-' {
-		field = sort[1:]
-		desc = true
-	}
-	if allowedSortFields[field] {
-		order := field
-		if desc {
-			order += " DESC"
-		} else {
-			order += " ASC"
-		}
-		return query.Order(order)
-	}
-	return query
+// NewRegionService c 가 nil 이면 조회 결과를 캐시하지 않음
+func NewRegionService(store repository.Store, c *cache.Cache) *RegionService {
+	return &RegionService{store: store, cache: c}
 }
 
-type RegionService struct {
-	db *gorm.DB
+// CacheTTL 조회 결과를 캐시하는 시간. 캐시를 쓰지 않으면 0
+func (s *RegionService) CacheTTL() time.Duration {
+	return s.cache.TTL()
 }
 
-func NewRegionService(db *gorm.DB) *RegionService {
-	return &RegionService{db: db}
+// transaction 커밋되면 지역 조회 캐시를 비움
+func (s *RegionService) transaction(ctx context.Context, fn func(tx repository.Store) error) error {
+	if err := s.store.Transaction(ctx, fn); err != nil {
+		return err
+	}
+	s.cache.Invalidate(ctx, regionCache)
+	return nil
 }
 
 // CreateRegion: 새로운 지역 생성
 func (s *RegionService) CreateRegion(ctx context.Context, req dto.CreateRegionRequest) (*models.Region, error) {
+	ctx, span := tracer.Start(ctx, "RegionService.CreateRegion")
+	defer span.End()
 	region := models.Region{
 		RegionID:    req.RegionID,
 		RegionName:  req.RegionName,
@@ -60,7 +47,13 @@ func (s *RegionService) CreateRegion(ctx context.Context, req dto.CreateRegionRe
 		MaxCapacity: req.MaxCapacity,
 		// CurrentCapacity, IsFull, SaturatedAt는 zero value 또는 default
 	}
-	if err := s.db.WithContext(ctx).Create(&region).Error; err != nil {
+	err := s.transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Regions().Create(ctx, &region); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.RegionCreated, outbox.AggregateRegion, region.RegionID, region)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &region, nil
@@ -68,69 +61,120 @@ func (s *RegionService) CreateRegion(ctx context.Context, req dto.CreateRegionRe
 
 // GetRegionByID: region_id로 단건 조회
 func (s *RegionService) GetRegionByID(ctx context.Context, id string) (*models.Region, error) {
-	var region models.Region
-	if err := s.db.WithContext(ctx).Where("region_id = ?", id).First(&region).Error; err != nil {
-		return nil, err
-	}
-	return &region, nil
+	ctx, span := tracer.Start(ctx, "RegionService.GetRegionByID")
+	defer span.End()
+	return cache.Fetch(ctx, s.cache, regionCache, "get:"+id, func() (*models.Region, error) {
+		return s.store.Regions().Get(ctx, id)
+	})
 }
 
 // DeleteRegion: 지역 삭제
 func (s *RegionService) DeleteRegion(ctx context.Context, id string) error {
-	result := s.db.WithContext(ctx).Where("region_id = ?", id).Delete(&models.Region{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return nil
+	ctx, span := tracer.Start(ctx, "RegionService.DeleteRegion")
+	defer span.End()
+	return s.transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Regions().Delete(ctx, id); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.RegionDeleted, outbox.AggregateRegion, id, map[string]string{"region_id": id})
+	})
 }
 
 func (s *RegionService) UpdateRegion(ctx context.Context, id string, req dto.UpdateRegionRequest) (*models.Region, error) {
-	var region models.Region
-	if err := s.db.WithContext(ctx).Where("region_id = ?", id).First(&region).Error; err != nil {
-		return nil, err
-	}
-	region.RegionName = req.RegionName
-	region.CoordX = req.CoordX
-	region.CoordY = req.CoordY
-	region.MaxCapacity = req.MaxCapacity
-	region.CurrentCapacity = req.CurrentCapacity
-	region.IsFull = req.IsFull
-	if req.SaturatedAt != nil {
-		t, _ := time.Parse(time.RFC3339, *req.SaturatedAt)
-		region.SaturatedAt = &t
-	}
-	if err := s.db.WithContext(ctx).Save(&region).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "RegionService.UpdateRegion")
+	defer span.End()
+	var region *models.Region
+	err := s.transaction(ctx, func(tx repository.Store) error {
+		var err error
+		if region, err = tx.Regions().Get(ctx, id); err != nil {
+			return err
+		}
+		wasFull := region.IsFull
+		region.RegionName = req.RegionName
+		region.CoordX = req.CoordX
+		region.CoordY = req.CoordY
+		region.MaxCapacity = req.MaxCapacity
+		region.CurrentCapacity = req.CurrentCapacity
+		region.IsFull = req.IsFull
+		if req.SaturatedAt != nil {
+			t, _ := time.Parse(time.RFC3339, *req.SaturatedAt)
+			region.SaturatedAt = &t
+		}
+		if err := tx.Regions().Save(ctx, region); err != nil {
+			return err
+		}
+		return recordRegionUpdate(ctx, tx, region, wasFull)
+	})
+	if err != nil {
 		return nil, err
 	}
-	return &region, nil
+	return region, nil
 }
 
-func (s *RegionService) ListRegions(ctx context.Context, sort string) ([]models.Region, error) {
-	var regions []models.Region
-	query := s.db.WithContext(ctx).Model(&models.Region{})
-	query = applyRegionSort(query, sort)
-	if err := query.Find(&regions).Error; err != nil {
+// MarkFull 분류기가 알린 적재함 포화를 반영. 이미 포화 상태면 변경하지 않음
+func (s *RegionService) MarkFull(ctx context.Context, id string) (*models.Region, error) {
+	ctx, span := tracer.Start(ctx, "RegionService.MarkFull")
+	defer span.End()
+	var region *models.Region
+	err := s.transaction(ctx, func(tx repository.Store) error {
+		var err error
+		if region, err = tx.Regions().Get(ctx, id); err != nil {
+			return err
+		}
+		if region.IsFull {
+			return nil
+		}
+		now := time.Now()
+		region.IsFull = true
+		region.SaturatedAt = &now
+		if err := tx.Regions().Save(ctx, region); err != nil {
+			return err
+		}
+		return recordRegionUpdate(ctx, tx, region, false)
+	})
+	if err != nil {
 		return nil, err
 	}
-	return regions, nil
+	return region, nil
 }
 
-func (s *RegionService) SearchRegions(ctx context.Context, params map[string]string, sort string) ([]models.Region, error) {
-	var regions []models.Region
-	query := s.db.WithContext(ctx).Model(&models.Region{})
-	for k, v := range params {
-		if k == "saturated_at" {
-			query = query.Where("DATE("+k+") = ?", v)
-		} else {
-			query = query.Where(k+" = ?", v)
-		}
+// recordRegionUpdate 변경 이벤트와, 새로 포화된 경우 포화 이벤트 기록
+func recordRegionUpdate(ctx context.Context, tx repository.Store, region *models.Region, wasFull bool) error {
+	if err := tx.Events().Record(ctx, outbox.RegionUpdated, outbox.AggregateRegion, region.RegionID, region); err != nil {
+		return err
 	}
-	query = applyRegionSort(query, sort)
-	if err := query.Find(&regions).Error; err != nil {
-		return nil, err
+	if region.IsFull && !wasFull {
+		return tx.Events().Record(ctx, outbox.RegionSaturated, outbox.AggregateRegion, region.RegionID, region)
 	}
-	return regions, nil
+	return nil
+}
+
+func (s *RegionService) ListRegions(ctx context.Context, sort string) ([]models.Region, error) {
+	ctx, span := tracer.Start(ctx, "RegionService.ListRegions")
+	defer span.End()
+	return cache.Fetch(ctx, s.cache, regionCache, "list:"+sort, func() ([]models.Region, error) {
+		return cacheSource(s.store, s.cache).Regions().Find(ctx, repository.Query{Sort: sort})
+	})
+}
+
+func (s *RegionService) SearchRegions(ctx context.Context, params map[string]string, sort string) ([]models.Region, error) {
+	ctx, span := tracer.Start(ctx, "RegionService.SearchRegions")
+	defer span.End()
+	return cache.Fetch(ctx, s.cache, regionCache, searchCacheKey(params, sort), func() ([]models.Region, error) {
+		return cacheSource(s.store, s.cache).Regions().Find(ctx, repository.Query{Filters: params, Sort: sort})
+	})
+}
+
+// PageRegions SearchRegions 와 같은 조건으로 한 페이지와 전체 개수를 조회
+func (s *RegionService) PageRegions(ctx context.Context, params map[string]string, sort string, page dto.PageRequest) ([]models.Region, int64, error) {
+	ctx, span := tracer.Start(ctx, "RegionService.PageRegions")
+	defer span.End()
+	return paginate(ctx, s.store.Replica().Regions(), repository.Query{Filters: params, Sort: sort, Page: page})
+}
+
+// GetRegionsByIDs 여러 지역을 한 번에 조회. 없는 ID 는 결과에서 빠짐
+func (s *RegionService) GetRegionsByIDs(ctx context.Context, ids []string) ([]models.Region, error) {
+	ctx, span := tracer.Start(ctx, "RegionService.GetRegionsByIDs")
+	defer span.End()
+	return s.store.Regions().GetMany(ctx, ids)
 }
@@ -0,0 +1,302 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/cache"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+func TestRegionService_CreateRegion(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    dto.CreateRegionRequest
+		status int
+		events []string
+	}{
+		{"created", dto.CreateRegionRequest{RegionID: "R02", RegionName: "Busan", MaxCapacity: 10}, http.StatusOK, []string{outbox.RegionCreated}},
+		{"duplicate id", dto.CreateRegionRequest{RegionID: "R01", RegionName: "Seoul"}, http.StatusConflict, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := repository.NewMemoryStore()
+			seed(t, store, &models.Region{RegionID: "R01", RegionName: "Seoul"})
+			svc := NewRegionService(store, nil)
+
+			region, err := svc.CreateRegion(context.Background(), tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err == nil && (region.RegionID != tt.req.RegionID || region.MaxCapacity != tt.req.MaxCapacity) {
+				t.Errorf("region = %+v", region)
+			}
+			if got := eventTypes(store); !slices.Equal(got, tt.events) {
+				t.Errorf("events = %v, want %v", got, tt.events)
+			}
+		})
+	}
+}
+
+func TestRegionService_UpdateRegion(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		req    dto.UpdateRegionRequest
+		status int
+		events []string
+	}{
+		{"renamed", "R01", dto.UpdateRegionRequest{RegionName: "Incheon", MaxCapacity: 5}, http.StatusOK, []string{outbox.RegionUpdated}},
+		{"became full", "R01", dto.UpdateRegionRequest{RegionName: "Seoul", IsFull: true}, http.StatusOK, []string{outbox.RegionUpdated, outbox.RegionSaturated}},
+		{"missing", "R99", dto.UpdateRegionRequest{RegionName: "Nowhere"}, http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := repository.NewMemoryStore()
+			seed(t, store, &models.Region{RegionID: "R01", RegionName: "Seoul"})
+			svc := NewRegionService(store, nil)
+
+			region, err := svc.UpdateRegion(context.Background(), tt.id, tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err == nil {
+				stored, _ := svc.GetRegionByID(context.Background(), tt.id)
+				if stored.RegionName != tt.req.RegionName || stored.IsFull != tt.req.IsFull || region.RegionName != tt.req.RegionName {
+					t.Errorf("stored = %+v", stored)
+				}
+			}
+			if got := eventTypes(store); !slices.Equal(got, tt.events) {
+				t.Errorf("events = %v, want %v", got, tt.events)
+			}
+		})
+	}
+}
+
+func TestRegionService_MarkFull(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		status int
+		events []string
+	}{
+		{"saturates", "R01", http.StatusOK, []string{outbox.RegionUpdated, outbox.RegionSaturated}},
+		{"already full", "R02", http.StatusOK, nil},
+		{"missing", "R99", http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := repository.NewMemoryStore()
+			seed(t, store,
+				&models.Region{RegionID: "R01", RegionName: "Seoul"},
+				&models.Region{RegionID: "R02", RegionName: "Busan", IsFull: true})
+			svc := NewRegionService(store, nil)
+
+			region, err := svc.MarkFull(context.Background(), tt.id)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err == nil && !region.IsFull {
+				t.Errorf("region not full: %+v", region)
+			}
+			if got := eventTypes(store); !slices.Equal(got, tt.events) {
+				t.Errorf("events = %v, want %v", got, tt.events)
+			}
+		})
+	}
+}
+
+func TestRegionService_DeleteRegion(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     string
+		status int
+	}{
+		{"deleted", "R02", http.StatusOK},
+		{"has packages", "R01", http.StatusConflict},
+		{"missing", "R99", http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := repository.NewMemoryStore()
+			seed(t, store,
+				&models.Region{RegionID: "R01", RegionName: "Seoul"},
+				&models.Region{RegionID: "R02", RegionName: "Busan"},
+				&models.Package{PackageType: "box", RegionID: "R01"})
+			svc := NewRegionService(store, nil)
+
+			err := svc.DeleteRegion(context.Background(), tt.id)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			_, getErr := svc.GetRegionByID(context.Background(), tt.id)
+			if deleted := getErr != nil; deleted != (tt.status != http.StatusConflict) {
+				t.Errorf("deleted = %v after status %d", deleted, tt.status)
+			}
+		})
+	}
+}
+
+func TestRegionService_PageRegions(t *testing.T) {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul", MaxCapacity: 3},
+		&models.Region{RegionID: "R02", RegionName: "Busan", MaxCapacity: 1},
+		&models.Region{RegionID: "R03", RegionName: "Daegu", MaxCapacity: 2, IsFull: true})
+	svc := NewRegionService(store, nil)
+
+	tests := []struct {
+		name   string
+		params map[string]string
+		sort   string
+		page   dto.PageRequest
+		want   []string
+		total  int64
+	}{
+		{"all ascending", nil, "region_id", dto.PageRequest{Limit: 10}, []string{"R01", "R02", "R03"}, 3},
+		{"descending capacity", nil, "-max_capacity", dto.PageRequest{Limit: 10}, []string{"R01", "R03", "R02"}, 3},
+		{"second page", nil, "region_id", dto.PageRequest{Limit: 2, Offset: 2}, []string{"R03"}, 3},
+		{"filtered", map[string]string{"is_full": "1"}, "", dto.PageRequest{Limit: 10}, []string{"R03"}, 1},
+		{"unknown sort ignored", map[string]string{"region_name": "Busan"}, "password", dto.PageRequest{Limit: 10}, []string{"R02"}, 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			regions, total, err := svc.PageRegions(context.Background(), tt.params, tt.sort, tt.page)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []string
+			for _, r := range regions {
+				got = append(got, r.RegionID)
+			}
+			if !slices.Equal(got, tt.want) || total != tt.total {
+				t.Errorf("got %v (total %d), want %v (total %d)", got, total, tt.want, tt.total)
+			}
+		})
+	}
+}
+
+// regionIDs ListRegions 결과의 지역 ID
+func regionIDs(t *testing.T, svc *RegionService) []string {
+	t.Helper()
+	regions, err := svc.ListRegions(context.Background(), "region_id")
+	if err != nil {
+		t.Fatal(err)
+	}
+	var ids []string
+	for _, r := range regions {
+		ids = append(ids, r.RegionID)
+	}
+	return ids
+}
+
+func TestRegionService_ReadCache(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name  string
+		write func(svc *RegionService) error
+		want  []string
+	}{
+		{"create", func(svc *RegionService) error {
+			_, err := svc.CreateRegion(ctx, dto.CreateRegionRequest{RegionID: "R02", RegionName: "Busan"})
+			return err
+		}, []string{"R01", "R02"}},
+		{"delete", func(svc *RegionService) error {
+			return svc.DeleteRegion(ctx, "R01")
+		}, nil},
+		{"failed write keeps cache", func(svc *RegionService) error {
+			_, err := svc.CreateRegion(ctx, dto.CreateRegionRequest{RegionID: "R01", RegionName: "Seoul"})
+			if err == nil {
+				t.Error("duplicate region created")
+			}
+			return nil
+		}, []string{"R01"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := repository.NewMemoryStore()
+			seed(t, store, &models.Region{RegionID: "R01", RegionName: "Seoul"})
+			svc := NewRegionService(store, cache.New(cache.NewMemoryStore(10), time.Minute))
+
+			if got := regionIDs(t, svc); !slices.Equal(got, []string{"R01"}) {
+				t.Fatalf("before write: got %v", got)
+			}
+			if err := tt.write(svc); err != nil {
+				t.Fatal(err)
+			}
+			if got := regionIDs(t, svc); !slices.Equal(got, tt.want) {
+				t.Errorf("after write: got %v, want %v", got, tt.want)
+			}
+		})
+	}
+
+	t.Run("served from cache", func(t *testing.T) {
+		store := repository.NewMemoryStore()
+		seed(t, store, &models.Region{RegionID: "R01", RegionName: "Seoul"})
+		svc := NewRegionService(store, cache.New(cache.NewMemoryStore(10), time.Minute))
+		regionIDs(t, svc)
+		// 서비스를 거치지 않은 변경은 TTL 이 지날 때까지 보이지 않음
+		seed(t, store, &models.Region{RegionID: "R02", RegionName: "Busan"})
+		if got := regionIDs(t, svc); !slices.Equal(got, []string{"R01"}) {
+			t.Errorf("got %v, want cached [R01]", got)
+		}
+	})
+}
+
+// laggingReplica 복제본이 아직 변경을 받지 못한 상태
+type laggingReplica struct {
+	*repository.MemoryStore
+	replica *repository.MemoryStore
+}
+
+func (s laggingReplica) Replica() repository.Store {
+	return s.replica
+}
+
+func TestRegionService_ListSource(t *testing.T) {
+	tests := []struct {
+		name  string
+		cache *cache.Cache
+		want  []string
+	}{
+		// 캐시에 채우는 목록은 주 DB 에서 읽어 복제 지연으로 옛 값이 남지 않게 함
+		{"cached reads primary", cache.New(cache.NewMemoryStore(10), time.Minute), []string{"R01", "R02"}},
+		{"uncached reads replica", nil, []string{"R01"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			primary, replica := repository.NewMemoryStore(), repository.NewMemoryStore()
+			seed(t, primary, &models.Region{RegionID: "R01", RegionName: "Seoul"}, &models.Region{RegionID: "R02", RegionName: "Busan"})
+			seed(t, replica, &models.Region{RegionID: "R01", RegionName: "Seoul"})
+			svc := NewRegionService(laggingReplica{primary, replica}, tt.cache)
+			if got := regionIDs(t, svc); !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRegionService_SharedCacheInvalidation(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	seed(t, store, &models.Region{RegionID: "R01", RegionName: "Seoul"})
+	// 두 인스턴스가 같은 캐시 저장소(Redis)를 쓰는 경우
+	shared := cache.NewMemoryStore(10)
+	a := NewRegionService(store, cache.New(shared, time.Minute))
+	b := NewRegionService(store, cache.New(shared, time.Minute))
+
+	regionIDs(t, b)
+	if _, err := a.CreateRegion(ctx, dto.CreateRegionRequest{RegionID: "R02", RegionName: "Busan"}); err != nil {
+		t.Fatal(err)
+	}
+	if got := regionIDs(t, b); !slices.Equal(got, []string{"R01", "R02"}) {
+		t.Errorf("other instance got %v after invalidation", got)
+	}
+}
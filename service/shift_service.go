@@ -0,0 +1,234 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/utils"
+)
+
+// driverPosition 차량을 배정받을 수 있는 직급
+const driverPosition = "운송직"
+
+type ShiftService struct {
+	store repository.Store
+}
+
+func NewShiftService(store repository.Store) *ShiftService {
+	return &ShiftService{store: store}
+}
+
+// CreateShift 근무를 등록. 같은 차량 또는 같은 직원의 근무 시간이 겹치면 거부
+func (s *ShiftService) CreateShift(ctx context.Context, req dto.CreateShiftRequest, createdBy int) (*dto.ShiftResponse, error) {
+	ctx, span := tracer.Start(ctx, "ShiftService.CreateShift")
+	defer span.End()
+
+	start := time.Now()
+	if req.StartTime != nil {
+		t := utils.ParseTimePtr(req.StartTime)
+		if t == nil {
+			return nil, apperror.Validation([]apperror.FieldError{{Field: "start_time", Rule: "datetime", Message: "must be an RFC3339 timestamp"}})
+		}
+		start = *t
+	}
+	var end *time.Time
+	if req.EndTime != nil {
+		if end = utils.ParseTimePtr(req.EndTime); end == nil {
+			return nil, apperror.Validation([]apperror.FieldError{{Field: "end_time", Rule: "datetime", Message: "must be an RFC3339 timestamp"}})
+		}
+		if !end.After(start) {
+			return nil, apperror.Validation([]apperror.FieldError{{Field: "end_time", Rule: "gtfield", Message: "must be after start_time"}})
+		}
+	}
+
+	shift := models.Shift{
+		EmployeeID: req.EmployeeID,
+		StartTime:  start,
+		EndTime:    end,
+		CreatedBy:  createdBy,
+	}
+	err := s.store.Transaction(ctx, func(tx repository.Store) error {
+		// 직원과 차량 행을 잠가 같은 대상에 대한 동시 배정을 직렬화
+		emp, err := tx.Employees().Lock(ctx, req.EmployeeID)
+		if err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return apperror.InvalidReference("shift").WithDetail("employee %d does not exist", req.EmployeeID)
+			}
+			return err
+		}
+		if emp.Position != driverPosition || !emp.IsActive {
+			return apperror.ErrInvalidDriver.WithDetail("employee %d must be an active %s", emp.EmployeeID, driverPosition)
+		}
+
+		switch {
+		case req.VehicleID != nil && *req.VehicleID != "":
+			shift.VehicleID = *req.VehicleID
+		case emp.AssignedVehicleID != nil:
+			shift.VehicleID = *emp.AssignedVehicleID
+		default:
+			return apperror.Validation([]apperror.FieldError{{Field: "vehicle_id", Rule: "required", Message: "is required when the employee has no assigned vehicle"}})
+		}
+		if _, err := tx.Vehicles().LockByVehicleID(ctx, shift.VehicleID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return apperror.InvalidReference("shift").WithDetail("vehicle %s does not exist", shift.VehicleID)
+			}
+			return err
+		}
+
+		conflict, err := tx.Shifts().FindOverlap(ctx, shift.VehicleID, shift.EmployeeID, start, end)
+		if err == nil {
+			return apperror.ErrShiftOverlap.WithDetail("shift %d already assigns vehicle %s / employee %d in that period",
+				conflict.ShiftID, conflict.VehicleID, conflict.EmployeeID)
+		}
+		if !errors.Is(err, repository.ErrNotFound) {
+			return err
+		}
+		return tx.Shifts().Create(ctx, &shift)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toShiftResponse(&shift), nil
+}
+
+func (s *ShiftService) GetShiftByID(ctx context.Context, id int) (*dto.ShiftResponse, error) {
+	ctx, span := tracer.Start(ctx, "ShiftService.GetShiftByID")
+	defer span.End()
+	shift, err := s.store.Shifts().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toShiftResponse(shift), nil
+}
+
+// ListShifts employee_id, vehicle_id 로 필터링. active 가 true 면 현재 진행 중인 근무만
+func (s *ShiftService) ListShifts(ctx context.Context, params map[string]string, active bool) ([]dto.ShiftResponse, error) {
+	ctx, span := tracer.Start(ctx, "ShiftService.ListShifts")
+	defer span.End()
+	var activeAt *time.Time
+	if active {
+		now := time.Now()
+		activeAt = &now
+	}
+	shifts, err := s.store.Shifts().List(ctx, params, activeAt)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]dto.ShiftResponse, 0, len(shifts))
+	for _, sh := range shifts {
+		res = append(res, *toShiftResponse(&sh))
+	}
+	return res, nil
+}
+
+// EndShift 진행 중이거나 예정된 근무를 지금 종료
+func (s *ShiftService) EndShift(ctx context.Context, id int) (*dto.ShiftResponse, error) {
+	ctx, span := tracer.Start(ctx, "ShiftService.EndShift")
+	defer span.End()
+	shift, err := s.store.Shifts().Get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	if shift.EndTime != nil && !shift.EndTime.After(now) {
+		return nil, apperror.ErrShiftEnded
+	}
+	// 아직 시작하지 않은 근무는 시작 시각으로 종료해 길이 0 으로 만듦
+	end := now
+	if shift.StartTime.After(now) {
+		end = shift.StartTime
+	}
+	shift.EndTime = &end
+	if err := s.store.Shifts().Save(ctx, shift); err != nil {
+		return nil, err
+	}
+	return toShiftResponse(shift), nil
+}
+
+func (s *ShiftService) DeleteShift(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "ShiftService.DeleteShift")
+	defer span.End()
+	return s.store.Shifts().Delete(ctx, id)
+}
+
+// CurrentShift 직원의 현재 진행 중인 근무
+func (s *ShiftService) CurrentShift(ctx context.Context, employeeID int) (*dto.ShiftResponse, error) {
+	ctx, span := tracer.Start(ctx, "ShiftService.CurrentShift")
+	defer span.End()
+	shift, err := s.store.Shifts().ActiveForEmployee(ctx, employeeID, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	return toShiftResponse(shift), nil
+}
+
+// EmployeeTrips 직원이 운전한 A/B 차량 운행 로그
+func (s *ShiftService) EmployeeTrips(ctx context.Context, employeeID int) (*dto.EmployeeTripsResponse, error) {
+	ctx, span := tracer.Start(ctx, "ShiftService.EmployeeTrips")
+	defer span.End()
+	if _, err := s.store.Employees().Get(ctx, employeeID); err != nil {
+		return nil, err
+	}
+	trips, err := s.store.TripLogs().ListByDriver(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	tripsB, err := s.store.TripLogs().ListBByDriver(ctx, employeeID)
+	if err != nil {
+		return nil, err
+	}
+	res := &dto.EmployeeTripsResponse{
+		EmployeeID: employeeID,
+		TripsA:     make([]dto.TripLogResponse, 0, len(trips)),
+		TripsB:     make([]dto.TripLogBResponse, 0, len(tripsB)),
+	}
+	for _, t := range trips {
+		res.TripsA = append(res.TripsA, *toTripLogResponse(&t))
+	}
+	for _, t := range tripsB {
+		res.TripsB = append(res.TripsB, *toTripLogBResponse(&t))
+	}
+	return res, nil
+}
+
+// driverOnShift at 시각에 차량을 운전 중인 직원. 근무가 없으면 nil
+func driverOnShift(ctx context.Context, store repository.Store, vehicleID string, at time.Time) (*int, error) {
+	shift, err := store.Shifts().ActiveForVehicle(ctx, vehicleID, at)
+	if errors.Is(err, repository.ErrNotFound) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &shift.EmployeeID, nil
+}
+
+func toShiftResponse(m *models.Shift) *dto.ShiftResponse {
+	return &dto.ShiftResponse{
+		ShiftID:    m.ShiftID,
+		EmployeeID: m.EmployeeID,
+		VehicleID:  m.VehicleID,
+		StartTime:  m.StartTime.Format(time.RFC3339),
+		EndTime:    utils.FormatTimePtr(m.EndTime),
+		CreatedBy:  m.CreatedBy,
+	}
+}
+
+func toTripLogBResponse(m *models.TripLogB) *dto.TripLogBResponse {
+	return &dto.TripLogBResponse{
+		TripID:       m.TripID,
+		VehicleID:    m.VehicleID,
+		DriverID:     m.DriverID,
+		StartTime:    utils.FormatTimePtr(m.StartTime),
+		EndTime:      utils.FormatTimePtr(m.EndTime),
+		Status:       m.Status,
+		Destination1: m.Destination1,
+		Destination2: m.Destination2,
+		Destination3: m.Destination3,
+	}
+}
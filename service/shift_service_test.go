@@ -0,0 +1,177 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+func newShiftStore(t *testing.T) *repository.MemoryStore {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Vehicle{VehicleID: "A01"},
+		&models.Vehicle{VehicleID: "B01"},
+		&models.Employee{Position: "관리직", IsActive: true, Name: "Kim"},
+		&models.Employee{Position: driverPosition, IsActive: true, Name: "Lee", AssignedVehicleID: ptr("A01")},
+		&models.Employee{Position: driverPosition, IsActive: true, Name: "Park"},
+		&models.Employee{Position: driverPosition, IsActive: false, Name: "Choi"})
+	return store
+}
+
+func TestShiftService_CreateShift(t *testing.T) {
+	at := func(h int) *string {
+		s := time.Date(2026, 3, 2, h, 0, 0, 0, time.UTC).Format(time.RFC3339)
+		return &s
+	}
+	tests := []struct {
+		name        string
+		req         dto.CreateShiftRequest
+		status      int
+		wantVehicle string
+	}{
+		{"assigned vehicle", dto.CreateShiftRequest{EmployeeID: 2, StartTime: at(9), EndTime: at(12)}, http.StatusOK, "A01"},
+		{"explicit vehicle", dto.CreateShiftRequest{EmployeeID: 3, VehicleID: ptr("B01"), StartTime: at(9), EndTime: at(12)}, http.StatusOK, "B01"},
+		{"after existing shift", dto.CreateShiftRequest{EmployeeID: 3, VehicleID: ptr("A01"), StartTime: at(18)}, http.StatusOK, "A01"},
+		{"vehicle overlap", dto.CreateShiftRequest{EmployeeID: 3, VehicleID: ptr("A01"), StartTime: at(13), EndTime: at(15)}, http.StatusConflict, ""},
+		{"employee overlap", dto.CreateShiftRequest{EmployeeID: 2, VehicleID: ptr("B01"), StartTime: at(16)}, http.StatusConflict, ""},
+		{"no vehicle", dto.CreateShiftRequest{EmployeeID: 3, StartTime: at(9)}, http.StatusBadRequest, ""},
+		{"not a driver", dto.CreateShiftRequest{EmployeeID: 1, VehicleID: ptr("A01"), StartTime: at(9)}, http.StatusUnprocessableEntity, ""},
+		{"inactive driver", dto.CreateShiftRequest{EmployeeID: 4, VehicleID: ptr("B01"), StartTime: at(9)}, http.StatusUnprocessableEntity, ""},
+		{"unknown employee", dto.CreateShiftRequest{EmployeeID: 99, VehicleID: ptr("A01")}, http.StatusUnprocessableEntity, ""},
+		{"unknown vehicle", dto.CreateShiftRequest{EmployeeID: 3, VehicleID: ptr("Z99")}, http.StatusUnprocessableEntity, ""},
+		{"end before start", dto.CreateShiftRequest{EmployeeID: 3, VehicleID: ptr("B01"), StartTime: at(12), EndTime: at(9)}, http.StatusBadRequest, ""},
+		{"bad start time", dto.CreateShiftRequest{EmployeeID: 3, VehicleID: ptr("B01"), StartTime: ptr("tomorrow")}, http.StatusBadRequest, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newShiftStore(t)
+			// 직원 2 가 A01 을 12시부터 17시까지 운전
+			seed(t, store, &models.Shift{EmployeeID: 2, VehicleID: "A01", CreatedBy: 1,
+				StartTime: time.Date(2026, 3, 2, 12, 0, 0, 0, time.UTC),
+				EndTime:   ptr(time.Date(2026, 3, 2, 17, 0, 0, 0, time.UTC))})
+
+			shift, err := NewShiftService(store).CreateShift(context.Background(), tt.req, 1)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err == nil && (shift.VehicleID != tt.wantVehicle || shift.CreatedBy != 1) {
+				t.Errorf("shift = %+v", shift)
+			}
+		})
+	}
+}
+
+func TestShiftService_EndShift(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		shift  models.Shift
+		status int
+	}{
+		{"running", models.Shift{StartTime: now.Add(-time.Hour)}, http.StatusOK},
+		{"scheduled", models.Shift{StartTime: now.Add(time.Hour)}, http.StatusOK},
+		{"already ended", models.Shift{StartTime: now.Add(-2 * time.Hour), EndTime: ptr(now.Add(-time.Hour))}, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newShiftStore(t)
+			tt.shift.EmployeeID, tt.shift.VehicleID = 2, "A01"
+			seed(t, store, &tt.shift)
+			svc := NewShiftService(store)
+
+			shift, err := svc.EndShift(context.Background(), 1)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			if _, err := svc.CurrentShift(context.Background(), 2); statusOf(err) != http.StatusNotFound {
+				t.Errorf("shift %+v still current (err %v)", shift, err)
+			}
+		})
+	}
+}
+
+func TestShiftService_ListShifts(t *testing.T) {
+	store := newShiftStore(t)
+	now := time.Now()
+	seed(t, store,
+		&models.Shift{EmployeeID: 2, VehicleID: "A01", StartTime: now.Add(-3 * time.Hour), EndTime: ptr(now.Add(-2 * time.Hour))},
+		&models.Shift{EmployeeID: 2, VehicleID: "A01", StartTime: now.Add(-time.Hour)},
+		&models.Shift{EmployeeID: 3, VehicleID: "B01", StartTime: now.Add(time.Hour)})
+	svc := NewShiftService(store)
+
+	tests := []struct {
+		name   string
+		params map[string]string
+		active bool
+		want   []int
+	}{
+		{"all newest first", nil, false, []int{3, 2, 1}},
+		{"by employee", map[string]string{"employee_id": "2"}, false, []int{2, 1}},
+		{"by vehicle", map[string]string{"vehicle_id": "B01"}, false, []int{3}},
+		{"active only", nil, true, []int{2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			shifts, err := svc.ListShifts(context.Background(), tt.params, tt.active)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int
+			for _, s := range shifts {
+				got = append(got, s.ShiftID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestShiftService_EmployeeTrips(t *testing.T) {
+	store := newShiftStore(t)
+	seed(t, store,
+		&models.TripLog{VehicleID: "A01", DriverID: ptr(2), Status: "비운행중"},
+		&models.TripLog{VehicleID: "B01", DriverID: ptr(3), Status: "비운행중"},
+		&models.TripLog{VehicleID: "A01", DriverID: ptr(2), Status: "운행중"})
+	store.AddTripLogB(models.TripLogB{VehicleID: "B01", DriverID: ptr(2), Status: "비운행중"})
+	svc := NewShiftService(store)
+
+	tests := []struct {
+		name   string
+		id     int
+		status int
+		wantA  []int
+		wantB  int
+	}{
+		{"driver with trips", 2, http.StatusOK, []int{3, 1}, 1},
+		{"driver without trips", 4, http.StatusOK, nil, 0},
+		{"missing", 99, http.StatusNotFound, nil, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res, err := svc.EmployeeTrips(context.Background(), tt.id)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			var gotA []int
+			for _, tr := range res.TripsA {
+				gotA = append(gotA, tr.TripID)
+			}
+			if !slices.Equal(gotA, tt.wantA) || len(res.TripsB) != tt.wantB {
+				t.Errorf("trips A = %v, B = %d; want %v, %d", gotA, len(res.TripsB), tt.wantA, tt.wantB)
+			}
+		})
+	}
+}
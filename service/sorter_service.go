@@ -0,0 +1,142 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/baboyiban/go-api-server/cache"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/metrics"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+type SorterService struct {
+	store   repository.Store
+	cache   *cache.Cache
+	timeout time.Duration
+}
+
+// NewSorterService c 는 적재량이 바뀐 지역을 비울 조회 캐시 (nil 이면 캐시 없음),
+// timeout 은 스캔 하나의 처리 한도 (0 이면 요청의 context 만 따름)
+func NewSorterService(store repository.Store, c *cache.Cache, timeout time.Duration) *SorterService {
+	return &SorterService{store: store, cache: c, timeout: timeout}
+}
+
+// Scan 분류기가 인식한 패키지를 보낼 적재함을 결정. 투입 가능하면 패키지를 투입됨으로 바꾸고 지역 적재량을 늘리며,
+// 결정과 상태 변경, package.scanned 이벤트를 한 트랜잭션으로 기록. 처리 한도를 넘기면 아무것도 기록하지 않고 DATABASE_TIMEOUT
+func (s *SorterService) Scan(ctx context.Context, req dto.SorterScanRequest) (*dto.SorterScanResponse, error) {
+	ctx, span := tracer.Start(ctx, "SorterService.Scan")
+	defer span.End()
+	start := time.Now()
+	if s.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, s.timeout)
+		defer cancel()
+	}
+
+	var res dto.SorterScanResponse
+	var regionChanged bool
+	err := s.store.Transaction(ctx, func(tx repository.Store) error {
+		// 교착 상태로 재시도되면 처음부터 다시 결정
+		res = dto.SorterScanResponse{PackageID: req.PackageID, SorterID: req.SorterID, ScannedAt: time.Now().Format(time.RFC3339)}
+		var err error
+		regionChanged, err = decideBin(ctx, tx, &res)
+		if err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.PackageScanned, outbox.AggregatePackage, strconv.Itoa(req.PackageID), res)
+	})
+	if err != nil {
+		metrics.ObserveSorterScan("error", time.Since(start))
+		return nil, err
+	}
+	if regionChanged {
+		s.cache.Invalidate(ctx, regionCache)
+	}
+	metrics.ObserveSorterScan(res.Decision, time.Since(start))
+	return &res, nil
+}
+
+// decideBin 패키지와 지역 행을 잠그고 결정을 res 에 채움. 투입해 지역 적재량을 바꿨으면 true
+func decideBin(ctx context.Context, tx repository.Store, res *dto.SorterScanResponse) (bool, error) {
+	pkg, err := tx.Packages().Lock(ctx, res.PackageID)
+	if errors.Is(err, repository.ErrNotFound) {
+		res.Decision, res.Reason = dto.SortReject, dto.ReasonUnknownPackage
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	res.RegionID = pkg.RegionID
+
+	switch pkg.PackageStatus {
+	case packageStatusRegistered, packageStatusInbound:
+	case packageStatusInducted:
+		// 분류기가 같은 패키지를 다시 읽음. 이미 적재량에 반영되었으므로 같은 적재함으로만 보냄
+		res.Decision, res.Duplicate = dto.SortDivert, true
+		return false, nil
+	default:
+		res.Decision, res.Reason = dto.SortReject, dto.ReasonInvalidStatus
+		return false, nil
+	}
+
+	region, err := tx.Regions().Lock(ctx, pkg.RegionID)
+	if err != nil {
+		return false, err
+	}
+	if atCapacity(region) {
+		res.Decision, res.Reason, res.BinFull = dto.SortOverflow, dto.ReasonBinFull, true
+		return false, nil
+	}
+
+	pkg.PackageStatus = packageStatusInducted
+	if err := tx.Packages().Save(ctx, pkg); err != nil {
+		return false, err
+	}
+	if err := tx.Events().Record(ctx, outbox.PackageUpdated, outbox.AggregatePackage, strconv.Itoa(pkg.PackageID), pkg); err != nil {
+		return false, err
+	}
+	region.CurrentCapacity++
+	if atCapacity(region) {
+		now := time.Now()
+		region.IsFull = true
+		region.SaturatedAt = &now
+	}
+	if err := tx.Regions().Save(ctx, region); err != nil {
+		return false, err
+	}
+	if err := recordRegionUpdate(ctx, tx, region, false); err != nil {
+		return false, err
+	}
+	res.Decision, res.BinFull = dto.SortDivert, region.IsFull
+	return true, nil
+}
+
+// releaseBin 투입된 패키지가 적재함을 떠나 지역 적재량을 하나 줄임. 최대 용량 아래로 내려가면 포화 표시를 해제해
+// 분류기가 다시 투입할 수 있게 함. 최대 용량이 0 인 지역의 포화 표시는 운영자가 직접 해제
+func releaseBin(ctx context.Context, tx repository.Store, regionID string) error {
+	region, err := tx.Regions().Lock(ctx, regionID)
+	if err != nil {
+		return err
+	}
+	if region.CurrentCapacity > 0 {
+		region.CurrentCapacity--
+	}
+	if region.IsFull && region.MaxCapacity > 0 && region.CurrentCapacity < region.MaxCapacity {
+		region.IsFull = false
+		region.SaturatedAt = nil
+	}
+	if err := tx.Regions().Save(ctx, region); err != nil {
+		return err
+	}
+	return recordRegionUpdate(ctx, tx, region, true)
+}
+
+// atCapacity 포화로 표시되었거나 최대 용량에 도달함. 최대 용량이 0 이면 포화 표시만 봄
+func atCapacity(region *models.Region) bool {
+	return region.IsFull || (region.MaxCapacity > 0 && region.CurrentCapacity >= region.MaxCapacity)
+}
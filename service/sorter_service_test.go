@@ -0,0 +1,172 @@
+package service
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/cache"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+func newSorterStore(t *testing.T) *repository.MemoryStore {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Region{RegionID: "R01", RegionName: "Seoul", MaxCapacity: 2},
+		&models.Region{RegionID: "R02", RegionName: "Busan", MaxCapacity: 2, CurrentCapacity: 1},
+		&models.Region{RegionID: "R03", RegionName: "Daegu", IsFull: true},
+		&models.Package{PackageType: "box", RegionID: "R01", PackageStatus: packageStatusRegistered},
+		&models.Package{PackageType: "box", RegionID: "R02", PackageStatus: packageStatusInbound},
+		&models.Package{PackageType: "box", RegionID: "R03", PackageStatus: packageStatusRegistered},
+		&models.Package{PackageType: "bag", RegionID: "R01", PackageStatus: packageStatusInducted},
+		&models.Package{PackageType: "bag", RegionID: "R02", PackageStatus: packageStatusCompleted})
+	return store
+}
+
+func TestSorterService_Scan(t *testing.T) {
+	tests := []struct {
+		name       string
+		packageID  int
+		want       dto.SorterScanResponse
+		wantStatus string // 스캔 뒤 패키지 상태 (없는 패키지면 비움)
+		capacity   int    // 스캔 뒤 패키지 지역의 적재량
+		events     []string
+	}{
+		{"divert", 1,
+			dto.SorterScanResponse{Decision: dto.SortDivert, RegionID: "R01"},
+			packageStatusInducted, 1,
+			[]string{outbox.PackageUpdated, outbox.RegionUpdated, outbox.PackageScanned}},
+		{"divert fills bin", 2,
+			dto.SorterScanResponse{Decision: dto.SortDivert, RegionID: "R02", BinFull: true},
+			packageStatusInducted, 2,
+			[]string{outbox.PackageUpdated, outbox.RegionUpdated, outbox.RegionSaturated, outbox.PackageScanned}},
+		{"bin full", 3,
+			dto.SorterScanResponse{Decision: dto.SortOverflow, RegionID: "R03", Reason: dto.ReasonBinFull, BinFull: true},
+			packageStatusRegistered, 0,
+			[]string{outbox.PackageScanned}},
+		{"rescanned", 4,
+			dto.SorterScanResponse{Decision: dto.SortDivert, RegionID: "R01", Duplicate: true},
+			packageStatusInducted, 0,
+			[]string{outbox.PackageScanned}},
+		{"already delivered", 5,
+			dto.SorterScanResponse{Decision: dto.SortReject, RegionID: "R02", Reason: dto.ReasonInvalidStatus},
+			packageStatusCompleted, 1,
+			[]string{outbox.PackageScanned}},
+		{"unknown package", 99,
+			dto.SorterScanResponse{Decision: dto.SortReject, Reason: dto.ReasonUnknownPackage},
+			"", 0,
+			[]string{outbox.PackageScanned}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := newSorterStore(t)
+			svc := NewSorterService(store, nil, time.Second)
+
+			res, err := svc.Scan(ctx, dto.SorterScanRequest{PackageID: tt.packageID, SorterID: "S1"})
+			if err != nil {
+				t.Fatal(err)
+			}
+			tt.want.PackageID, tt.want.SorterID, tt.want.ScannedAt = tt.packageID, "S1", res.ScannedAt
+			if *res != tt.want {
+				t.Errorf("got %+v, want %+v", *res, tt.want)
+			}
+			if got := eventTypes(store); !slices.Equal(got, tt.events) {
+				t.Errorf("events = %v, want %v", got, tt.events)
+			}
+			if tt.wantStatus == "" {
+				return
+			}
+			pkg, _ := store.Packages().Get(ctx, tt.packageID)
+			if pkg.PackageStatus != tt.wantStatus {
+				t.Errorf("package status = %q, want %q", pkg.PackageStatus, tt.wantStatus)
+			}
+			region, _ := store.Regions().Get(ctx, pkg.RegionID)
+			if region.CurrentCapacity != tt.capacity || region.IsFull != res.BinFull {
+				t.Errorf("region capacity = %d (full %v), want %d (full %v)", region.CurrentCapacity, region.IsFull, tt.capacity, res.BinFull)
+			}
+		})
+	}
+}
+
+func TestSorterService_ScanInvalidatesRegionCache(t *testing.T) {
+	ctx := context.Background()
+	store := newSorterStore(t)
+	readCache := cache.New(cache.NewMemoryStore(10), time.Minute)
+	regions := NewRegionService(store, readCache)
+	if _, err := regions.GetRegionByID(ctx, "R01"); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := NewSorterService(store, readCache, time.Second).Scan(ctx, dto.SorterScanRequest{PackageID: 1}); err != nil {
+		t.Fatal(err)
+	}
+	region, err := regions.GetRegionByID(ctx, "R01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if region.CurrentCapacity != 1 {
+		t.Errorf("cached region not invalidated: current_capacity = %d", region.CurrentCapacity)
+	}
+}
+
+func TestSorterService_ReleasesCapacity(t *testing.T) {
+	tests := []struct {
+		name    string
+		release func(svc *PackageService, id int) error // 투입된 패키지를 적재함에서 빼는 방법
+	}{
+		{"advanced", func(svc *PackageService, id int) error {
+			_, err := svc.UpdatePackage(context.Background(), id, dto.UpdatePackageRequest{PackageStatus: "B차운송중"})
+			return err
+		}},
+		{"deleted", func(svc *PackageService, id int) error {
+			return svc.DeletePackage(context.Background(), id)
+		}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			store := repository.NewMemoryStore()
+			seed(t, store,
+				&models.Region{RegionID: "R01", RegionName: "Seoul", MaxCapacity: 1},
+				&models.Package{PackageType: "box", RegionID: "R01", PackageStatus: packageStatusRegistered},
+				&models.Package{PackageType: "bag", RegionID: "R01", PackageStatus: packageStatusRegistered})
+			sorter := NewSorterService(store, nil, time.Second)
+			packages := NewPackageService(store, nil)
+			scan := func(id int) *dto.SorterScanResponse {
+				t.Helper()
+				res, err := sorter.Scan(ctx, dto.SorterScanRequest{PackageID: id, SorterID: "S1"})
+				if err != nil {
+					t.Fatal(err)
+				}
+				return res
+			}
+
+			if res := scan(1); res.Decision != dto.SortDivert || !res.BinFull {
+				t.Fatalf("first induct = %+v, want divert filling the bin", res)
+			}
+			if res := scan(2); res.Decision != dto.SortOverflow {
+				t.Fatalf("scan into full bin = %+v, want overflow", res)
+			}
+
+			if err := tt.release(packages, 1); err != nil {
+				t.Fatal(err)
+			}
+			region, err := store.Regions().Get(ctx, "R01")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if region.CurrentCapacity != 0 || region.IsFull || region.SaturatedAt != nil {
+				t.Errorf("region after release = %+v, want empty and not full", region)
+			}
+
+			if res := scan(2); res.Decision != dto.SortDivert || !res.BinFull {
+				t.Errorf("induct after release = %+v, want divert", res)
+			}
+		})
+	}
+}
@@ -0,0 +1,6 @@
+package service
+
+import "go.opentelemetry.io/otel"
+
+// tracer 서비스 메서드 단위 스팬 생성용
+var tracer = otel.Tracer("github.com/baboyiban/go-api-server/service")
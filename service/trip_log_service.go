@@ -2,55 +2,30 @@ package service
 
 import (
 	"context"
+	"strconv"
+	"time"
 
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
 	"github.com/baboyiban/go-api-server/utils"
-	"gorm.io/gorm"
 )
 
-var allowedTripLogSortFields = map[string]bool{
-	"trip_id":     true,
-	"vehicle_id":  true,
-	"start_time":  true,
-	"end_time":    true,
-	"status":      true,
-	"destination": true,
-}
-
-func applyTripLogSort(query *gorm.DB, sort string) *gorm.DB {
-	if sort == "" {
-		return query
-	}
-	field := sort
-	desc := false
-	if sort[0] == '-' {
-		field = sort[1:]
-		desc = true
-	}
-	if allowedTripLogSortFields[field] {
-		order := field
-		if desc {
-			order += " DESC"
-		} else {
-			order += " ASC"
-		}
-		return query.Order(order)
-	}
-	return query
-}
-
 type TripLogService struct {
-	db *gorm.DB
+	store repository.Store
 }
 
-func NewTripLogService(db *gorm.DB) *TripLogService {
-	return &TripLogService{db: db}
+func NewTripLogService(store repository.Store) *TripLogService {
+	return &TripLogService{store: store}
 }
 
 func (s *TripLogService) CreateTripLog(ctx context.Context, req dto.CreateTripLogRequest) (*dto.TripLogResponse, error) {
+	ctx, span := tracer.Start(ctx, "TripLogService.CreateTripLog")
+	defer span.End()
 	trip := models.TripLog{
 		VehicleID:   req.VehicleID,
+		DriverID:    req.DriverID,
 		StartTime:   utils.ParseTimePtr(req.StartTime),
 		EndTime:     utils.ParseTimePtr(req.EndTime),
 		Status:      req.Status,
@@ -59,53 +34,84 @@ func (s *TripLogService) CreateTripLog(ctx context.Context, req dto.CreateTripLo
 	if trip.Status == "" {
 		trip.Status = "비운행중"
 	}
-	if err := s.db.WithContext(ctx).Create(&trip).Error; err != nil {
+	if trip.DriverID == nil {
+		// 운전자를 지정하지 않으면 운행 시작 시점에 차량을 배정받은 근무자로 기록
+		at := time.Now()
+		if trip.StartTime != nil {
+			at = *trip.StartTime
+		}
+		driverID, err := driverOnShift(ctx, s.store, trip.VehicleID, at)
+		if err != nil {
+			return nil, err
+		}
+		trip.DriverID = driverID
+	}
+	err := s.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.TripLogs().Create(ctx, &trip); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.TripLogCreated, outbox.AggregateTripLog, strconv.Itoa(trip.TripID), toTripLogResponse(&trip))
+	})
+	if err != nil {
 		return nil, err
 	}
 	return toTripLogResponse(&trip), nil
 }
 
 func (s *TripLogService) GetTripLogByID(ctx context.Context, id int) (*dto.TripLogResponse, error) {
-	var trip models.TripLog
-	if err := s.db.WithContext(ctx).Where("trip_id = ?", id).First(&trip).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "TripLogService.GetTripLogByID")
+	defer span.End()
+	trip, err := s.store.TripLogs().Get(ctx, id)
+	if err != nil {
 		return nil, err
 	}
-	return toTripLogResponse(&trip), nil
+	return toTripLogResponse(trip), nil
 }
 
 func (s *TripLogService) DeleteTripLog(ctx context.Context, id int) error {
-	result := s.db.WithContext(ctx).Where("trip_id = ?", id).Delete(&models.TripLog{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return nil
+	ctx, span := tracer.Start(ctx, "TripLogService.DeleteTripLog")
+	defer span.End()
+	return s.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.TripLogs().Delete(ctx, id); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.TripLogDeleted, outbox.AggregateTripLog, strconv.Itoa(id), map[string]int{"trip_id": id})
+	})
 }
 
 func (s *TripLogService) UpdateTripLog(ctx context.Context, id int, req dto.UpdateTripLogRequest) (*dto.TripLogResponse, error) {
-	var trip models.TripLog
-	if err := s.db.WithContext(ctx).Where("trip_id = ?", id).First(&trip).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "TripLogService.UpdateTripLog")
+	defer span.End()
+	trip, err := s.store.TripLogs().Get(ctx, id)
+	if err != nil {
 		return nil, err
 	}
+	if req.DriverID != nil {
+		trip.DriverID = req.DriverID
+	}
 	trip.StartTime = utils.ParseTimePtr(req.StartTime)
 	trip.EndTime = utils.ParseTimePtr(req.EndTime)
 	if req.Status != "" {
 		trip.Status = req.Status
 	}
 	trip.Destination = req.Destination
-	if err := s.db.WithContext(ctx).Save(&trip).Error; err != nil {
+	err = s.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.TripLogs().Save(ctx, trip); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.TripLogUpdated, outbox.AggregateTripLog, strconv.Itoa(trip.TripID), toTripLogResponse(trip))
+	})
+	if err != nil {
 		return nil, err
 	}
-	return toTripLogResponse(&trip), nil
+	return toTripLogResponse(trip), nil
 }
 
 func (s *TripLogService) ListTripLogs(ctx context.Context, sort string) ([]dto.TripLogResponse, error) {
-	var trips []models.TripLog
-	query := s.db.WithContext(ctx).Model(&models.TripLog{})
-	query = applyTripLogSort(query, sort)
-	if err := query.Find(&trips).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "TripLogService.ListTripLogs")
+	defer span.End()
+	trips, err := s.store.Replica().TripLogs().Find(ctx, repository.Query{Sort: sort})
+	if err != nil {
 		return nil, err
 	}
 	var res []dto.TripLogResponse
@@ -116,17 +122,10 @@ func (s *TripLogService) ListTripLogs(ctx context.Context, sort string) ([]dto.T
 }
 
 func (s *TripLogService) SearchTripLogs(ctx context.Context, params map[string]string, sort string) ([]dto.TripLogResponse, error) {
-	var trips []models.TripLog
-	query := s.db.WithContext(ctx).Model(&models.TripLog{})
-	for k, v := range params {
-		if k == "start_time" || k == "end_time" {
-			query = query.Where("DATE("+k+") = ?", v)
-		} else {
-			query = query.Where(k+" = ?", v)
-		}
-	}
-	query = applyTripLogSort(query, sort)
-	if err := query.Find(&trips).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "TripLogService.SearchTripLogs")
+	defer span.End()
+	trips, err := s.store.Replica().TripLogs().Find(ctx, repository.Query{Filters: params, Sort: sort})
+	if err != nil {
 		return nil, err
 	}
 	var res []dto.TripLogResponse
@@ -136,10 +135,52 @@ func (s *TripLogService) SearchTripLogs(ctx context.Context, params map[string]s
 	return res, nil
 }
 
+// PageTripLogs SearchTripLogs 와 같은 조건으로 한 페이지와 전체 개수를 조회
+func (s *TripLogService) PageTripLogs(ctx context.Context, params map[string]string, sort string, page dto.PageRequest) ([]dto.TripLogResponse, int64, error) {
+	ctx, span := tracer.Start(ctx, "TripLogService.PageTripLogs")
+	defer span.End()
+	trips, total, err := paginate(ctx, s.store.Replica().TripLogs(), repository.Query{Filters: params, Sort: sort, Page: page})
+	if err != nil {
+		return nil, 0, err
+	}
+	return toTripLogResponses(trips), total, nil
+}
+
+// GetTripLogsByIDs 여러 운행 기록을 한 번에 조회. 없는 ID 는 결과에서 빠짐
+func (s *TripLogService) GetTripLogsByIDs(ctx context.Context, ids []int) ([]dto.TripLogResponse, error) {
+	ctx, span := tracer.Start(ctx, "TripLogService.GetTripLogsByIDs")
+	defer span.End()
+	trips, err := s.store.TripLogs().GetMany(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	return toTripLogResponses(trips), nil
+}
+
+// ListTripLogsByVehicles 여러 차량의 운행 기록을 한 번에 조회
+func (s *TripLogService) ListTripLogsByVehicles(ctx context.Context, vehicleIDs []string) ([]dto.TripLogResponse, error) {
+	ctx, span := tracer.Start(ctx, "TripLogService.ListTripLogsByVehicles")
+	defer span.End()
+	trips, err := s.store.TripLogs().ListByVehicles(ctx, vehicleIDs)
+	if err != nil {
+		return nil, err
+	}
+	return toTripLogResponses(trips), nil
+}
+
+func toTripLogResponses(trips []models.TripLog) []dto.TripLogResponse {
+	res := make([]dto.TripLogResponse, 0, len(trips))
+	for _, t := range trips {
+		res = append(res, *toTripLogResponse(&t))
+	}
+	return res
+}
+
 func toTripLogResponse(m *models.TripLog) *dto.TripLogResponse {
 	return &dto.TripLogResponse{
 		TripID:      m.TripID,
 		VehicleID:   m.VehicleID,
+		DriverID:    m.DriverID,
 		StartTime:   utils.FormatTimePtr(m.StartTime),
 		EndTime:     utils.FormatTimePtr(m.EndTime),
 		Status:      m.Status,
@@ -0,0 +1,146 @@
+package service
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+func newTripStore(t *testing.T) *repository.MemoryStore {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Vehicle{VehicleID: "A01"},
+		&models.Vehicle{VehicleID: "B01"},
+		&models.Employee{Position: driverPosition, IsActive: true, Name: "Lee"},
+		&models.Shift{EmployeeID: 1, VehicleID: "A01", StartTime: time.Now().Add(-time.Hour)})
+	return store
+}
+
+func TestTripLogService_CreateTripLog(t *testing.T) {
+	past := time.Now().Add(-2 * time.Hour).Format(time.RFC3339)
+	tests := []struct {
+		name       string
+		req        dto.CreateTripLogRequest
+		status     int
+		wantDriver *int
+		wantStatus string
+	}{
+		{"driver from shift", dto.CreateTripLogRequest{VehicleID: "A01"}, http.StatusOK, ptr(1), "비운행중"},
+		{"explicit driver", dto.CreateTripLogRequest{VehicleID: "B01", DriverID: ptr(1), Status: "운행중"}, http.StatusOK, ptr(1), "운행중"},
+		{"no shift at start time", dto.CreateTripLogRequest{VehicleID: "A01", StartTime: &past}, http.StatusOK, nil, "비운행중"},
+		{"no shift for vehicle", dto.CreateTripLogRequest{VehicleID: "B01"}, http.StatusOK, nil, "비운행중"},
+		{"unknown vehicle", dto.CreateTripLogRequest{VehicleID: "Z99"}, http.StatusUnprocessableEntity, nil, ""},
+		{"unknown driver", dto.CreateTripLogRequest{VehicleID: "A01", DriverID: ptr(99)}, http.StatusUnprocessableEntity, nil, ""},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTripStore(t)
+			trip, err := NewTripLogService(store).CreateTripLog(context.Background(), tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			if (trip.DriverID == nil) != (tt.wantDriver == nil) || (trip.DriverID != nil && *trip.DriverID != *tt.wantDriver) {
+				t.Errorf("driver = %v, want %v", trip.DriverID, tt.wantDriver)
+			}
+			if trip.Status != tt.wantStatus {
+				t.Errorf("status = %q, want %q", trip.Status, tt.wantStatus)
+			}
+			if got := eventTypes(store); !slices.Equal(got, []string{outbox.TripLogCreated}) {
+				t.Errorf("events = %v", got)
+			}
+		})
+	}
+}
+
+func TestTripLogService_UpdateTripLog(t *testing.T) {
+	end := time.Now().Format(time.RFC3339)
+	tests := []struct {
+		name   string
+		id     int
+		req    dto.UpdateTripLogRequest
+		status int
+	}{
+		{"finish", 1, dto.UpdateTripLogRequest{EndTime: &end, Status: "비운행중"}, http.StatusOK},
+		{"reassign driver", 1, dto.UpdateTripLogRequest{DriverID: ptr(1)}, http.StatusOK},
+		{"unknown driver", 1, dto.UpdateTripLogRequest{DriverID: ptr(99)}, http.StatusUnprocessableEntity},
+		{"missing", 99, dto.UpdateTripLogRequest{}, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newTripStore(t)
+			seed(t, store, &models.TripLog{VehicleID: "A01", Status: "운행중"})
+			trip, err := NewTripLogService(store).UpdateTripLog(context.Background(), tt.id, tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err == nil && tt.req.Status != "" && trip.Status != tt.req.Status {
+				t.Errorf("status = %q, want %q", trip.Status, tt.req.Status)
+			}
+		})
+	}
+}
+
+func TestTripLogService_SearchTripLogs(t *testing.T) {
+	store := newTripStore(t)
+	day := time.Date(2026, 3, 2, 9, 0, 0, 0, time.Local)
+	next := day.AddDate(0, 0, 1)
+	seed(t, store,
+		&models.TripLog{VehicleID: "A01", DriverID: ptr(1), StartTime: &day, Status: "비운행중"},
+		&models.TripLog{VehicleID: "B01", StartTime: &next, Status: "운행중"},
+		&models.TripLog{VehicleID: "A01", Status: "운행중"})
+	svc := NewTripLogService(store)
+
+	tests := []struct {
+		name   string
+		params map[string]string
+		sort   string
+		want   []int
+	}{
+		{"by vehicle", map[string]string{"vehicle_id": "A01"}, "-trip_id", []int{3, 1}},
+		{"by start date", map[string]string{"start_time": "2026-03-03"}, "", []int{2}},
+		{"by status", map[string]string{"status": "운행중"}, "trip_id", []int{2, 3}},
+		{"by driver", map[string]string{"driver_id": "1"}, "", []int{1}},
+		{"sort by start time", nil, "start_time", []int{3, 1, 2}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			trips, err := svc.SearchTripLogs(context.Background(), tt.params, tt.sort)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var got []int
+			for _, tr := range trips {
+				got = append(got, tr.TripID)
+			}
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestTripLogService_DeleteTripLog(t *testing.T) {
+	store := newTripStore(t)
+	seed(t, store, &models.TripLog{VehicleID: "A01", Status: "운행중"})
+	svc := NewTripLogService(store)
+
+	if err := svc.DeleteTripLog(context.Background(), 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := svc.DeleteTripLog(context.Background(), 1); statusOf(err) != http.StatusNotFound {
+		t.Errorf("second delete: err = %v", err)
+	}
+	if got := eventTypes(store); !slices.Equal(got, []string{outbox.TripLogDeleted}) {
+		t.Errorf("events = %v", got)
+	}
+}
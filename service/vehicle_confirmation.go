@@ -0,0 +1,163 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/utils"
+)
+
+// defaultConfirmationLed 사유별 기본 LED 색상
+var defaultConfirmationLed = map[string]string{
+	models.ConfirmationReasonArrival:      models.LedBlue,
+	models.ConfirmationReasonLoadMismatch: models.LedRed,
+	models.ConfirmationReasonOther:        models.LedYellow,
+}
+
+// RaiseConfirmation 차량의 확인 요청을 등록하고 확인 필요 플래그와 LED 를 설정
+func (s *VehicleService) RaiseConfirmation(ctx context.Context, internalID int, req dto.CreateConfirmationRequest) (*dto.ConfirmationResponse, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.RaiseConfirmation")
+	defer span.End()
+	led := req.LedColor
+	if led == "" {
+		led = defaultConfirmationLed[req.Reason]
+	}
+	conf := models.VehicleConfirmation{
+		Reason:      req.Reason,
+		Detail:      req.Detail,
+		LedColor:    led,
+		Status:      models.ConfirmationPending,
+		RequestedAt: time.Now(),
+	}
+	err := s.transaction(ctx, func(tx repository.Store) error {
+		vehicle, err := tx.Vehicles().Lock(ctx, internalID)
+		if err != nil {
+			return err
+		}
+		conf.VehicleID = vehicle.VehicleID
+		conf.PreviousLedStatus = vehicle.LedStatus
+		if vehicle.NeedsConfirmation {
+			// 이미 대기 중인 요청이 있으면 그 요청의 원래 LED 를 이어받아, 모두 처리된 뒤 처음 상태로 복원
+			pending, err := tx.Vehicles().PendingConfirmations(ctx, vehicle.VehicleID)
+			if err != nil {
+				return err
+			}
+			if len(pending) > 0 {
+				conf.PreviousLedStatus = pending[0].PreviousLedStatus
+			}
+		}
+		if err := tx.Vehicles().CreateConfirmation(ctx, &conf); err != nil {
+			return err
+		}
+		previousLed := vehicle.LedStatus
+		vehicle.NeedsConfirmation = true
+		vehicle.LedStatus = led
+		if err := tx.Vehicles().Save(ctx, vehicle); err != nil {
+			return err
+		}
+		if err := recordLedChange(ctx, tx, vehicle.VehicleID, previousLed, led); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.VehicleConfirmationRequested, outbox.AggregateVehicle, vehicle.VehicleID, toConfirmationResponse(&conf))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toConfirmationResponse(&conf), nil
+}
+
+// ListConfirmations 차량의 확인 요청 목록. status 가 비어 있으면 전체
+func (s *VehicleService) ListConfirmations(ctx context.Context, internalID int, status string) ([]dto.ConfirmationResponse, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.ListConfirmations")
+	defer span.End()
+	vehicle, err := s.store.Vehicles().Get(ctx, internalID)
+	if err != nil {
+		return nil, err
+	}
+	confs, err := s.store.Vehicles().ListConfirmations(ctx, vehicle.VehicleID, status)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]dto.ConfirmationResponse, 0, len(confs))
+	for _, c := range confs {
+		res = append(res, *toConfirmationResponse(&c))
+	}
+	return res, nil
+}
+
+// AcknowledgeConfirmation 운영자가 확인 요청을 처리. 남은 대기 요청이 없으면 플래그를 내리고 LED 를 복원
+func (s *VehicleService) AcknowledgeConfirmation(ctx context.Context, internalID, confirmationID, employeeID int, req dto.AcknowledgeConfirmationRequest) (*dto.ConfirmationResponse, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.AcknowledgeConfirmation")
+	defer span.End()
+	var conf *models.VehicleConfirmation
+	err := s.transaction(ctx, func(tx repository.Store) error {
+		vehicle, err := tx.Vehicles().Lock(ctx, internalID)
+		if err != nil {
+			return err
+		}
+		if conf, err = tx.Vehicles().GetConfirmation(ctx, vehicle.VehicleID, confirmationID); err != nil {
+			if errors.Is(err, repository.ErrNotFound) {
+				return apperror.NotFound("confirmation")
+			}
+			return err
+		}
+		if conf.Status != models.ConfirmationPending {
+			return apperror.ErrConfirmationAcked
+		}
+
+		now := time.Now()
+		conf.Status = models.ConfirmationAcknowledged
+		conf.AcknowledgedBy = &employeeID
+		conf.AcknowledgedAt = &now
+		conf.Note = req.Note
+		if err := tx.Vehicles().SaveConfirmation(ctx, conf); err != nil {
+			return err
+		}
+
+		// 남은 대기 요청 중 가장 최근 요청의 색상을 표시하고, 없으면 원래 LED 로 복원
+		pending, err := tx.Vehicles().PendingConfirmations(ctx, vehicle.VehicleID)
+		if err != nil {
+			return err
+		}
+		led := conf.PreviousLedStatus
+		if len(pending) > 0 {
+			led = pending[len(pending)-1].LedColor
+		} else {
+			vehicle.NeedsConfirmation = false
+		}
+		previousLed := vehicle.LedStatus
+		vehicle.LedStatus = led
+		if err := tx.Vehicles().Save(ctx, vehicle); err != nil {
+			return err
+		}
+		if err := recordLedChange(ctx, tx, vehicle.VehicleID, previousLed, led); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.VehicleConfirmationAcked, outbox.AggregateVehicle, vehicle.VehicleID, toConfirmationResponse(conf))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toConfirmationResponse(conf), nil
+}
+
+func toConfirmationResponse(m *models.VehicleConfirmation) *dto.ConfirmationResponse {
+	return &dto.ConfirmationResponse{
+		ConfirmationID: m.ConfirmationID,
+		VehicleID:      m.VehicleID,
+		Reason:         m.Reason,
+		Detail:         m.Detail,
+		LedColor:       m.LedColor,
+		Status:         m.Status,
+		RequestedAt:    m.RequestedAt.Format(time.RFC3339),
+		AcknowledgedBy: m.AcknowledgedBy,
+		AcknowledgedAt: utils.FormatTimePtr(m.AcknowledgedAt),
+		Note:           m.Note,
+	}
+}
@@ -2,118 +2,178 @@ package service
 
 import (
 	"context"
+	"strconv"
+	"time"
 
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/cache"
 	"github.com/baboyiban/go-api-server/dto"
 	"github.com/baboyiban/go-api-server/models"
-	"gorm.io/gorm"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
 )
 
-var allowedVehicleSortFields = map[string]bool{
-	"internal_id":        true,
-	"vehicle_id":         true,
-	"current_load":       true,
-	"max_load":           true,
-	"led_status":         true,
-	"needs_confirmation": true,
-	"coord_x":            true,
-	"coord_y":            true,
+type VehicleService struct {
+	store repository.Store
+	cache *cache.Cache
 }
 
-func applyVehicleSort(query *gorm.DB, sort string) *gorm.DB {
-	if sort == "" {
-		return query
-	}
-	field := sort
-	desc := false
-	if sort[0] == '-' {
-		field = sort[1:]
-		desc = true
-	}
-	if allowedVehicleSortFields[field] {
-		order := field
-		if desc {
-			order += " DESC"
-		} else {
-			order += " ASC"
-		}
-		return query.Order(order)
-	}
-	return query
+// NewVehicleService c 가 nil 이면 조회 결과를 캐시하지 않음
+func NewVehicleService(store repository.Store, c *cache.Cache) *VehicleService {
+	return &VehicleService{store: store, cache: c}
 }
 
-type VehicleService struct {
-	db *gorm.DB
+// CacheTTL 조회 결과를 캐시하는 시간. 캐시를 쓰지 않으면 0
+func (s *VehicleService) CacheTTL() time.Duration {
+	return s.cache.TTL()
 }
 
-func NewVehicleService(db *gorm.DB) *VehicleService {
-	return &VehicleService{db: db}
+// transaction 커밋되면 차량 조회 캐시를 비움
+func (s *VehicleService) transaction(ctx context.Context, fn func(tx repository.Store) error) error {
+	if err := s.store.Transaction(ctx, fn); err != nil {
+		return err
+	}
+	s.cache.Invalidate(ctx, vehicleCache)
+	return nil
 }
 
 func (s *VehicleService) CreateVehicle(ctx context.Context, req dto.CreateVehicleRequest) (*models.Vehicle, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.CreateVehicle")
+	defer span.End()
 	vehicle := models.Vehicle{
 		VehicleID: req.VehicleID,
 		MaxLoad:   req.MaxLoad,
+		LedStatus: models.LedOff,
 	}
-	if err := s.db.WithContext(ctx).Create(&vehicle).Error; err != nil {
+	err := s.transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Vehicles().Create(ctx, &vehicle); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.VehicleCreated, outbox.AggregateVehicle, vehicle.VehicleID, vehicle)
+	})
+	if err != nil {
 		return nil, err
 	}
 	return &vehicle, nil
 }
 
 func (s *VehicleService) GetVehicleByID(ctx context.Context, id int) (*models.Vehicle, error) {
-	var vehicle models.Vehicle
-	if err := s.db.WithContext(ctx).Where("internal_id = ?", id).First(&vehicle).Error; err != nil {
-		return nil, err
-	}
-	return &vehicle, nil
+	ctx, span := tracer.Start(ctx, "VehicleService.GetVehicleByID")
+	defer span.End()
+	return cache.Fetch(ctx, s.cache, vehicleCache, "get:"+strconv.Itoa(id), func() (*models.Vehicle, error) {
+		return s.store.Vehicles().Get(ctx, id)
+	})
 }
 
 func (s *VehicleService) DeleteVehicle(ctx context.Context, id int) error {
-	result := s.db.WithContext(ctx).Where("internal_id = ?", id).Delete(&models.Vehicle{})
-	if result.Error != nil {
-		return result.Error
-	}
-	if result.RowsAffected == 0 {
-		return gorm.ErrRecordNotFound
-	}
-	return nil
+	ctx, span := tracer.Start(ctx, "VehicleService.DeleteVehicle")
+	defer span.End()
+	return s.transaction(ctx, func(tx repository.Store) error {
+		vehicle, err := tx.Vehicles().Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := tx.Vehicles().Delete(ctx, id); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.VehicleDeleted, outbox.AggregateVehicle, vehicle.VehicleID, vehicle)
+	})
 }
 
 func (s *VehicleService) UpdateVehicle(ctx context.Context, id int, req dto.UpdateVehicleRequest) (*models.Vehicle, error) {
-	var vehicle models.Vehicle
-	if err := s.db.WithContext(ctx).Where("internal_id = ?", id).First(&vehicle).Error; err != nil {
+	ctx, span := tracer.Start(ctx, "VehicleService.UpdateVehicle")
+	defer span.End()
+	vehicle, err := s.store.Vehicles().Get(ctx, id)
+	if err != nil {
 		return nil, err
 	}
 	vehicle.MaxLoad = req.MaxLoad
-	vehicle.LedStatus = req.LedStatus
-	vehicle.NeedsConfirmation = req.NeedsConfirmation
+	previousLed := vehicle.LedStatus
+	if req.LedStatus != nil {
+		// 확인 대기 중에는 LED 가 확인 요청 색상을 유지해야 함
+		if vehicle.NeedsConfirmation {
+			return nil, apperror.ErrConfirmationPending.WithDetail("vehicle %s has pending confirmations", vehicle.VehicleID)
+		}
+		vehicle.LedStatus = *req.LedStatus
+	}
 	vehicle.CoordX = req.CoordX
 	vehicle.CoordY = req.CoordY
-	if err := s.db.WithContext(ctx).Save(&vehicle).Error; err != nil {
+	err = s.transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Vehicles().Save(ctx, vehicle); err != nil {
+			return err
+		}
+		if err := tx.Events().Record(ctx, outbox.VehicleUpdated, outbox.AggregateVehicle, vehicle.VehicleID, vehicle); err != nil {
+			return err
+		}
+		return recordLedChange(ctx, tx, vehicle.VehicleID, previousLed, vehicle.LedStatus)
+	})
+	if err != nil {
 		return nil, err
 	}
-	return &vehicle, nil
+	return vehicle, nil
 }
 
-func (s *VehicleService) ListVehicles(ctx context.Context, sort string) ([]models.Vehicle, error) {
-	var vehicles []models.Vehicle
-	query := s.db.WithContext(ctx).Model(&models.Vehicle{})
-	query = applyVehicleSort(query, sort)
-	if err := query.Find(&vehicles).Error; err != nil {
+// ReportTelemetry 차량이 보고한 위치와 적재량 반영
+func (s *VehicleService) ReportTelemetry(ctx context.Context, vehicleID string, req dto.VehicleTelemetryMessage) (*models.Vehicle, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.ReportTelemetry")
+	defer span.End()
+	var vehicle *models.Vehicle
+	err := s.transaction(ctx, func(tx repository.Store) error {
+		var err error
+		if vehicle, err = tx.Vehicles().GetByVehicleID(ctx, vehicleID); err != nil {
+			return err
+		}
+		vehicle.CoordX = req.CoordX
+		vehicle.CoordY = req.CoordY
+		if req.CurrentLoad != nil {
+			vehicle.CurrentLoad = *req.CurrentLoad
+		}
+		if err := tx.Vehicles().Save(ctx, vehicle); err != nil {
+			return err
+		}
+		return tx.Events().Record(ctx, outbox.VehicleUpdated, outbox.AggregateVehicle, vehicle.VehicleID, vehicle)
+	})
+	if err != nil {
 		return nil, err
 	}
-	return vehicles, nil
+	return vehicle, nil
 }
 
-func (s *VehicleService) SearchVehicles(ctx context.Context, params map[string]string, sort string) ([]models.Vehicle, error) {
-	var vehicles []models.Vehicle
-	query := s.db.WithContext(ctx).Model(&models.Vehicle{})
-	for k, v := range params {
-		query = query.Where(k+" = ?", v)
+// recordLedChange LED 색상이 바뀌었으면 차량에 명령을 보내도록 이벤트 기록
+func recordLedChange(ctx context.Context, tx repository.Store, vehicleID, previous, current string) error {
+	if previous == current {
+		return nil
 	}
-	query = applyVehicleSort(query, sort)
-	if err := query.Find(&vehicles).Error; err != nil {
-		return nil, err
-	}
-	return vehicles, nil
+	return tx.Events().Record(ctx, outbox.VehicleLedChanged, outbox.AggregateVehicle, vehicleID,
+		dto.VehicleLedEvent{VehicleID: vehicleID, LedStatus: current})
+}
+
+func (s *VehicleService) ListVehicles(ctx context.Context, sort string) ([]models.Vehicle, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.ListVehicles")
+	defer span.End()
+	return cache.Fetch(ctx, s.cache, vehicleCache, "list:"+sort, func() ([]models.Vehicle, error) {
+		return cacheSource(s.store, s.cache).Vehicles().Find(ctx, repository.Query{Sort: sort})
+	})
+}
+
+func (s *VehicleService) SearchVehicles(ctx context.Context, params map[string]string, sort string) ([]models.Vehicle, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.SearchVehicles")
+	defer span.End()
+	return cache.Fetch(ctx, s.cache, vehicleCache, searchCacheKey(params, sort), func() ([]models.Vehicle, error) {
+		return cacheSource(s.store, s.cache).Vehicles().Find(ctx, repository.Query{Filters: params, Sort: sort})
+	})
+}
+
+// PageVehicles SearchVehicles 와 같은 조건으로 한 페이지와 전체 개수를 조회
+func (s *VehicleService) PageVehicles(ctx context.Context, params map[string]string, sort string, page dto.PageRequest) ([]models.Vehicle, int64, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.PageVehicles")
+	defer span.End()
+	return paginate(ctx, s.store.Replica().Vehicles(), repository.Query{Filters: params, Sort: sort, Page: page})
+}
+
+// GetVehiclesByVehicleIDs 여러 차량을 차량 번호(vehicle_id)로 한 번에 조회. 없는 번호는 결과에서 빠짐
+func (s *VehicleService) GetVehiclesByVehicleIDs(ctx context.Context, vehicleIDs []string) ([]models.Vehicle, error) {
+	ctx, span := tracer.Start(ctx, "VehicleService.GetVehiclesByVehicleIDs")
+	defer span.End()
+	return s.store.Vehicles().GetMany(ctx, vehicleIDs)
 }
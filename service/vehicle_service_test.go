@@ -0,0 +1,190 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"slices"
+	"testing"
+
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+func newVehicleStore(t *testing.T) *repository.MemoryStore {
+	store := repository.NewMemoryStore()
+	seed(t, store,
+		&models.Vehicle{VehicleID: "A01", MaxLoad: 5, LedStatus: models.LedOff},
+		&models.Vehicle{VehicleID: "B01", MaxLoad: 5, LedStatus: models.LedBlue, NeedsConfirmation: true})
+	return store
+}
+
+func TestVehicleService_CreateVehicle(t *testing.T) {
+	tests := []struct {
+		name   string
+		req    dto.CreateVehicleRequest
+		status int
+	}{
+		{"created", dto.CreateVehicleRequest{VehicleID: "C01", MaxLoad: 3}, http.StatusOK},
+		{"duplicate vehicle id", dto.CreateVehicleRequest{VehicleID: "A01"}, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newVehicleStore(t)
+			vehicle, err := NewVehicleService(store, nil).CreateVehicle(context.Background(), tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err == nil && (vehicle.InternalID == 0 || vehicle.LedStatus != models.LedOff) {
+				t.Errorf("vehicle = %+v", vehicle)
+			}
+		})
+	}
+}
+
+func TestVehicleService_UpdateVehicle(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     int
+		req    dto.UpdateVehicleRequest
+		status int
+		events []string
+	}{
+		{"move", 1, dto.UpdateVehicleRequest{MaxLoad: 5, CoordX: 3, CoordY: 4}, http.StatusOK, []string{outbox.VehicleUpdated}},
+		{"change led", 1, dto.UpdateVehicleRequest{MaxLoad: 5, LedStatus: ptr(models.LedGreen)}, http.StatusOK, []string{outbox.VehicleUpdated, outbox.VehicleLedChanged}},
+		{"same led", 1, dto.UpdateVehicleRequest{MaxLoad: 5, LedStatus: ptr(models.LedOff)}, http.StatusOK, []string{outbox.VehicleUpdated}},
+		{"led while confirmation pending", 2, dto.UpdateVehicleRequest{MaxLoad: 5, LedStatus: ptr(models.LedGreen)}, http.StatusConflict, nil},
+		{"missing", 99, dto.UpdateVehicleRequest{}, http.StatusNotFound, nil},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newVehicleStore(t)
+			_, err := NewVehicleService(store, nil).UpdateVehicle(context.Background(), tt.id, tt.req)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if got := eventTypes(store); !slices.Equal(got, tt.events) {
+				t.Errorf("events = %v, want %v", got, tt.events)
+			}
+		})
+	}
+}
+
+func TestVehicleService_ReportTelemetry(t *testing.T) {
+	tests := []struct {
+		name     string
+		vehicle  string
+		msg      dto.VehicleTelemetryMessage
+		status   int
+		wantLoad int
+	}{
+		{"position and load", "A01", dto.VehicleTelemetryMessage{CoordX: 1, CoordY: 2, CurrentLoad: ptr(3)}, http.StatusOK, 3},
+		{"position only keeps load", "A01", dto.VehicleTelemetryMessage{CoordX: 1, CoordY: 2}, http.StatusOK, 0},
+		{"unknown vehicle", "Z99", dto.VehicleTelemetryMessage{}, http.StatusNotFound, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newVehicleStore(t)
+			vehicle, err := NewVehicleService(store, nil).ReportTelemetry(context.Background(), tt.vehicle, tt.msg)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err == nil && (vehicle.CoordX != tt.msg.CoordX || vehicle.CurrentLoad != tt.wantLoad) {
+				t.Errorf("vehicle = %+v", vehicle)
+			}
+		})
+	}
+}
+
+func TestVehicleService_DeleteVehicle(t *testing.T) {
+	tests := []struct {
+		name   string
+		id     int
+		status int
+	}{
+		{"deleted", 2, http.StatusOK},
+		{"has trips", 1, http.StatusConflict},
+		{"missing", 99, http.StatusNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := newVehicleStore(t)
+			seed(t, store, &models.TripLog{VehicleID: "A01", Status: "비운행중"})
+			err := NewVehicleService(store, nil).DeleteVehicle(context.Background(), tt.id)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+		})
+	}
+}
+
+func TestVehicleService_Confirmations(t *testing.T) {
+	ctx := context.Background()
+	store := newVehicleStore(t)
+	svc := NewVehicleService(store, nil)
+
+	// 두 요청이 쌓이고 처리되는 동안 LED 가 최근 요청 색상을 따르다가 처음 상태로 돌아와야 함
+	steps := []struct {
+		name        string
+		run         func() (*dto.ConfirmationResponse, error)
+		status      int
+		wantLed     string
+		wantPending bool
+	}{
+		{"raise arrival", func() (*dto.ConfirmationResponse, error) {
+			return svc.RaiseConfirmation(ctx, 1, dto.CreateConfirmationRequest{Reason: models.ConfirmationReasonArrival})
+		}, http.StatusOK, models.LedBlue, true},
+		{"raise mismatch", func() (*dto.ConfirmationResponse, error) {
+			return svc.RaiseConfirmation(ctx, 1, dto.CreateConfirmationRequest{Reason: models.ConfirmationReasonLoadMismatch})
+		}, http.StatusOK, models.LedRed, true},
+		{"ack newest", func() (*dto.ConfirmationResponse, error) {
+			return svc.AcknowledgeConfirmation(ctx, 1, 2, 7, dto.AcknowledgeConfirmationRequest{})
+		}, http.StatusOK, models.LedBlue, true},
+		{"ack twice", func() (*dto.ConfirmationResponse, error) {
+			return svc.AcknowledgeConfirmation(ctx, 1, 2, 7, dto.AcknowledgeConfirmationRequest{})
+		}, http.StatusConflict, models.LedBlue, true},
+		{"ack other vehicle's confirmation", func() (*dto.ConfirmationResponse, error) {
+			return svc.AcknowledgeConfirmation(ctx, 2, 1, 7, dto.AcknowledgeConfirmationRequest{})
+		}, http.StatusNotFound, models.LedBlue, true},
+		{"ack last", func() (*dto.ConfirmationResponse, error) {
+			return svc.AcknowledgeConfirmation(ctx, 1, 1, 7, dto.AcknowledgeConfirmationRequest{Note: ptr("checked")})
+		}, http.StatusOK, models.LedOff, false},
+		{"raise on missing vehicle", func() (*dto.ConfirmationResponse, error) {
+			return svc.RaiseConfirmation(ctx, 99, dto.CreateConfirmationRequest{Reason: models.ConfirmationReasonOther})
+		}, http.StatusNotFound, models.LedOff, false},
+	}
+	for _, step := range steps {
+		_, err := step.run()
+		if got := statusOf(err); got != step.status {
+			t.Fatalf("%s: status = %d, want %d (err %v)", step.name, got, step.status, err)
+		}
+		vehicle, _ := svc.GetVehicleByID(ctx, 1)
+		if vehicle.LedStatus != step.wantLed || vehicle.NeedsConfirmation != step.wantPending {
+			t.Fatalf("%s: led = %s, needs_confirmation = %v; want %s, %v",
+				step.name, vehicle.LedStatus, vehicle.NeedsConfirmation, step.wantLed, step.wantPending)
+		}
+	}
+
+	confs, err := svc.ListConfirmations(ctx, 1, models.ConfirmationAcknowledged)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(confs) != 2 || confs[0].ConfirmationID != 2 || confs[1].AcknowledgedBy == nil || *confs[1].AcknowledgedBy != 7 {
+		t.Errorf("acknowledged confirmations = %+v", confs)
+	}
+
+	// LED 명령은 색상이 바뀔 때만 기록
+	var leds []string
+	for _, ev := range store.RecordedEvents() {
+		if ev.Type == outbox.VehicleLedChanged {
+			var led dto.VehicleLedEvent
+			_ = json.Unmarshal(ev.Data, &led)
+			leds = append(leds, led.LedStatus)
+		}
+	}
+	if want := []string{models.LedBlue, models.LedRed, models.LedBlue, models.LedOff}; !slices.Equal(leds, want) {
+		t.Errorf("led commands = %v, want %v", leds, want)
+	}
+}
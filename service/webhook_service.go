@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/utils"
+	"github.com/baboyiban/go-api-server/webhook"
+)
+
+type WebhookService struct {
+	store      repository.Store
+	dispatcher *webhook.Dispatcher
+}
+
+func NewWebhookService(store repository.Store, dispatcher *webhook.Dispatcher) *WebhookService {
+	return &WebhookService{store: store, dispatcher: dispatcher}
+}
+
+// CreateSubscription 웹훅 구독 생성. 시크릿은 이 응답에서만 노출
+func (s *WebhookService) CreateSubscription(ctx context.Context, req dto.CreateWebhookRequest, createdBy int) (*dto.WebhookResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.CreateSubscription")
+	defer span.End()
+	if err := s.validateTarget(req.URL, req.EventTypes); err != nil {
+		return nil, err
+	}
+	secret := ""
+	if req.Secret != nil {
+		secret = *req.Secret
+	} else {
+		var err error
+		if secret, err = webhook.NewSecret(); err != nil {
+			return nil, err
+		}
+	}
+	sub := models.WebhookSubscription{
+		URL:        req.URL,
+		EventTypes: joinEventTypes(req.EventTypes),
+		Secret:     secret,
+		IsActive:   true,
+		CreatedBy:  createdBy,
+		CreatedAt:  time.Now(),
+	}
+	if req.IsActive != nil {
+		sub.IsActive = *req.IsActive
+	}
+	if err := s.store.Webhooks().CreateSubscription(ctx, &sub); err != nil {
+		return nil, err
+	}
+	res := toWebhookResponse(&sub)
+	res.Secret = sub.Secret
+	return res, nil
+}
+
+func (s *WebhookService) GetSubscription(ctx context.Context, id int) (*dto.WebhookResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.GetSubscription")
+	defer span.End()
+	sub, err := s.store.Webhooks().GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookResponse(sub), nil
+}
+
+func (s *WebhookService) ListSubscriptions(ctx context.Context) ([]dto.WebhookResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.ListSubscriptions")
+	defer span.End()
+	subs, err := s.store.Webhooks().ListSubscriptions(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]dto.WebhookResponse, 0, len(subs))
+	for _, sub := range subs {
+		res = append(res, *toWebhookResponse(&sub))
+	}
+	return res, nil
+}
+
+func (s *WebhookService) UpdateSubscription(ctx context.Context, id int, req dto.UpdateWebhookRequest) (*dto.WebhookResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.UpdateSubscription")
+	defer span.End()
+	if err := s.validateTarget(req.URL, req.EventTypes); err != nil {
+		return nil, err
+	}
+	sub, err := s.store.Webhooks().GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	sub.URL = req.URL
+	sub.EventTypes = joinEventTypes(req.EventTypes)
+	if req.Secret != nil {
+		sub.Secret = *req.Secret
+	}
+	if req.IsActive != nil {
+		sub.IsActive = *req.IsActive
+	}
+	if err := s.store.Webhooks().SaveSubscription(ctx, sub); err != nil {
+		return nil, err
+	}
+	return toWebhookResponse(sub), nil
+}
+
+// DeleteSubscription 구독과 전송 기록을 함께 삭제
+func (s *WebhookService) DeleteSubscription(ctx context.Context, id int) error {
+	ctx, span := tracer.Start(ctx, "WebhookService.DeleteSubscription")
+	defer span.End()
+	return s.store.Webhooks().DeleteSubscription(ctx, id)
+}
+
+// TestSubscription 테스트 이벤트를 즉시 전송하고 결과를 반환. 실패하면 일반 전송처럼 재시도 예약
+func (s *WebhookService) TestSubscription(ctx context.Context, id int) (*dto.WebhookDeliveryResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.TestSubscription")
+	defer span.End()
+	sub, err := s.store.Webhooks().GetSubscription(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	delivery, err := s.dispatcher.Test(ctx, sub)
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookDeliveryResponse(delivery), nil
+}
+
+// ListDeliveries 구독의 전송 목록을 최근 순으로. status 가 비어 있으면 전체
+func (s *WebhookService) ListDeliveries(ctx context.Context, subscriptionID int, status string) ([]dto.WebhookDeliveryResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.ListDeliveries")
+	defer span.End()
+	if _, err := s.store.Webhooks().GetSubscription(ctx, subscriptionID); err != nil {
+		return nil, err
+	}
+	deliveries, err := s.store.Webhooks().ListDeliveries(ctx, subscriptionID, status)
+	if err != nil {
+		return nil, err
+	}
+	res := make([]dto.WebhookDeliveryResponse, 0, len(deliveries))
+	for _, d := range deliveries {
+		res = append(res, *toWebhookDeliveryResponse(&d))
+	}
+	return res, nil
+}
+
+// GetDelivery 전송 본문과 시도 기록을 포함한 단건 조회
+func (s *WebhookService) GetDelivery(ctx context.Context, id int) (*dto.WebhookDeliveryDetailResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.GetDelivery")
+	defer span.End()
+	delivery, err := s.store.Webhooks().GetDelivery(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	attempts, err := s.store.Webhooks().ListAttempts(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	res := &dto.WebhookDeliveryDetailResponse{
+		WebhookDeliveryResponse: *toWebhookDeliveryResponse(delivery),
+		Payload:                 delivery.Payload,
+		AttemptLogs:             make([]dto.WebhookAttemptResponse, 0, len(attempts)),
+	}
+	for _, a := range attempts {
+		res.AttemptLogs = append(res.AttemptLogs, dto.WebhookAttemptResponse{
+			AttemptID:   a.AttemptID,
+			AttemptedAt: a.AttemptedAt.Format(time.RFC3339),
+			StatusCode:  a.StatusCode,
+			Error:       a.Error,
+			DurationMs:  a.DurationMs,
+		})
+	}
+	return res, nil
+}
+
+// Redeliver 같은 본문과 이벤트 ID 로 즉시 다시 전송
+func (s *WebhookService) Redeliver(ctx context.Context, id int) (*dto.WebhookDeliveryResponse, error) {
+	ctx, span := tracer.Start(ctx, "WebhookService.Redeliver")
+	defer span.End()
+	delivery, err := s.dispatcher.Redeliver(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return toWebhookDeliveryResponse(delivery), nil
+}
+
+// validateTarget 주소가 내부망을 가리키거나 구독할 수 없는 이벤트 타입이 있으면 검증 에러
+func (s *WebhookService) validateTarget(url string, types []string) error {
+	var fields []apperror.FieldError
+	if err := s.dispatcher.CheckURL(url); err != nil {
+		fields = append(fields, apperror.FieldError{Field: "url", Rule: "public_url", Message: err.Error()})
+	}
+	for i, t := range types {
+		if !slices.Contains(webhook.EventTypes, t) {
+			fields = append(fields, apperror.FieldError{
+				Field:   fmt.Sprintf("event_types[%d]", i),
+				Rule:    "oneof",
+				Message: "must be one of: " + strings.Join(webhook.EventTypes, ", "),
+			})
+		}
+	}
+	if len(fields) > 0 {
+		return apperror.Validation(fields)
+	}
+	return nil
+}
+
+func joinEventTypes(types []string) string {
+	seen := map[string]bool{}
+	out := make([]string, 0, len(types))
+	for _, t := range types {
+		if !seen[t] {
+			seen[t] = true
+			out = append(out, t)
+		}
+	}
+	return strings.Join(out, ",")
+}
+
+func toWebhookResponse(m *models.WebhookSubscription) *dto.WebhookResponse {
+	return &dto.WebhookResponse{
+		SubscriptionID: m.SubscriptionID,
+		URL:            m.URL,
+		EventTypes:     webhook.SplitEventTypes(m.EventTypes),
+		IsActive:       m.IsActive,
+		CreatedBy:      m.CreatedBy,
+		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
+	}
+}
+
+func toWebhookDeliveryResponse(m *models.WebhookDelivery) *dto.WebhookDeliveryResponse {
+	res := &dto.WebhookDeliveryResponse{
+		DeliveryID:     m.DeliveryID,
+		SubscriptionID: m.SubscriptionID,
+		EventID:        m.EventID,
+		EventType:      m.EventType,
+		Status:         m.Status,
+		Attempts:       m.Attempts,
+		LastStatusCode: m.LastStatusCode,
+		LastError:      m.LastError,
+		CreatedAt:      m.CreatedAt.Format(time.RFC3339),
+		DeliveredAt:    utils.FormatTimePtr(m.DeliveredAt),
+	}
+	if m.Status == models.WebhookDeliveryPending {
+		res.NextAttemptAt = utils.FormatTimePtr(&m.NextAttemptAt)
+	}
+	return res
+}
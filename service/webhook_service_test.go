@@ -0,0 +1,338 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/dto"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+	"github.com/baboyiban/go-api-server/webhook"
+)
+
+const testWebhookSecret = "test-webhook-secret-0123456789"
+
+// webhookReceiver 서명을 검증하고 미리 정한 상태 코드로 응답하는 수신 서버
+type webhookReceiver struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	statuses []int // 요청마다 하나씩 꺼내 응답. 비어 있으면 200
+	received []receivedWebhook
+}
+
+type receivedWebhook struct {
+	event    string
+	delivery string
+	signed   bool
+	body     webhook.Event
+}
+
+func newWebhookReceiver(t *testing.T, statuses ...int) *webhookReceiver {
+	r := &webhookReceiver{statuses: statuses}
+	r.Server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		body, _ := io.ReadAll(req.Body)
+		got := receivedWebhook{
+			event:    req.Header.Get(webhook.HeaderEvent),
+			delivery: req.Header.Get(webhook.HeaderDelivery),
+			signed: webhook.Verify(testWebhookSecret, req.Header.Get(webhook.HeaderTimestamp), body,
+				req.Header.Get(webhook.HeaderSignature), time.Minute),
+		}
+		_ = json.Unmarshal(body, &got.body)
+
+		r.mu.Lock()
+		r.received = append(r.received, got)
+		status := http.StatusOK
+		if len(r.statuses) > 0 {
+			status, r.statuses = r.statuses[0], r.statuses[1:]
+		}
+		r.mu.Unlock()
+		w.WriteHeader(status)
+	}))
+	t.Cleanup(r.Close)
+	return r
+}
+
+func (r *webhookReceiver) requests() []receivedWebhook {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]receivedWebhook(nil), r.received...)
+}
+
+// newWebhookService 재시도 간격을 길게 두어 테스트가 직접 전송 시각을 앞당기도록 함
+func newWebhookService(t *testing.T) (*WebhookService, *webhook.Dispatcher, *repository.MemoryStore) {
+	store := repository.NewMemoryStore()
+	dispatcher := webhook.NewDispatcher(store, config.WebhookConfig{
+		BatchSize:   10,
+		Timeout:     time.Second,
+		MaxAttempts: 3,
+		BaseBackoff: time.Hour,
+		MaxBackoff:  90 * time.Minute,
+		// 수신 서버는 httptest 의 루프백 주소
+		AllowPrivateTargets: true,
+	})
+	return NewWebhookService(store, dispatcher), dispatcher, store
+}
+
+func createTestSubscription(t *testing.T, svc *WebhookService, url string, types ...string) *dto.WebhookResponse {
+	t.Helper()
+	sub, err := svc.CreateSubscription(context.Background(), dto.CreateWebhookRequest{
+		URL: url, EventTypes: types, Secret: ptr(testWebhookSecret),
+	}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sub
+}
+
+// makeDue 재시도 대기 중인 전송을 지금 보낼 수 있게 함
+func makeDue(t *testing.T, store repository.Store, deliveryID int) {
+	t.Helper()
+	if err := store.Webhooks().UpdateDelivery(context.Background(), deliveryID, map[string]any{"next_attempt_at": time.Now().Add(-time.Second)}); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestWebhookService_CreateSubscription(t *testing.T) {
+	tests := []struct {
+		name       string
+		req        dto.CreateWebhookRequest
+		status     int
+		wantTypes  []string
+		wantSecret bool
+	}{
+		{"generated secret", dto.CreateWebhookRequest{URL: "http://example.com/hook", EventTypes: []string{outbox.PackageCreated}}, http.StatusOK, []string{outbox.PackageCreated}, true},
+		{"duplicate types collapsed", dto.CreateWebhookRequest{URL: "http://example.com/hook", EventTypes: []string{outbox.RegionSaturated, outbox.RegionSaturated}, Secret: ptr(testWebhookSecret)}, http.StatusOK, []string{outbox.RegionSaturated}, true},
+		{"unknown event type", dto.CreateWebhookRequest{URL: "http://example.com/hook", EventTypes: []string{"package.exploded"}}, http.StatusBadRequest, nil, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			svc, _, _ := newWebhookService(t)
+			sub, err := svc.CreateSubscription(context.Background(), tt.req, 1)
+			if got := statusOf(err); got != tt.status {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.status, err)
+			}
+			if err != nil {
+				return
+			}
+			if len(sub.EventTypes) != len(tt.wantTypes) || sub.EventTypes[0] != tt.wantTypes[0] || (sub.Secret != "") != tt.wantSecret {
+				t.Errorf("subscription = %+v", sub)
+			}
+			// 시크릿은 생성 응답에서만 노출
+			if got, _ := svc.GetSubscription(context.Background(), sub.SubscriptionID); got.Secret != "" {
+				t.Errorf("secret exposed on read: %+v", got)
+			}
+		})
+	}
+}
+
+// 운영 설정에서는 내부망 주소로 구독을 만들거나 바꿀 수 없음
+func TestWebhookService_PrivateTargets(t *testing.T) {
+	ctx := context.Background()
+	store := repository.NewMemoryStore()
+	svc := NewWebhookService(store, webhook.NewDispatcher(store, config.WebhookConfig{Timeout: time.Second}))
+	sub, err := svc.CreateSubscription(ctx, dto.CreateWebhookRequest{URL: "https://hooks.example.com/in", EventTypes: []string{outbox.PackageCreated}}, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tests := []struct {
+		name string
+		call func(url string) error
+	}{
+		{"create", func(url string) error {
+			_, err := svc.CreateSubscription(ctx, dto.CreateWebhookRequest{URL: url, EventTypes: []string{outbox.PackageCreated}}, 1)
+			return err
+		}},
+		{"update", func(url string) error {
+			_, err := svc.UpdateSubscription(ctx, sub.SubscriptionID, dto.UpdateWebhookRequest{URL: url, EventTypes: []string{outbox.PackageCreated}})
+			return err
+		}},
+	}
+	for _, tt := range tests {
+		for _, url := range []string{"http://127.0.0.1:8080/in", "http://169.254.169.254/latest", "http://localhost/in"} {
+			t.Run(tt.name+" "+url, func(t *testing.T) {
+				if got := statusOf(tt.call(url)); got != http.StatusBadRequest {
+					t.Errorf("status = %d, want 400", got)
+				}
+			})
+		}
+	}
+	if got, _ := svc.GetSubscription(ctx, sub.SubscriptionID); got.URL != "https://hooks.example.com/in" {
+		t.Errorf("url = %s", got.URL)
+	}
+}
+
+func TestWebhookService_TestSubscription(t *testing.T) {
+	ctx := context.Background()
+	receiver := newWebhookReceiver(t)
+	svc, _, _ := newWebhookService(t)
+	sub := createTestSubscription(t, svc, receiver.URL, outbox.PackageCreated)
+
+	delivery, err := svc.TestSubscription(ctx, sub.SubscriptionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if delivery.Status != models.WebhookDeliverySucceeded || delivery.Attempts != 1 || delivery.LastStatusCode == nil || *delivery.LastStatusCode != http.StatusOK {
+		t.Errorf("delivery = %+v", delivery)
+	}
+	reqs := receiver.requests()
+	if len(reqs) != 1 || reqs[0].event != webhook.EventTest || !reqs[0].signed || reqs[0].body.ID != delivery.EventID {
+		t.Fatalf("received = %+v", reqs)
+	}
+
+	if _, err := svc.TestSubscription(ctx, 99); statusOf(err) != http.StatusNotFound {
+		t.Errorf("missing subscription: err = %v", err)
+	}
+}
+
+func TestWebhookService_RetryAndRedeliver(t *testing.T) {
+	ctx := context.Background()
+	// 세 번 실패해 최대 시도 횟수에 도달한 뒤, 재전송은 성공
+	receiver := newWebhookReceiver(t, http.StatusInternalServerError, http.StatusBadGateway, http.StatusServiceUnavailable)
+	svc, dispatcher, store := newWebhookService(t)
+	sub := createTestSubscription(t, svc, receiver.URL, outbox.RegionSaturated)
+
+	first, err := svc.TestSubscription(ctx, sub.SubscriptionID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	id := first.DeliveryID
+
+	steps := []struct {
+		name        string
+		wantStatus  string
+		wantBackoff time.Duration // 다음 시도까지 남은 시간. 대기 중일 때만
+	}{
+		{"second attempt capped by max backoff", models.WebhookDeliveryPending, 90 * time.Minute},
+		{"third attempt gives up", models.WebhookDeliveryFailed, 0},
+	}
+	if first.Status != models.WebhookDeliveryPending || first.LastStatusCode == nil || *first.LastStatusCode != http.StatusInternalServerError {
+		t.Fatalf("first attempt = %+v", first)
+	}
+	if got, _ := store.Webhooks().GetDelivery(ctx, id); time.Until(got.NextAttemptAt) < 59*time.Minute {
+		t.Errorf("first retry at %s, want about 1h from now", got.NextAttemptAt)
+	}
+	// 아직 재시도 시각이 아니면 가져가지 않음
+	if n, err := dispatcher.ProcessDue(ctx); err != nil || n != 0 {
+		t.Fatalf("ProcessDue before backoff = %d, %v", n, err)
+	}
+	for _, step := range steps {
+		makeDue(t, store, id)
+		if n, err := dispatcher.ProcessDue(ctx); err != nil || n != 1 {
+			t.Fatalf("%s: ProcessDue = %d, %v", step.name, n, err)
+		}
+		got, _ := store.Webhooks().GetDelivery(ctx, id)
+		if got.Status != step.wantStatus {
+			t.Fatalf("%s: status = %s, want %s", step.name, got.Status, step.wantStatus)
+		}
+		if wait := time.Until(got.NextAttemptAt); step.wantBackoff > 0 && (wait > step.wantBackoff || wait < step.wantBackoff-time.Minute) {
+			t.Errorf("%s: next attempt in %s, want about %s", step.name, wait, step.wantBackoff)
+		}
+	}
+
+	detail, err := svc.GetDelivery(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var codes []int
+	for _, a := range detail.AttemptLogs {
+		codes = append(codes, *a.StatusCode)
+	}
+	if len(codes) != 3 || codes[0] != 500 || codes[1] != 502 || codes[2] != 503 || detail.LastError == nil {
+		t.Errorf("attempt logs = %v, delivery = %+v", codes, detail.WebhookDeliveryResponse)
+	}
+
+	redelivered, err := svc.Redeliver(ctx, id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if redelivered.Status != models.WebhookDeliverySucceeded || redelivered.Attempts != 1 || redelivered.EventID != first.EventID {
+		t.Errorf("redelivered = %+v", redelivered)
+	}
+	for _, r := range receiver.requests() {
+		if !r.signed || r.delivery == "" {
+			t.Errorf("request = %+v, want signed with delivery id", r)
+		}
+	}
+}
+
+func TestWebhookService_Redeliver(t *testing.T) {
+	tests := []struct {
+		name   string
+		status string
+		want   int
+	}{
+		{"succeeded", models.WebhookDeliverySucceeded, http.StatusOK},
+		{"failed", models.WebhookDeliveryFailed, http.StatusOK},
+		{"pending", models.WebhookDeliveryPending, http.StatusConflict},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctx := context.Background()
+			receiver := newWebhookReceiver(t)
+			svc, _, store := newWebhookService(t)
+			sub := createTestSubscription(t, svc, receiver.URL, outbox.PackageCreated)
+			deliveries := []models.WebhookDelivery{{
+				SubscriptionID: sub.SubscriptionID, EventID: outbox.NewEventID(), EventType: outbox.PackageCreated,
+				Payload: "{}", Status: tt.status, Attempts: 2, NextAttemptAt: time.Now().Add(time.Hour), CreatedAt: time.Now(),
+			}}
+			if err := store.Webhooks().CreateDeliveries(ctx, deliveries); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := svc.Redeliver(ctx, deliveries[0].DeliveryID)
+			if got := statusOf(err); got != tt.want {
+				t.Fatalf("status = %d, want %d (err %v)", got, tt.want, err)
+			}
+			// 대기 중인 전송은 건드리지 않음
+			wantRequests := 1
+			if err != nil {
+				wantRequests = 0
+			}
+			if n := len(receiver.requests()); n != wantRequests {
+				t.Errorf("requests = %d, want %d", n, wantRequests)
+			}
+		})
+	}
+}
+
+func TestWebhookSink(t *testing.T) {
+	ctx := context.Background()
+	svc, _, store := newWebhookService(t)
+	createTestSubscription(t, svc, "http://example.com/packages", outbox.PackageCreated)
+	createTestSubscription(t, svc, "http://example.com/all", outbox.PackageCreated, outbox.RegionSaturated)
+	inactive := createTestSubscription(t, svc, "http://example.com/off", outbox.PackageCreated)
+	if _, err := svc.UpdateSubscription(ctx, inactive.SubscriptionID, dto.UpdateWebhookRequest{
+		URL: "http://example.com/off", EventTypes: []string{outbox.PackageCreated}, IsActive: ptr(false),
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	sink := webhook.NewSink(store)
+	events := []struct {
+		ev   outbox.Event
+		want int // 누적 전송 대기 행 수
+	}{
+		{outbox.Event{ID: "ev-1", Type: outbox.PackageCreated, Data: json.RawMessage(`{}`)}, 2},
+		{outbox.Event{ID: "ev-1", Type: outbox.PackageCreated, Data: json.RawMessage(`{}`)}, 2}, // 다시 전달되어도 중복 없음
+		{outbox.Event{ID: "ev-2", Type: outbox.RegionSaturated, Data: json.RawMessage(`{}`)}, 3},
+		{outbox.Event{ID: "ev-3", Type: outbox.TripLogCreated, Data: json.RawMessage(`{}`)}, 3},
+	}
+	for _, e := range events {
+		if err := sink.Handle(ctx, e.ev); err != nil {
+			t.Fatal(err)
+		}
+		due, _ := store.Webhooks().DueDeliveries(ctx, time.Now(), 100)
+		if len(due) != e.want {
+			t.Fatalf("after %s: queued = %d, want %d", e.ev.ID, len(due), e.want)
+		}
+	}
+}
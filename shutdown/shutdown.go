@@ -0,0 +1,32 @@
+// Package shutdown 은 프로세스 종료 신호를 장시간 연결(이벤트 스트림 등)에 전달합니다.
+//
+// http.Server.Shutdown 은 이미 처리 중인 요청을 끝날 때까지 기다리므로,
+// 스트리밍 핸들러는 Done 채널을 함께 감시하여 종료 시 스스로 연결을 닫아야 합니다.
+package shutdown
+
+import "sync"
+
+var (
+	done = make(chan struct{})
+	once sync.Once
+)
+
+// Done 은 종료 절차가 시작되면 닫히는 채널을 반환합니다.
+func Done() <-chan struct{} {
+	return done
+}
+
+// Trigger 는 종료 절차 시작을 알립니다. 여러 번 호출해도 안전합니다.
+func Trigger() {
+	once.Do(func() { close(done) })
+}
+
+// InProgress 는 종료 절차가 시작되었는지 여부를 반환합니다.
+func InProgress() bool {
+	select {
+	case <-done:
+		return true
+	default:
+		return false
+	}
+}
@@ -1,35 +1,59 @@
 package utils
 
 import (
-	"os"
-
+	"errors"
 	"time"
 
 	"github.com/golang-jwt/jwt/v5"
 )
 
-var JwtSecret = []byte(os.Getenv("JWT_SECRET"))
+var (
+	jwtSecret []byte
+	jwtTTL    = 8 * time.Hour
+)
+
+// ConfigureJWT 토큰 서명 키와 유효 기간 설정. 서버 시작 시 한 번 호출
+func ConfigureJWT(secret string, ttl time.Duration) {
+	jwtSecret = []byte(secret)
+	jwtTTL = ttl
+}
+
+// JWTTTL 발급되는 토큰의 유효 기간
+func JWTTTL() time.Duration {
+	return jwtTTL
+}
 
-func GenerateJWT(employeeID int, position string) (string, error) {
+// GenerateJWT tokenVersion 은 직원의 현재 토큰 버전으로, 비밀번호가 바뀌면 이전 토큰이 무효화됨
+func GenerateJWT(employeeID int, position string, tokenVersion int) (string, error) {
+	if len(jwtSecret) == 0 {
+		return "", errors.New("jwt secret is not configured")
+	}
 	claims := jwt.MapClaims{
 		"employee_id": employeeID,
 		"position":    position,
-		"exp":         time.Now().Add(8 * time.Hour).Unix(),
+		"ver":         tokenVersion,
+		"exp":         time.Now().Add(jwtTTL).Unix(),
 	}
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
-	return token.SignedString(JwtSecret)
+	return token.SignedString(jwtSecret)
 }
 
 func ParseJWT(tokenStr string) (jwt.MapClaims, error) {
+	if len(jwtSecret) == 0 {
+		return nil, errors.New("jwt secret is not configured")
+	}
 	token, err := jwt.Parse(tokenStr, func(token *jwt.Token) (any, error) {
-		return JwtSecret, nil
-	})
-	if err != nil || !token.Valid {
+		return jwtSecret, nil
+	}, jwt.WithValidMethods([]string{jwt.SigningMethodHS256.Alg()}))
+	if err != nil {
 		return nil, err
 	}
+	if !token.Valid {
+		return nil, errors.New("invalid token")
+	}
 	claims, ok := token.Claims.(jwt.MapClaims)
 	if !ok {
-		return nil, err
+		return nil, errors.New("invalid token claims")
 	}
 	return claims, nil
 }
@@ -1,12 +1,105 @@
 package utils
 
 import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
 	"golang.org/x/crypto/bcrypt"
 )
 
+// bcrypt 는 입력의 앞 72바이트만 사용하므로 그보다 긴 비밀번호는 거부
+const maxPasswordBytes = 72
+
+// PasswordPolicy 새 비밀번호가 만족해야 하는 조건
+type PasswordPolicy struct {
+	MinLength  int
+	MinClasses int
+	breached   map[string]struct{}
+}
+
+var (
+	bcryptCost     = bcrypt.DefaultCost
+	passwordPolicy = PasswordPolicy{MinLength: 8}
+)
+
+// ConfigurePassword 해시 cost 와 비밀번호 정책 설정. 서버 시작 시 한 번 호출
+func ConfigurePassword(cost int, policy PasswordPolicy) {
+	bcryptCost = cost
+	passwordPolicy = policy
+}
+
+// LoadBreachedList 유출된 비밀번호 목록 파일(한 줄에 하나)을 정책에 적재
+func (p *PasswordPolicy) LoadBreachedList(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("유출 비밀번호 목록 읽기 실패: %w", err)
+	}
+	defer f.Close()
+
+	p.breached = map[string]struct{}{}
+	sc := bufio.NewScanner(f)
+	for sc.Scan() {
+		if line := strings.TrimSpace(sc.Text()); line != "" && !strings.HasPrefix(line, "#") {
+			p.breached[strings.ToLower(line)] = struct{}{}
+		}
+	}
+	return sc.Err()
+}
+
+// BreachedCount 적재된 유출 비밀번호 수
+func (p *PasswordPolicy) BreachedCount() int {
+	return len(p.breached)
+}
+
+// CheckPasswordPolicy 설정된 정책 위반 사유 목록. 비어 있으면 통과
+func CheckPasswordPolicy(password string) []string {
+	p := passwordPolicy
+	var violations []string
+	if n := utf8.RuneCountInString(password); n < p.MinLength {
+		violations = append(violations, fmt.Sprintf("must be at least %d characters", p.MinLength))
+	}
+	if len(password) > maxPasswordBytes {
+		violations = append(violations, fmt.Sprintf("must be at most %d bytes", maxPasswordBytes))
+	}
+	if classes := passwordClasses(password); classes < p.MinClasses {
+		violations = append(violations, fmt.Sprintf("must contain at least %d of: uppercase, lowercase, digit, symbol", p.MinClasses))
+	}
+	if _, ok := p.breached[strings.ToLower(password)]; ok {
+		violations = append(violations, "appears in a list of breached passwords")
+	}
+	return violations
+}
+
+func passwordClasses(password string) int {
+	var upper, lower, digit, symbol bool
+	for _, r := range password {
+		switch {
+		case unicode.IsUpper(r):
+			upper = true
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsDigit(r):
+			digit = true
+		default:
+			symbol = true
+		}
+	}
+	n := 0
+	for _, ok := range []bool{upper, lower, digit, symbol} {
+		if ok {
+			n++
+		}
+	}
+	return n
+}
+
 // 비밀번호 해시 생성
 func HashPassword(password string) (string, error) {
-	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcrypt.DefaultCost)
+	bytes, err := bcrypt.GenerateFromPassword([]byte(password), bcryptCost)
 	return string(bytes), err
 }
 
@@ -15,3 +108,9 @@ func CheckPasswordHash(password, hash string) bool {
 	err := bcrypt.CompareHashAndPassword([]byte(hash), []byte(password))
 	return err == nil
 }
+
+// NeedsRehash 해시의 cost 가 현재 설정보다 낮으면 true
+func NeedsRehash(hash string) bool {
+	cost, err := bcrypt.Cost([]byte(hash))
+	return err == nil && cost < bcryptCost
+}
@@ -20,3 +20,25 @@ func FormatTimePtr(t *time.Time) *string {
 	s := t.Format(time.RFC3339)
 	return &s
 }
+
+// DateLayout 날짜만 다루는 필드(입사일 등)의 형식
+const DateLayout = "2006-01-02"
+
+func ParseDatePtr(str *string) *time.Time {
+	if str == nil || *str == "" {
+		return nil
+	}
+	t, err := time.Parse(DateLayout, *str)
+	if err != nil {
+		return nil
+	}
+	return &t
+}
+
+func FormatDatePtr(t *time.Time) *string {
+	if t == nil {
+		return nil
+	}
+	s := t.Format(DateLayout)
+	return &s
+}
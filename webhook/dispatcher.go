@@ -0,0 +1,286 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/baboyiban/go-api-server/apperror"
+	"github.com/baboyiban/go-api-server/config"
+	"github.com/baboyiban/go-api-server/metrics"
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/repository"
+	"go.opentelemetry.io/otel"
+)
+
+var tracer = otel.Tracer("github.com/baboyiban/go-api-server/webhook")
+
+// maxErrorLen 전송 기록에 저장하는 에러 메시지 최대 길이 (컬럼 크기)
+const maxErrorLen = 500
+
+// Dispatcher webhook_delivery 큐에서 전송할 행을 가져와 전달하고 결과에 따라 재시도를 예약
+type Dispatcher struct {
+	store  repository.Store
+	client *http.Client
+	cfg    config.WebhookConfig
+}
+
+func NewDispatcher(store repository.Store, cfg config.WebhookConfig) *Dispatcher {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if !cfg.AllowPrivateTargets {
+		// 프록시를 거치면 실제 대상 주소를 확인할 수 없으므로 직접 연결
+		transport.Proxy = nil
+		transport.DialContext = (&net.Dialer{Timeout: cfg.Timeout, Control: dialControl}).DialContext
+	}
+	return &Dispatcher{
+		store: store,
+		cfg:   cfg,
+		client: &http.Client{
+			Timeout:   cfg.Timeout,
+			Transport: transport,
+			// 리다이렉트를 따라가면 서명된 본문이 등록되지 않은 주소로 전달될 수 있음
+			CheckRedirect: func(*http.Request, []*http.Request) error { return http.ErrUseLastResponse },
+		},
+	}
+}
+
+// Run ctx 가 취소될 때까지 PollInterval 마다 전송 시각이 된 행을 처리
+func (d *Dispatcher) Run(ctx context.Context) {
+	ticker := time.NewTicker(d.cfg.PollInterval)
+	defer ticker.Stop()
+	for {
+		// 한 번에 BatchSize 만큼 가져오므로 밀린 행이 있으면 쉬지 않고 이어서 처리
+		for {
+			n, err := d.ProcessDue(ctx)
+			if err != nil && ctx.Err() == nil {
+				slog.ErrorContext(ctx, "웹훅 전송 대기 행 처리 실패", "error", err)
+			}
+			if err != nil || n < d.cfg.BatchSize {
+				break
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// ProcessDue 전송 시각이 된 대기 행을 최대 BatchSize 개 처리하고 처리한 수를 반환
+func (d *Dispatcher) ProcessDue(ctx context.Context) (int, error) {
+	due, err := d.store.Webhooks().DueDeliveries(ctx, time.Now(), d.cfg.BatchSize)
+	if err != nil {
+		return 0, err
+	}
+	processed := 0
+	for i := range due {
+		if ctx.Err() != nil {
+			return processed, ctx.Err()
+		}
+		// 전송 중에는 다음 시도 시각을 응답 대기 시간 이후로 미뤄 다른 인스턴스가 같은 행을 가져가지 않도록 함
+		now := time.Now()
+		claimed, err := d.store.Webhooks().ClaimDelivery(ctx, due[i].DeliveryID, now, now.Add(2*d.cfg.Timeout))
+		if err != nil {
+			return processed, err
+		}
+		if !claimed {
+			// 다른 인스턴스가 먼저 가져감
+			continue
+		}
+		if err := d.attempt(ctx, &due[i]); err != nil {
+			return processed, err
+		}
+		processed++
+	}
+	return processed, nil
+}
+
+// Redeliver 이미 성공했거나 실패한 전송을 시도 횟수를 초기화하여 즉시 다시 전송.
+// 대기 중인 전송은 워커가 보내는 중일 수 있으므로 되돌리지 않음. 조건부 UPDATE 라 동시에 요청해도 한 번만 전송
+func (d *Dispatcher) Redeliver(ctx context.Context, deliveryID int) (*models.WebhookDelivery, error) {
+	ctx, span := tracer.Start(ctx, "webhook.Redeliver")
+	defer span.End()
+	if _, err := d.store.Webhooks().GetDelivery(ctx, deliveryID); err != nil {
+		return nil, err
+	}
+	reset, err := d.store.Webhooks().ResetDelivery(ctx, deliveryID, time.Now().Add(2*d.cfg.Timeout))
+	if err != nil {
+		return nil, err
+	}
+	if !reset {
+		return nil, apperror.ErrDeliveryPending
+	}
+	return d.deliverNow(ctx, deliveryID)
+}
+
+// Test 구독에 테스트 이벤트를 만들어 즉시 전송하고 결과를 반환
+func (d *Dispatcher) Test(ctx context.Context, sub *models.WebhookSubscription) (*models.WebhookDelivery, error) {
+	ctx, span := tracer.Start(ctx, "webhook.Test")
+	defer span.End()
+	ev := NewEvent(EventTest, map[string]any{"subscription_id": sub.SubscriptionID})
+	payload, err := jsonPayload(ev)
+	if err != nil {
+		return nil, err
+	}
+	now := time.Now()
+	delivery := models.WebhookDelivery{
+		SubscriptionID: sub.SubscriptionID,
+		EventID:        ev.ID,
+		EventType:      ev.Type,
+		Payload:        payload,
+		Status:         models.WebhookDeliveryPending,
+		// 워커가 가져가지 않도록 전송 중 상태로 생성
+		NextAttemptAt: now.Add(2 * d.cfg.Timeout),
+		CreatedAt:     now,
+	}
+	deliveries := []models.WebhookDelivery{delivery}
+	if err := d.store.Webhooks().CreateDeliveries(ctx, deliveries); err != nil {
+		return nil, err
+	}
+	return d.deliverNow(ctx, deliveries[0].DeliveryID)
+}
+
+// deliverNow 이미 선점한 전송을 바로 시도
+func (d *Dispatcher) deliverNow(ctx context.Context, deliveryID int) (*models.WebhookDelivery, error) {
+	delivery, err := d.store.Webhooks().GetDelivery(ctx, deliveryID)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.attempt(ctx, delivery); err != nil {
+		return nil, err
+	}
+	return delivery, nil
+}
+
+// attempt 전송을 한 번 시도하고 시도 기록과 전송 상태를 저장
+func (d *Dispatcher) attempt(ctx context.Context, delivery *models.WebhookDelivery) error {
+	ctx, span := tracer.Start(ctx, "webhook.attempt")
+	defer span.End()
+
+	sub, err := d.store.Webhooks().GetSubscription(ctx, delivery.SubscriptionID)
+	if err != nil {
+		return err
+	}
+
+	start := time.Now()
+	var statusCode *int
+	var sendErr error
+	if !sub.IsActive {
+		sendErr = errors.New("subscription is inactive")
+	} else {
+		code, err := d.send(ctx, sub, delivery)
+		if code != 0 {
+			statusCode = &code
+		}
+		sendErr = err
+	}
+	elapsed := time.Since(start)
+
+	delivery.Attempts++
+	delivery.LastStatusCode = statusCode
+	delivery.LastError = nil
+	result := "succeeded"
+	switch {
+	case sendErr == nil:
+		delivery.Status = models.WebhookDeliverySucceeded
+		delivery.DeliveredAt = &start
+	case !sub.IsActive || delivery.Attempts >= d.cfg.MaxAttempts:
+		msg := truncate(sendErr.Error())
+		delivery.LastError = &msg
+		delivery.Status = models.WebhookDeliveryFailed
+		result = "failed"
+	default:
+		msg := truncate(sendErr.Error())
+		delivery.LastError = &msg
+		delivery.NextAttemptAt = time.Now().Add(d.backoff(delivery.Attempts))
+		result = "retry"
+	}
+	metrics.ObserveWebhookAttempt(delivery.EventType, result, elapsed)
+	if sendErr != nil {
+		slog.WarnContext(ctx, "웹훅 전송 실패",
+			"delivery_id", delivery.DeliveryID, "subscription_id", sub.SubscriptionID,
+			"attempts", delivery.Attempts, "result", result, "error", sendErr)
+	}
+
+	return d.store.Transaction(ctx, func(tx repository.Store) error {
+		if err := tx.Webhooks().CreateAttempt(ctx, &models.WebhookAttempt{
+			DeliveryID:  delivery.DeliveryID,
+			AttemptedAt: start,
+			StatusCode:  statusCode,
+			Error:       delivery.LastError,
+			DurationMs:  elapsed.Milliseconds(),
+		}); err != nil {
+			return err
+		}
+		return tx.Webhooks().UpdateDelivery(ctx, delivery.DeliveryID, map[string]any{
+			"status":           delivery.Status,
+			"attempts":         delivery.Attempts,
+			"next_attempt_at":  delivery.NextAttemptAt,
+			"last_status_code": delivery.LastStatusCode,
+			"last_error":       delivery.LastError,
+			"delivered_at":     delivery.DeliveredAt,
+		})
+	})
+}
+
+// send 서명한 요청을 보내고 응답 코드를 반환. 2xx 가 아니면 에러
+func (d *Dispatcher) send(ctx context.Context, sub *models.WebhookSubscription, delivery *models.WebhookDelivery) (int, error) {
+	body := []byte(delivery.Payload)
+	ts := time.Now().Unix()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, sub.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "go-api-server-webhook/1.0")
+	req.Header.Set(HeaderEvent, delivery.EventType)
+	req.Header.Set(HeaderEventID, delivery.EventID)
+	req.Header.Set(HeaderDelivery, strconv.Itoa(delivery.DeliveryID))
+	req.Header.Set(HeaderTimestamp, strconv.FormatInt(ts, 10))
+	req.Header.Set(HeaderSignature, Sign(sub.Secret, ts, body))
+
+	resp, err := d.client.Do(req)
+	if err != nil {
+		return 0, err
+	}
+	defer resp.Body.Close()
+	// 커넥션 재사용을 위해 본문을 일부 읽고 버림
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 64<<10))
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return resp.StatusCode, fmt.Errorf("unexpected status %d", resp.StatusCode)
+	}
+	return resp.StatusCode, nil
+}
+
+// backoff attempts 번 실패한 뒤의 재시도 간격. BaseBackoff 부터 두 배씩 늘려 MaxBackoff 까지
+func (d *Dispatcher) backoff(attempts int) time.Duration {
+	wait := d.cfg.BaseBackoff
+	for i := 1; i < attempts && wait < d.cfg.MaxBackoff; i++ {
+		wait *= 2
+	}
+	return min(wait, d.cfg.MaxBackoff)
+}
+
+// truncate maxErrorLen 바이트 이하로 자름. 여러 바이트 문자 중간에서 자르면
+// utf8mb4, PostgreSQL 컬럼에 저장할 수 없으므로 문자 경계에서 자름
+func truncate(s string) string {
+	s = strings.ToValidUTF8(s, "\uFFFD")
+	if len(s) <= maxErrorLen {
+		return s
+	}
+	cut := maxErrorLen
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut]
+}
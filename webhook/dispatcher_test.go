@@ -0,0 +1,29 @@
+package webhook
+
+import (
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestTruncate(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		wantLen int
+	}{
+		{"short", "timeout", len("timeout")},
+		{"ascii at limit", strings.Repeat("a", maxErrorLen+10), maxErrorLen},
+		// 3바이트 문자가 경계에 걸리면 그 문자 전체를 버림
+		{"multibyte on boundary", "a" + strings.Repeat("가", maxErrorLen/3+1), maxErrorLen - 1},
+		{"invalid bytes replaced", "bad \xff body", len("bad � body")},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := truncate(tt.in)
+			if len(got) != tt.wantLen || !utf8.ValidString(got) {
+				t.Errorf("len = %d (valid %v), want %d", len(got), utf8.ValidString(got), tt.wantLen)
+			}
+		})
+	}
+}
@@ -0,0 +1,38 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+// Sink 아웃박스 이벤트를 구독마다 전송 대기 행으로 바꾸는 outbox.Sink
+type Sink struct {
+	store repository.Store
+}
+
+var _ outbox.TxSink[repository.Store] = (*Sink)(nil)
+
+func NewSink(store repository.Store) *Sink {
+	return &Sink{store: store}
+}
+
+func (s *Sink) Name() string {
+	return "webhook"
+}
+
+func (s *Sink) Handle(ctx context.Context, ev outbox.Event) error {
+	return s.store.Transaction(ctx, func(tx repository.Store) error {
+		return s.HandleTx(ctx, tx, ev)
+	})
+}
+
+// HandleTx 구독마다 전송 대기 행을 추가. 아웃박스는 최소 한 번 전달하므로 이미 큐에 넣은 이벤트는 건너뜀
+func (s *Sink) HandleTx(ctx context.Context, tx repository.Store, ev outbox.Event) error {
+	queued, err := tx.Webhooks().HasEvent(ctx, ev.ID)
+	if err != nil || queued {
+		return err
+	}
+	return Enqueue(ctx, tx.Webhooks(), Event{ID: ev.ID, Type: ev.Type, OccurredAt: ev.OccurredAt, Data: ev.Data})
+}
@@ -0,0 +1,64 @@
+package webhook
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/netip"
+	"net/url"
+	"strings"
+	"syscall"
+)
+
+// errBlockedTarget 전송 시점에 주소가 내부망으로 해석된 경우 (DNS 로 검증을 우회한 경우 포함)
+var errBlockedTarget = errors.New("webhook target resolves to a loopback, private or link-local address")
+
+// sharedAddressSpace 통신사 NAT 대역 (RFC 6598). netip 의 IsPrivate 에 포함되지 않음
+var sharedAddressSpace = netip.MustParsePrefix("100.64.0.0/10")
+
+// CheckURL 구독 주소가 http(s) 이고 루프백, 사설, 링크 로컬 주소를 직접 가리키지 않는지 확인.
+// 도메인이 내부 주소로 해석되는 경우는 전송 시 연결 단계에서 막음
+func (d *Dispatcher) CheckURL(raw string) error {
+	u, err := url.Parse(raw)
+	if err != nil {
+		return errors.New("must be a valid URL")
+	}
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return errors.New("scheme must be http or https")
+	}
+	host := u.Hostname()
+	if host == "" {
+		return errors.New("host is required")
+	}
+	if d.cfg.AllowPrivateTargets {
+		return nil
+	}
+	if host = strings.TrimSuffix(strings.ToLower(host), "."); host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return errors.New("loopback hosts are not allowed")
+	}
+	if ip, err := netip.ParseAddr(host); err == nil && blockedAddr(ip) {
+		return fmt.Errorf("address %s is not publicly routable", ip)
+	}
+	return nil
+}
+
+// blockedAddr 웹훅으로 보내면 안 되는 내부 주소
+func blockedAddr(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	return ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() ||
+		ip.IsMulticast() || sharedAddressSpace.Contains(ip)
+}
+
+// dialControl 실제로 연결하는 IP 를 확인해 DNS 재바인딩으로 내부 주소에 보내지 않도록 함
+func dialControl(_, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil || blockedAddr(ip) {
+		return errBlockedTarget
+	}
+	return nil
+}
@@ -0,0 +1,79 @@
+package webhook
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/baboyiban/go-api-server/config"
+)
+
+func TestDispatcher_CheckURL(t *testing.T) {
+	tests := []struct {
+		name         string
+		url          string
+		allowPrivate bool
+		wantErr      string
+	}{
+		{"public https", "https://hooks.example.com/in", false, ""},
+		{"public ip", "http://203.0.113.10:8080/in", false, ""},
+		{"ftp scheme", "ftp://example.com/in", false, "scheme"},
+		{"no host", "http:///in", false, "host"},
+		{"localhost", "http://localhost:8080/in", false, "loopback"},
+		{"localhost subdomain", "http://api.localhost./in", false, "loopback"},
+		{"loopback ip", "http://127.0.0.1/in", false, "not publicly routable"},
+		{"ipv6 loopback", "http://[::1]/in", false, "not publicly routable"},
+		{"mapped loopback", "http://[::ffff:127.0.0.1]/in", false, "not publicly routable"},
+		{"private ip", "http://10.1.2.3/in", false, "not publicly routable"},
+		{"metadata service", "http://169.254.169.254/latest", false, "not publicly routable"},
+		{"shared address space", "http://100.64.0.1/in", false, "not publicly routable"},
+		{"unspecified", "http://0.0.0.0/in", false, "not publicly routable"},
+		{"private allowed in development", "http://127.0.0.1:9000/in", true, ""},
+		{"scheme checked even when private allowed", "file:///etc/passwd", true, "scheme"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDispatcher(nil, config.WebhookConfig{Timeout: time.Second, AllowPrivateTargets: tt.allowPrivate})
+			err := d.CheckURL(tt.url)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatal(err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("err = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// 주소 검증을 통과한 도메인이 내부 주소로 해석되어도 연결 단계에서 막힘
+func TestDispatcher_BlocksPrivateDial(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+
+	tests := []struct {
+		name         string
+		allowPrivate bool
+		blocked      bool
+	}{
+		{"blocked", false, true},
+		{"allowed in development", true, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d := NewDispatcher(nil, config.WebhookConfig{Timeout: time.Second, AllowPrivateTargets: tt.allowPrivate})
+			res, err := d.client.Get(srv.URL)
+			if err == nil {
+				_ = res.Body.Close()
+			}
+			if blocked := errors.Is(err, errBlockedTarget); blocked != tt.blocked {
+				t.Errorf("err = %v, want blocked %v", err, tt.blocked)
+			}
+		})
+	}
+}
@@ -0,0 +1,138 @@
+// Package webhook 은 도메인 이벤트를 구독한 외부 시스템에 HMAC-SHA256 으로 서명한 HTTP 요청으로 전달합니다.
+//
+// 아웃박스 이벤트는 Sink 를 통해 구독마다 webhook_delivery 행으로 기록되고,
+// Dispatcher 가 이 테이블을 큐로 삼아 전송과 재시도를 처리하므로 프로세스가 재시작되어도 유실되지 않습니다.
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/baboyiban/go-api-server/models"
+	"github.com/baboyiban/go-api-server/outbox"
+	"github.com/baboyiban/go-api-server/repository"
+)
+
+// EventTest 테스트 전송 이벤트 타입. 구독 여부와 관계없이 대상 구독에만 전송
+const EventTest = "webhook.test"
+
+// EventTypes 구독 가능한 이벤트 타입
+var EventTypes = outbox.EventTypes
+
+// 전송 요청 헤더
+const (
+	HeaderEvent     = "X-Webhook-Event"
+	HeaderEventID   = "X-Webhook-Id"
+	HeaderDelivery  = "X-Webhook-Delivery"
+	HeaderTimestamp = "X-Webhook-Timestamp"
+	HeaderSignature = "X-Webhook-Signature"
+)
+
+// Event 수신 측에 전달되는 본문
+type Event struct {
+	ID         string    `json:"id"`
+	Type       string    `json:"type"`
+	OccurredAt time.Time `json:"occurred_at"`
+	Data       any       `json:"data"`
+}
+
+// NewEvent 새 ID 를 부여한 이벤트
+func NewEvent(eventType string, data any) Event {
+	return Event{ID: outbox.NewEventID(), Type: eventType, OccurredAt: time.Now().UTC(), Data: data}
+}
+
+// Sign "<timestamp>.<body>" 의 HMAC-SHA256 서명. 헤더 값 형식은 "sha256=<hex>"
+func Sign(secret string, timestamp int64, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(strconv.FormatInt(timestamp, 10)))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// Verify 수신 측 서명 검증. 재전송 공격을 막기 위해 tolerance 보다 오래된 요청은 거부
+func Verify(secret, timestamp string, body []byte, signature string, tolerance time.Duration) bool {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if tolerance > 0 {
+		age := time.Since(time.Unix(ts, 0))
+		if age > tolerance || age < -tolerance {
+			return false
+		}
+	}
+	return hmac.Equal([]byte(Sign(secret, ts, body)), []byte(signature))
+}
+
+// NewSecret 구독 생성 시 시크릿을 지정하지 않으면 사용하는 임의 시크릿
+func NewSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return "whsec_" + hex.EncodeToString(b), nil
+}
+
+// Enqueue 이벤트를 구독 중인 활성 구독마다 전송 대기 행으로 기록
+func Enqueue(ctx context.Context, webhooks repository.WebhookRepository, ev Event) error {
+	subs, err := webhooks.ListSubscriptions(ctx, true)
+	if err != nil {
+		return err
+	}
+	payload, err := jsonPayload(ev)
+	if err != nil {
+		return err
+	}
+	now := time.Now()
+	var deliveries []models.WebhookDelivery
+	for _, sub := range subs {
+		if !Subscribed(sub.EventTypes, ev.Type) {
+			continue
+		}
+		deliveries = append(deliveries, models.WebhookDelivery{
+			SubscriptionID: sub.SubscriptionID,
+			EventID:        ev.ID,
+			EventType:      ev.Type,
+			Payload:        payload,
+			Status:         models.WebhookDeliveryPending,
+			NextAttemptAt:  now,
+			CreatedAt:      now,
+		})
+	}
+	return webhooks.CreateDeliveries(ctx, deliveries)
+}
+
+// Subscribed 쉼표로 구분한 구독 이벤트 목록에 eventType 이 포함되는지 여부
+func Subscribed(eventTypes, eventType string) bool {
+	return slices.Contains(SplitEventTypes(eventTypes), eventType)
+}
+
+// SplitEventTypes 저장된 이벤트 목록을 슬라이스로 변환
+func SplitEventTypes(eventTypes string) []string {
+	var out []string
+	for _, t := range strings.Split(eventTypes, ",") {
+		if t = strings.TrimSpace(t); t != "" {
+			out = append(out, t)
+		}
+	}
+	return out
+}
+
+// jsonPayload 전송 본문으로 저장할 이벤트 JSON
+func jsonPayload(ev Event) (string, error) {
+	b, err := json.Marshal(ev)
+	if err != nil {
+		return "", fmt.Errorf("webhook: 이벤트 직렬화 실패: %w", err)
+	}
+	return string(b), nil
+}